@@ -1 +1,73 @@
-package grpc
+// cmd/grpc/main.go
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+	"log/slog"
+	"net"
+	"os"
+	"os/signal"
+	"syscall"
+
+	grpcapi "moh/internal/adapters/grpc"
+	"moh/models"
+	"moh/shared/config"
+	mydb "moh/shared/db"
+	mw "moh/shared/middlewares"
+)
+
+func main() {
+	configPath := flag.String("config", os.Getenv("MOH_CONFIG"), "path to a .yaml or .toml config file")
+	flag.Parse()
+
+	cfg, err := config.Load(*configPath)
+	if err != nil {
+		log.Fatalf("❌ %v", err)
+	}
+	if err := cfg.Validate(); err != nil {
+		log.Fatalf("❌ invalid config:\n%v", err)
+	}
+	setupLogging(cfg.Log)
+	log.Printf("config: %s", cfg)
+
+	ctx := context.Background()
+	db := mydb.MustOpen(ctx, cfg.DB)
+	defer db.Close()
+
+	keys, err := mw.KeySetFromConfig(cfg.JWT)
+	if err != nil {
+		log.Fatalf("❌ jwt keys: %v", err)
+	}
+
+	lis, err := net.Listen("tcp", cfg.GRPC.Addr)
+	if err != nil {
+		log.Fatalf("❌ listen: %v", err)
+	}
+	srv, health := grpcapi.NewServer(db, keys, grpcapi.Options{
+		BrandSimilarityThreshold: cfg.Safety.BrandSimilarityThreshold,
+		PriceCeilingPolicy:       models.PriceCeilingPolicy(cfg.Pricing.CeilingPolicy),
+	})
+
+	go func() {
+		stop := make(chan os.Signal, 1)
+		signal.Notify(stop, os.Interrupt, syscall.SIGTERM)
+		<-stop
+		log.Println("shutting down")
+		health.Shutdown()
+		srv.GracefulStop()
+	}()
+
+	log.Printf("🚀 gRPC server listening on %s", cfg.GRPC.Addr)
+	if err := srv.Serve(lis); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// setupLogging routes the standard logger through slog at the configured level.
+func setupLogging(c config.LogConfig) {
+	var level slog.Level
+	_ = level.UnmarshalText([]byte(c.Level))
+	slog.SetDefault(slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: level})))
+}
@@ -0,0 +1,102 @@
+// cmd/migrate/main.go
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+
+	"moh/shared/config"
+	mydb "moh/shared/db"
+)
+
+const usage = `usage: migrate [-config file] [-dsn postgres://...] <command>
+
+commands:
+  up          apply all pending migrations
+  down [n]    roll back the last n migrations (default 1)
+  status      list migrations and whether they are applied
+
+The DSN comes from -dsn, else the config file and MOH_DB_DSN.`
+
+func main() {
+	configPath := flag.String("config", os.Getenv("MOH_CONFIG"), "path to a .yaml or .toml config file")
+	dsn := flag.String("dsn", "", "Postgres connection string (overrides config)")
+	flag.Usage = func() { fmt.Fprintln(os.Stderr, usage) }
+	flag.Parse()
+
+	if flag.NArg() < 1 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	cfg, err := config.Load(*configPath)
+	if err != nil {
+		log.Fatalf("❌ %v", err)
+	}
+	if *dsn != "" {
+		cfg.DB.DSN = *dsn
+	}
+	if err := cfg.DB.Validate(); err != nil {
+		log.Fatalf("❌ invalid config:\n%v", err)
+	}
+
+	ctx := context.Background()
+	db := mydb.MustOpen(ctx, cfg.DB)
+	defer db.Close()
+
+	switch cmd := flag.Arg(0); cmd {
+	case "up":
+		applied, err := mydb.MigrateUp(ctx, db)
+		if err != nil {
+			log.Fatalf("❌ migrate up: %v", err)
+		}
+		if len(applied) == 0 {
+			log.Println("✅ schema is up to date")
+		}
+		for _, v := range applied {
+			log.Printf("✅ applied %d", v)
+		}
+
+	case "down":
+		steps := 1
+		if flag.NArg() > 1 {
+			n, err := strconv.Atoi(flag.Arg(1))
+			if err != nil || n < 1 {
+				log.Fatalf("❌ invalid step count %q", flag.Arg(1))
+			}
+			steps = n
+		}
+		reverted, err := mydb.MigrateDown(ctx, db, steps)
+		if err != nil {
+			log.Fatalf("❌ migrate down: %v", err)
+		}
+		if len(reverted) == 0 {
+			log.Println("✅ nothing to roll back")
+		}
+		for _, v := range reverted {
+			log.Printf("✅ rolled back %d", v)
+		}
+
+	case "status":
+		statuses, err := mydb.MigrationStatuses(ctx, db)
+		if err != nil {
+			log.Fatalf("❌ migrate status: %v", err)
+		}
+		for _, s := range statuses {
+			state := "pending"
+			if s.Applied {
+				state = "applied " + s.AppliedAt.Format("2006-01-02 15:04:05")
+			}
+			fmt.Printf("%04d  %-30s  %s\n", s.Version, s.Name, state)
+		}
+
+	default:
+		fmt.Fprintf(os.Stderr, "unknown command %q\n\n", cmd)
+		flag.Usage()
+		os.Exit(2)
+	}
+}
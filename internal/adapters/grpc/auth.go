@@ -0,0 +1,124 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	mw "moh/shared/middlewares"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// Role policy, the same as the HTTP router's:
+//
+//	Get*, List*                      any role
+//	Create*, Update* on Drug/Batch   registry_admin, manufacturer
+//	other Create*, Update*           registry_admin
+//	Delete*                          registry_admin
+//
+// Health checks and reflection are public; any other method is refused.
+var (
+	readers = []mw.Role{mw.RoleRegistryAdmin, mw.RoleInspector, mw.RoleManufacturer, mw.RoleReadOnly}
+	admins  = []mw.Role{mw.RoleRegistryAdmin}
+	supply  = []mw.Role{mw.RoleRegistryAdmin, mw.RoleManufacturer}
+)
+
+const servicePrefix = "/moh.registry.v1."
+
+var publicPrefixes = []string{"/grpc.health.v1.Health/", "/grpc.reflection."}
+
+// methodRoles returns the roles allowed to call fullMethod
+// ("/package.Service/Method"). public is true when no token is needed.
+func methodRoles(fullMethod string) (roles []mw.Role, public bool) {
+	for _, p := range publicPrefixes {
+		if strings.HasPrefix(fullMethod, p) {
+			return nil, true
+		}
+	}
+	service, method, ok := strings.Cut(strings.TrimPrefix(fullMethod, servicePrefix), "/")
+	if !ok || !strings.HasPrefix(fullMethod, servicePrefix) {
+		return nil, false
+	}
+	switch {
+	case strings.HasPrefix(method, "Get"), strings.HasPrefix(method, "List"):
+		return readers, false
+	case strings.HasPrefix(method, "Delete"):
+		return admins, false
+	case service == "DrugService", service == "BatchService":
+		return supply, false
+	default:
+		return admins, false
+	}
+}
+
+type claimsKey struct{}
+
+// ClaimsFromContext returns the caller's claims stored by the auth interceptors.
+func ClaimsFromContext(ctx context.Context) (*mw.Claims, bool) {
+	c, ok := ctx.Value(claimsKey{}).(*mw.Claims)
+	return c, ok
+}
+
+// authorize verifies the "authorization: Bearer <jwt>" metadata and the
+// caller's roles, returning ctx with the claims attached.
+func authorize(ctx context.Context, ks *mw.KeySet, fullMethod string) (context.Context, error) {
+	roles, public := methodRoles(fullMethod)
+	if public {
+		return ctx, nil
+	}
+
+	md, _ := metadata.FromIncomingContext(ctx)
+	values := md.Get("authorization")
+	if len(values) == 0 {
+		return nil, status.Error(codes.Unauthenticated, "authorization metadata is missing")
+	}
+	token := strings.TrimPrefix(values[0], "Bearer ")
+	if token == values[0] || token == "" {
+		return nil, status.Error(codes.Unauthenticated, "invalid authorization metadata format")
+	}
+	claims, err := ks.Verify(token)
+	if err != nil {
+		if errors.Is(err, mw.ErrTokenExpired) {
+			return nil, status.Error(codes.Unauthenticated, "token has expired")
+		}
+		return nil, status.Error(codes.Unauthenticated, "invalid token")
+	}
+
+	if roles == nil || !claims.HasRole(roles...) {
+		return nil, status.Error(codes.PermissionDenied, "insufficient role for this operation")
+	}
+	return context.WithValue(ctx, claimsKey{}, claims), nil
+}
+
+// UnaryAuth is the unary counterpart of the HTTP AuthGin + RequireRoles chain.
+func UnaryAuth(ks *mw.KeySet) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		ctx, err := authorize(ctx, ks, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamAuth applies the same checks to streaming RPCs.
+func StreamAuth(ks *mw.KeySet) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := authorize(ss.Context(), ks, info.FullMethod)
+		if err != nil {
+			return err
+		}
+		return handler(srv, &authedStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+type authedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *authedStream) Context() context.Context { return s.ctx }
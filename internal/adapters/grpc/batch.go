@@ -0,0 +1,117 @@
+package grpc
+
+import (
+	"context"
+
+	"moh/internal/adapters/grpc/registrypb"
+	"moh/internal/services"
+	"moh/models"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type batchServer struct {
+	registrypb.UnimplementedBatchServiceServer
+	db     *pgxpool.Pool
+	policy models.PriceCeilingPolicy
+}
+
+// ===== Batch =====
+
+func (s *batchServer) CreateBatch(ctx context.Context, in *registrypb.CreateBatchRequest) (*registrypb.Batch, error) {
+	add := func(ctx context.Context, db services.DBTX, b models.Batch) (models.Batch, error) {
+		return services.AddBatch(ctx, db, b, s.policy)
+	}
+	return create(ctx, s.db, in.GetBatch(), batchFromPB, add, batchToPB)
+}
+
+func (s *batchServer) GetBatch(ctx context.Context, in *registrypb.GetBatchRequest) (*registrypb.Batch, error) {
+	return get(ctx, s.db, in.GetId(), services.GetBatch, batchToPB)
+}
+
+func (s *batchServer) UpdateBatch(ctx context.Context, in *registrypb.UpdateBatchRequest) (*registrypb.Batch, error) {
+	upd := func(ctx context.Context, db services.DBTX, id string, b models.Batch) (models.Batch, error) {
+		return services.UpdateBatch(ctx, db, id, b, s.policy)
+	}
+	return update(ctx, s.db, in.GetBatch().GetId(), in.GetBatch(), batchFromPB, upd, batchToPB)
+}
+
+func (s *batchServer) DeleteBatch(ctx context.Context, in *registrypb.DeleteBatchRequest) (*emptypb.Empty, error) {
+	return remove(ctx, s.db, in.GetId(), services.DeleteBatch)
+}
+
+func (s *batchServer) ListBatches(ctx context.Context, in *registrypb.ListBatchesRequest) (*registrypb.ListBatchesResponse, error) {
+	before, err := parseDate("expire_before", in.GetExpireBefore())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	after, err := parseDate("expire_after", in.GetExpireAfter())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	f := models.BatchFilter{
+		Status:             models.BatchStatus(in.GetStatus()),
+		DrugID:             in.GetDrugId(),
+		DrugRegistrationID: in.GetDrugRegistrationId(),
+		PresentationID:     in.GetPresentationId(),
+		BatchNumber:        in.GetBatchNumber(),
+		ExpireBefore:       before,
+		ExpireAfter:        after,
+	}
+	items, next, total, err := list(ctx, s.db, f, in.GetPageSize(), in.GetPageToken(), in.GetOrderBy(), services.ListBatches, batchToPB)
+	if err != nil {
+		return nil, err
+	}
+	return &registrypb.ListBatchesResponse{Items: items, NextPageToken: next, TotalSize: total}, nil
+}
+
+// ===== Conversions =====
+
+func batchFromPB(p *registrypb.Batch) (models.Batch, error) {
+	mfg, err := parseDate("mfg_date", p.GetMfgDate())
+	if err != nil {
+		return models.Batch{}, err
+	}
+	exp, err := parseDate("expire_date", p.GetExpireDate())
+	if err != nil {
+		return models.Batch{}, err
+	}
+	price, err := parseAmount("price", p.GetPrice())
+	if err != nil {
+		return models.Batch{}, err
+	}
+	return models.Batch{
+		DrugID:             p.GetDrugId(),
+		DrugRegistrationID: p.GetDrugRegistrationId(),
+		PresentationID:     p.GetPresentationId(),
+		BatchNumber:        p.GetBatchNumber(),
+		MfgDate:            mfg,
+		ExpireDate:         exp,
+		QtyInBatch:         p.GetQtyInBatch(),
+		Status:             models.BatchStatus(p.GetStatus()),
+		Price:              price,
+		Currency:           p.GetCurrency(),
+	}, nil
+}
+
+func batchToPB(m models.Batch) *registrypb.Batch {
+	return &registrypb.Batch{
+		Id:                 m.ID,
+		DrugId:             m.DrugID,
+		DrugRegistrationId: m.DrugRegistrationID,
+		PresentationId:     m.PresentationID,
+		BatchNumber:        m.BatchNumber,
+		MfgDate:            formatDate(m.MfgDate),
+		ExpireDate:         formatDate(m.ExpireDate),
+		QtyInBatch:         m.QtyInBatch,
+		QtyRemaining:       m.QtyRemaining,
+		Status:             string(m.Status),
+		Price:              m.Price.String(),
+		Currency:           m.Currency,
+		CreatedAt:          timestamp(m.CreatedAt),
+		UpdatedAt:          timestamp(m.UpdatedAt),
+	}
+}
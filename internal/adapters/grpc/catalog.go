@@ -0,0 +1,159 @@
+package grpc
+
+import (
+	"context"
+
+	"moh/internal/adapters/grpc/registrypb"
+	"moh/internal/services"
+	"moh/models"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type catalogServer struct {
+	registrypb.UnimplementedCatalogServiceServer
+	db *pgxpool.Pool
+}
+
+// ===== DosageForm =====
+
+func (s *catalogServer) CreateDosageForm(ctx context.Context, in *registrypb.CreateDosageFormRequest) (*registrypb.DosageForm, error) {
+	return create(ctx, s.db, in.GetDosageForm(), dosageFormFromPB, services.AddDosageForm, dosageFormToPB)
+}
+
+func (s *catalogServer) GetDosageForm(ctx context.Context, in *registrypb.GetDosageFormRequest) (*registrypb.DosageForm, error) {
+	return get(ctx, s.db, in.GetId(), services.GetDosageForm, dosageFormToPB)
+}
+
+func (s *catalogServer) UpdateDosageForm(ctx context.Context, in *registrypb.UpdateDosageFormRequest) (*registrypb.DosageForm, error) {
+	return update(ctx, s.db, in.GetDosageForm().GetId(), in.GetDosageForm(), dosageFormFromPB, services.UpdateDosageForm, dosageFormToPB)
+}
+
+func (s *catalogServer) DeleteDosageForm(ctx context.Context, in *registrypb.DeleteDosageFormRequest) (*emptypb.Empty, error) {
+	return remove(ctx, s.db, in.GetId(), services.DeleteDosageForm)
+}
+
+func (s *catalogServer) ListDosageForms(ctx context.Context, in *registrypb.ListDosageFormsRequest) (*registrypb.ListDosageFormsResponse, error) {
+	items, next, total, err := list(ctx, s.db, models.CodeNameFilter{Code: in.GetCode(), Q: in.GetQ()}, in.GetPageSize(), in.GetPageToken(), in.GetOrderBy(), services.ListDosageForms, dosageFormToPB)
+	if err != nil {
+		return nil, err
+	}
+	return &registrypb.ListDosageFormsResponse{Items: items, NextPageToken: next, TotalSize: total}, nil
+}
+
+// ===== StrengthUnit =====
+
+func (s *catalogServer) CreateStrengthUnit(ctx context.Context, in *registrypb.CreateStrengthUnitRequest) (*registrypb.StrengthUnit, error) {
+	return create(ctx, s.db, in.GetStrengthUnit(), strengthUnitFromPB, services.AddStrengthUnit, strengthUnitToPB)
+}
+
+func (s *catalogServer) GetStrengthUnit(ctx context.Context, in *registrypb.GetStrengthUnitRequest) (*registrypb.StrengthUnit, error) {
+	return get(ctx, s.db, in.GetId(), services.GetStrengthUnit, strengthUnitToPB)
+}
+
+func (s *catalogServer) UpdateStrengthUnit(ctx context.Context, in *registrypb.UpdateStrengthUnitRequest) (*registrypb.StrengthUnit, error) {
+	return update(ctx, s.db, in.GetStrengthUnit().GetId(), in.GetStrengthUnit(), strengthUnitFromPB, services.UpdateStrengthUnit, strengthUnitToPB)
+}
+
+func (s *catalogServer) DeleteStrengthUnit(ctx context.Context, in *registrypb.DeleteStrengthUnitRequest) (*emptypb.Empty, error) {
+	return remove(ctx, s.db, in.GetId(), services.DeleteStrengthUnit)
+}
+
+func (s *catalogServer) ListStrengthUnits(ctx context.Context, in *registrypb.ListStrengthUnitsRequest) (*registrypb.ListStrengthUnitsResponse, error) {
+	items, next, total, err := list(ctx, s.db, models.CodeNameFilter{Code: in.GetCode(), Q: in.GetQ()}, in.GetPageSize(), in.GetPageToken(), in.GetOrderBy(), services.ListStrengthUnits, strengthUnitToPB)
+	if err != nil {
+		return nil, err
+	}
+	return &registrypb.ListStrengthUnitsResponse{Items: items, NextPageToken: next, TotalSize: total}, nil
+}
+
+// ===== RouteOfAdmin =====
+
+func (s *catalogServer) CreateRouteOfAdmin(ctx context.Context, in *registrypb.CreateRouteOfAdminRequest) (*registrypb.RouteOfAdmin, error) {
+	return create(ctx, s.db, in.GetRouteOfAdmin(), routeOfAdminFromPB, services.AddRouteOfAdmin, routeOfAdminToPB)
+}
+
+func (s *catalogServer) GetRouteOfAdmin(ctx context.Context, in *registrypb.GetRouteOfAdminRequest) (*registrypb.RouteOfAdmin, error) {
+	return get(ctx, s.db, in.GetId(), services.GetRouteOfAdmin, routeOfAdminToPB)
+}
+
+func (s *catalogServer) UpdateRouteOfAdmin(ctx context.Context, in *registrypb.UpdateRouteOfAdminRequest) (*registrypb.RouteOfAdmin, error) {
+	return update(ctx, s.db, in.GetRouteOfAdmin().GetId(), in.GetRouteOfAdmin(), routeOfAdminFromPB, services.UpdateRouteOfAdmin, routeOfAdminToPB)
+}
+
+func (s *catalogServer) DeleteRouteOfAdmin(ctx context.Context, in *registrypb.DeleteRouteOfAdminRequest) (*emptypb.Empty, error) {
+	return remove(ctx, s.db, in.GetId(), services.DeleteRouteOfAdmin)
+}
+
+func (s *catalogServer) ListRoutesOfAdmin(ctx context.Context, in *registrypb.ListRoutesOfAdminRequest) (*registrypb.ListRoutesOfAdminResponse, error) {
+	items, next, total, err := list(ctx, s.db, models.CodeNameFilter{Code: in.GetCode(), Q: in.GetQ()}, in.GetPageSize(), in.GetPageToken(), in.GetOrderBy(), services.ListRoutesOfAdmin, routeOfAdminToPB)
+	if err != nil {
+		return nil, err
+	}
+	return &registrypb.ListRoutesOfAdminResponse{Items: items, NextPageToken: next, TotalSize: total}, nil
+}
+
+// ===== Api =====
+
+func (s *catalogServer) CreateApi(ctx context.Context, in *registrypb.CreateApiRequest) (*registrypb.Api, error) {
+	return create(ctx, s.db, in.GetApi(), apiFromPB, services.AddAPI, apiToPB)
+}
+
+func (s *catalogServer) GetApi(ctx context.Context, in *registrypb.GetApiRequest) (*registrypb.Api, error) {
+	return get(ctx, s.db, in.GetId(), services.GetAPI, apiToPB)
+}
+
+func (s *catalogServer) UpdateApi(ctx context.Context, in *registrypb.UpdateApiRequest) (*registrypb.Api, error) {
+	return update(ctx, s.db, in.GetApi().GetId(), in.GetApi(), apiFromPB, services.UpdateAPI, apiToPB)
+}
+
+func (s *catalogServer) DeleteApi(ctx context.Context, in *registrypb.DeleteApiRequest) (*emptypb.Empty, error) {
+	return remove(ctx, s.db, in.GetId(), services.DeleteAPI)
+}
+
+func (s *catalogServer) ListApis(ctx context.Context, in *registrypb.ListApisRequest) (*registrypb.ListApisResponse, error) {
+	items, next, total, err := list(ctx, s.db, models.APIFilter{Status: models.APIStatus(in.GetStatus()), Q: in.GetQ()}, in.GetPageSize(), in.GetPageToken(), in.GetOrderBy(), services.ListAPIs, apiToPB)
+	if err != nil {
+		return nil, err
+	}
+	return &registrypb.ListApisResponse{Items: items, NextPageToken: next, TotalSize: total}, nil
+}
+
+// ===== Conversions =====
+
+func dosageFormFromPB(p *registrypb.DosageForm) (models.DosageForm, error) {
+	return models.DosageForm{Code: p.GetCode(), Name: p.GetName(), Names: p.GetNames(), Group: p.GetGroup()}, nil
+}
+
+func dosageFormToPB(m models.DosageForm) *registrypb.DosageForm {
+	return &registrypb.DosageForm{Id: m.ID, Code: m.Code, Name: m.Name, Names: m.Names, Group: m.Group, CreatedAt: timestamp(m.CreatedAt), UpdatedAt: timestamp(m.UpdatedAt)}
+}
+
+func strengthUnitFromPB(p *registrypb.StrengthUnit) (models.StrengthUnit, error) {
+	factor, err := parseDecimal("factor", p.GetFactor())
+	if err != nil {
+		return models.StrengthUnit{}, err
+	}
+	return models.StrengthUnit{Code: p.GetCode(), Name: p.GetName(), Names: p.GetNames(), Dimension: models.UnitDimension(p.GetDimension()), Factor: factor}, nil
+}
+
+func strengthUnitToPB(m models.StrengthUnit) *registrypb.StrengthUnit {
+	return &registrypb.StrengthUnit{Id: m.ID, Code: m.Code, Name: m.Name, Names: m.Names, Dimension: string(m.Dimension), Factor: formatDecimal(m.Factor), CreatedAt: timestamp(m.CreatedAt), UpdatedAt: timestamp(m.UpdatedAt)}
+}
+
+func routeOfAdminFromPB(p *registrypb.RouteOfAdmin) (models.RouteOfAdmin, error) {
+	return models.RouteOfAdmin{Code: p.GetCode(), Name: p.GetName(), Names: p.GetNames()}, nil
+}
+
+func routeOfAdminToPB(m models.RouteOfAdmin) *registrypb.RouteOfAdmin {
+	return &registrypb.RouteOfAdmin{Id: m.ID, Code: m.Code, Name: m.Name, Names: m.Names, CreatedAt: timestamp(m.CreatedAt), UpdatedAt: timestamp(m.UpdatedAt)}
+}
+
+func apiFromPB(p *registrypb.Api) (models.API, error) {
+	return models.API{Name: p.GetName(), Names: p.GetNames(), Status: models.APIStatus(p.GetStatus())}, nil
+}
+
+func apiToPB(m models.API) *registrypb.Api {
+	return &registrypb.Api{Id: m.ID, Name: m.Name, Names: m.Names, Status: string(m.Status), CreatedAt: timestamp(m.CreatedAt), UpdatedAt: timestamp(m.UpdatedAt)}
+}
@@ -0,0 +1,91 @@
+package grpc
+
+import (
+	"context"
+
+	"moh/internal/adapters/grpc/registrypb"
+	"moh/internal/services"
+	"moh/models"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type drugServer struct {
+	registrypb.UnimplementedDrugServiceServer
+	db        *pgxpool.Pool
+	threshold float64
+}
+
+// ===== Drug =====
+
+// CreateDrug refuses a look-alike/sound-alike brand name with
+// FAILED_PRECONDITION similar_brand unless the request sets override_lasa.
+func (s *drugServer) CreateDrug(ctx context.Context, in *registrypb.CreateDrugRequest) (*registrypb.Drug, error) {
+	add := func(ctx context.Context, db services.DBTX, d models.Drug) (models.Drug, error) {
+		if err := services.CheckBrand(ctx, db, d, s.threshold, in.GetOverrideLasa()); err != nil {
+			return models.Drug{}, err
+		}
+		return services.AddDrug(ctx, db, d)
+	}
+	return create(ctx, s.db, in.GetDrug(), drugFromPB, add, drugToPB)
+}
+
+func (s *drugServer) GetDrug(ctx context.Context, in *registrypb.GetDrugRequest) (*registrypb.Drug, error) {
+	return get(ctx, s.db, in.GetId(), services.GetDrug, drugToPB)
+}
+
+func (s *drugServer) UpdateDrug(ctx context.Context, in *registrypb.UpdateDrugRequest) (*registrypb.Drug, error) {
+	return update(ctx, s.db, in.GetDrug().GetId(), in.GetDrug(), drugFromPB, services.UpdateDrug, drugToPB)
+}
+
+func (s *drugServer) DeleteDrug(ctx context.Context, in *registrypb.DeleteDrugRequest) (*emptypb.Empty, error) {
+	return remove(ctx, s.db, in.GetId(), services.DeleteDrug)
+}
+
+func (s *drugServer) ListDrugs(ctx context.Context, in *registrypb.ListDrugsRequest) (*registrypb.ListDrugsResponse, error) {
+	items, next, total, err := list(ctx, s.db, models.DrugFilter{
+		APIID:          in.GetApiId(),
+		DosageFormID:   in.GetDosageFormId(),
+		RouteID:        in.GetRouteId(),
+		StrengthUnitID: in.GetStrengthUnitId(),
+		Q:              in.GetQ(),
+	}, in.GetPageSize(), in.GetPageToken(), in.GetOrderBy(), services.ListDrugs, drugToPB)
+	if err != nil {
+		return nil, err
+	}
+	return &registrypb.ListDrugsResponse{Items: items, NextPageToken: next, TotalSize: total}, nil
+}
+
+// ===== Conversions =====
+
+func drugFromPB(p *registrypb.Drug) (models.Drug, error) {
+	dose, err := parseAmount("dose", p.GetDose())
+	if err != nil {
+		return models.Drug{}, err
+	}
+	return models.Drug{
+		BrandName:      p.GetBrandName(),
+		BrandNames:     p.GetBrandNames(),
+		DosageFormID:   p.GetDosageFormId(),
+		RouteID:        p.GetRouteId(),
+		StrengthUnitID: p.GetStrengthUnitId(),
+		Dose:           dose,
+		APIID:          p.GetApiId(),
+	}, nil
+}
+
+func drugToPB(m models.Drug) *registrypb.Drug {
+	return &registrypb.Drug{
+		Id:             m.ID,
+		BrandName:      m.BrandName,
+		BrandNames:     m.BrandNames,
+		DosageFormId:   m.DosageFormID,
+		RouteId:        m.RouteID,
+		StrengthUnitId: m.StrengthUnitID,
+		Dose:           m.Dose.String(),
+		ApiId:          m.APIID,
+		CreatedAt:      timestamp(m.CreatedAt),
+		UpdatedAt:      timestamp(m.UpdatedAt),
+	}
+}
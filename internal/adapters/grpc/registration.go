@@ -0,0 +1,161 @@
+package grpc
+
+import (
+	"context"
+
+	"moh/internal/adapters/grpc/registrypb"
+	"moh/internal/services"
+	"moh/models"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type registrationServer struct {
+	registrypb.UnimplementedRegistrationServiceServer
+	db *pgxpool.Pool
+}
+
+// ===== DrugRegistration =====
+
+func (s *registrationServer) CreateDrugRegistration(ctx context.Context, in *registrypb.CreateDrugRegistrationRequest) (*registrypb.DrugRegistration, error) {
+	return create(ctx, s.db, in.GetDrugRegistration(), drugRegistrationFromPB, services.AddDrugRegistration, drugRegistrationToPB)
+}
+
+func (s *registrationServer) GetDrugRegistration(ctx context.Context, in *registrypb.GetDrugRegistrationRequest) (*registrypb.DrugRegistration, error) {
+	return get(ctx, s.db, in.GetId(), services.GetDrugRegistration, drugRegistrationToPB)
+}
+
+func (s *registrationServer) UpdateDrugRegistration(ctx context.Context, in *registrypb.UpdateDrugRegistrationRequest) (*registrypb.DrugRegistration, error) {
+	return update(ctx, s.db, in.GetDrugRegistration().GetId(), in.GetDrugRegistration(), drugRegistrationFromPB, services.UpdateDrugRegistration, drugRegistrationToPB)
+}
+
+func (s *registrationServer) DeleteDrugRegistration(ctx context.Context, in *registrypb.DeleteDrugRegistrationRequest) (*emptypb.Empty, error) {
+	return remove(ctx, s.db, in.GetId(), services.DeleteDrugRegistration)
+}
+
+func (s *registrationServer) ListDrugRegistrations(ctx context.Context, in *registrypb.ListDrugRegistrationsRequest) (*registrypb.ListDrugRegistrationsResponse, error) {
+	validOn, err := parseDate("valid_on", in.GetValidOn())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	f := models.DrugRegistrationFilter{
+		Status:    models.RegistrationStatus(in.GetStatus()),
+		DrugID:    in.GetDrugId(),
+		MAID:      in.GetMaId(),
+		ValidOn:   validOn,
+		IsPrimary: in.IsPrimary,
+	}
+	items, next, total, err := list(ctx, s.db, f, in.GetPageSize(), in.GetPageToken(), in.GetOrderBy(), services.ListDrugRegistrations, drugRegistrationToPB)
+	if err != nil {
+		return nil, err
+	}
+	return &registrypb.ListDrugRegistrationsResponse{Items: items, NextPageToken: next, TotalSize: total}, nil
+}
+
+// ===== DrugRegistrationSite =====
+
+func (s *registrationServer) CreateDrugRegistrationSite(ctx context.Context, in *registrypb.CreateDrugRegistrationSiteRequest) (*registrypb.DrugRegistrationSite, error) {
+	return create(ctx, s.db, in.GetDrugRegistrationSite(), drugRegistrationSiteFromPB, services.AddDrugRegistrationSite, drugRegistrationSiteToPB)
+}
+
+func (s *registrationServer) GetDrugRegistrationSite(ctx context.Context, in *registrypb.GetDrugRegistrationSiteRequest) (*registrypb.DrugRegistrationSite, error) {
+	return get(ctx, s.db, in.GetId(), services.GetDrugRegistrationSite, drugRegistrationSiteToPB)
+}
+
+func (s *registrationServer) UpdateDrugRegistrationSite(ctx context.Context, in *registrypb.UpdateDrugRegistrationSiteRequest) (*registrypb.DrugRegistrationSite, error) {
+	return update(ctx, s.db, in.GetDrugRegistrationSite().GetId(), in.GetDrugRegistrationSite(), drugRegistrationSiteFromPB, services.UpdateDrugRegistrationSite, drugRegistrationSiteToPB)
+}
+
+func (s *registrationServer) DeleteDrugRegistrationSite(ctx context.Context, in *registrypb.DeleteDrugRegistrationSiteRequest) (*emptypb.Empty, error) {
+	return remove(ctx, s.db, in.GetId(), services.DeleteDrugRegistrationSite)
+}
+
+func (s *registrationServer) ListDrugRegistrationSites(ctx context.Context, in *registrypb.ListDrugRegistrationSitesRequest) (*registrypb.ListDrugRegistrationSitesResponse, error) {
+	items, next, total, err := list(ctx, s.db, models.DrugRegistrationSiteFilter{DrugRegistrationID: in.GetDrugRegistrationId(), SiteID: in.GetSiteId()}, in.GetPageSize(), in.GetPageToken(), in.GetOrderBy(), services.ListDrugRegistrationSites, drugRegistrationSiteToPB)
+	if err != nil {
+		return nil, err
+	}
+	return &registrypb.ListDrugRegistrationSitesResponse{Items: items, NextPageToken: next, TotalSize: total}, nil
+}
+
+// ===== DrugRegistrationAuthHolder =====
+
+func (s *registrationServer) CreateDrugRegistrationAuthHolder(ctx context.Context, in *registrypb.CreateDrugRegistrationAuthHolderRequest) (*registrypb.DrugRegistrationAuthHolder, error) {
+	return create(ctx, s.db, in.GetDrugRegistrationAuthHolder(), drugRegistrationAuthHolderFromPB, services.AddDrugRegistrationAuthHolder, drugRegistrationAuthHolderToPB)
+}
+
+func (s *registrationServer) GetDrugRegistrationAuthHolder(ctx context.Context, in *registrypb.GetDrugRegistrationAuthHolderRequest) (*registrypb.DrugRegistrationAuthHolder, error) {
+	return get(ctx, s.db, in.GetId(), services.GetDrugRegistrationAuthHolder, drugRegistrationAuthHolderToPB)
+}
+
+func (s *registrationServer) UpdateDrugRegistrationAuthHolder(ctx context.Context, in *registrypb.UpdateDrugRegistrationAuthHolderRequest) (*registrypb.DrugRegistrationAuthHolder, error) {
+	return update(ctx, s.db, in.GetDrugRegistrationAuthHolder().GetId(), in.GetDrugRegistrationAuthHolder(), drugRegistrationAuthHolderFromPB, services.UpdateDrugRegistrationAuthHolder, drugRegistrationAuthHolderToPB)
+}
+
+func (s *registrationServer) DeleteDrugRegistrationAuthHolder(ctx context.Context, in *registrypb.DeleteDrugRegistrationAuthHolderRequest) (*emptypb.Empty, error) {
+	return remove(ctx, s.db, in.GetId(), services.DeleteDrugRegistrationAuthHolder)
+}
+
+func (s *registrationServer) ListDrugRegistrationAuthHolders(ctx context.Context, in *registrypb.ListDrugRegistrationAuthHoldersRequest) (*registrypb.ListDrugRegistrationAuthHoldersResponse, error) {
+	items, next, total, err := list(ctx, s.db, models.DrugRegistrationAuthHolderFilter{DrugRegistrationID: in.GetDrugRegistrationId(), AuthHolderID: in.GetAuthHolderId()}, in.GetPageSize(), in.GetPageToken(), in.GetOrderBy(), services.ListDrugRegistrationAuthHolders, drugRegistrationAuthHolderToPB)
+	if err != nil {
+		return nil, err
+	}
+	return &registrypb.ListDrugRegistrationAuthHoldersResponse{Items: items, NextPageToken: next, TotalSize: total}, nil
+}
+
+// ===== Conversions =====
+
+func drugRegistrationFromPB(p *registrypb.DrugRegistration) (models.DrugRegistration, error) {
+	validFrom, err := parseDate("valid_from", p.GetValidFrom())
+	if err != nil {
+		return models.DrugRegistration{}, err
+	}
+	validTo, err := parseDate("valid_to", p.GetValidTo())
+	if err != nil {
+		return models.DrugRegistration{}, err
+	}
+	return models.DrugRegistration{
+		DrugID:             p.GetDrugId(),
+		MAID:               p.GetMaId(),
+		RegistrationNumber: p.GetRegistrationNumber(),
+		Status:             models.RegistrationStatus(p.GetStatus()),
+		ValidFrom:          validFrom,
+		ValidTo:            validTo,
+		IsPrimary:          p.GetIsPrimary(),
+	}, nil
+}
+
+func drugRegistrationToPB(m models.DrugRegistration) *registrypb.DrugRegistration {
+	return &registrypb.DrugRegistration{
+		Id:                 m.ID,
+		DrugId:             m.DrugID,
+		MaId:               m.MAID,
+		RegistrationNumber: m.RegistrationNumber,
+		Status:             string(m.Status),
+		ValidFrom:          formatDate(m.ValidFrom),
+		ValidTo:            formatDate(m.ValidTo),
+		IsPrimary:          m.IsPrimary,
+		CreatedAt:          timestamp(m.CreatedAt),
+		UpdatedAt:          timestamp(m.UpdatedAt),
+	}
+}
+
+func drugRegistrationSiteFromPB(p *registrypb.DrugRegistrationSite) (models.DrugRegistrationSite, error) {
+	return models.DrugRegistrationSite{DrugRegistrationID: p.GetDrugRegistrationId(), SiteID: p.GetSiteId(), Role: p.GetRole()}, nil
+}
+
+func drugRegistrationSiteToPB(m models.DrugRegistrationSite) *registrypb.DrugRegistrationSite {
+	return &registrypb.DrugRegistrationSite{Id: m.ID, DrugRegistrationId: m.DrugRegistrationID, SiteId: m.SiteID, Role: m.Role}
+}
+
+func drugRegistrationAuthHolderFromPB(p *registrypb.DrugRegistrationAuthHolder) (models.DrugRegistrationAuthHolder, error) {
+	return models.DrugRegistrationAuthHolder{DrugRegistrationID: p.GetDrugRegistrationId(), AuthHolderID: p.GetAuthHolderId(), Role: p.GetRole()}, nil
+}
+
+func drugRegistrationAuthHolderToPB(m models.DrugRegistrationAuthHolder) *registrypb.DrugRegistrationAuthHolder {
+	return &registrypb.DrugRegistrationAuthHolder{Id: m.ID, DrugRegistrationId: m.DrugRegistrationID, AuthHolderId: m.AuthHolderID, Role: m.Role}
+}
@@ -0,0 +1,121 @@
+package grpc
+
+import (
+	"context"
+
+	"moh/internal/adapters/grpc/registrypb"
+	"moh/internal/services"
+	"moh/models"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type registryServer struct {
+	registrypb.UnimplementedRegistryServiceServer
+	db *pgxpool.Pool
+}
+
+// ===== AuthHolder =====
+
+func (s *registryServer) CreateAuthHolder(ctx context.Context, in *registrypb.CreateAuthHolderRequest) (*registrypb.AuthHolder, error) {
+	return create(ctx, s.db, in.GetAuthHolder(), authHolderFromPB, services.AddAuthHolder, authHolderToPB)
+}
+
+func (s *registryServer) GetAuthHolder(ctx context.Context, in *registrypb.GetAuthHolderRequest) (*registrypb.AuthHolder, error) {
+	return get(ctx, s.db, in.GetId(), services.GetAuthHolder, authHolderToPB)
+}
+
+func (s *registryServer) UpdateAuthHolder(ctx context.Context, in *registrypb.UpdateAuthHolderRequest) (*registrypb.AuthHolder, error) {
+	return update(ctx, s.db, in.GetAuthHolder().GetId(), in.GetAuthHolder(), authHolderFromPB, services.UpdateAuthHolder, authHolderToPB)
+}
+
+func (s *registryServer) DeleteAuthHolder(ctx context.Context, in *registrypb.DeleteAuthHolderRequest) (*emptypb.Empty, error) {
+	return remove(ctx, s.db, in.GetId(), services.DeleteAuthHolder)
+}
+
+func (s *registryServer) ListAuthHolders(ctx context.Context, in *registrypb.ListAuthHoldersRequest) (*registrypb.ListAuthHoldersResponse, error) {
+	items, next, total, err := list(ctx, s.db, models.AuthHolderFilter{RegistrationNumber: in.GetRegistrationNumber(), Q: in.GetQ()}, in.GetPageSize(), in.GetPageToken(), in.GetOrderBy(), services.ListAuthHolders, authHolderToPB)
+	if err != nil {
+		return nil, err
+	}
+	return &registrypb.ListAuthHoldersResponse{Items: items, NextPageToken: next, TotalSize: total}, nil
+}
+
+// ===== MarketingAuthorization =====
+
+func (s *registryServer) CreateMarketingAuthorization(ctx context.Context, in *registrypb.CreateMarketingAuthorizationRequest) (*registrypb.MarketingAuthorization, error) {
+	return create(ctx, s.db, in.GetMarketingAuthorization(), marketingAuthorizationFromPB, services.AddMarketingAuthorization, marketingAuthorizationToPB)
+}
+
+func (s *registryServer) GetMarketingAuthorization(ctx context.Context, in *registrypb.GetMarketingAuthorizationRequest) (*registrypb.MarketingAuthorization, error) {
+	return get(ctx, s.db, in.GetId(), services.GetMarketingAuthorization, marketingAuthorizationToPB)
+}
+
+func (s *registryServer) UpdateMarketingAuthorization(ctx context.Context, in *registrypb.UpdateMarketingAuthorizationRequest) (*registrypb.MarketingAuthorization, error) {
+	return update(ctx, s.db, in.GetMarketingAuthorization().GetId(), in.GetMarketingAuthorization(), marketingAuthorizationFromPB, services.UpdateMarketingAuthorization, marketingAuthorizationToPB)
+}
+
+func (s *registryServer) DeleteMarketingAuthorization(ctx context.Context, in *registrypb.DeleteMarketingAuthorizationRequest) (*emptypb.Empty, error) {
+	return remove(ctx, s.db, in.GetId(), services.DeleteMarketingAuthorization)
+}
+
+func (s *registryServer) ListMarketingAuthorizations(ctx context.Context, in *registrypb.ListMarketingAuthorizationsRequest) (*registrypb.ListMarketingAuthorizationsResponse, error) {
+	items, next, total, err := list(ctx, s.db, models.CountryFilter{Country: in.GetCountry(), Q: in.GetQ()}, in.GetPageSize(), in.GetPageToken(), in.GetOrderBy(), services.ListMarketingAuthorizations, marketingAuthorizationToPB)
+	if err != nil {
+		return nil, err
+	}
+	return &registrypb.ListMarketingAuthorizationsResponse{Items: items, NextPageToken: next, TotalSize: total}, nil
+}
+
+// ===== ManufacturingSite =====
+
+func (s *registryServer) CreateManufacturingSite(ctx context.Context, in *registrypb.CreateManufacturingSiteRequest) (*registrypb.ManufacturingSite, error) {
+	return create(ctx, s.db, in.GetManufacturingSite(), manufacturingSiteFromPB, services.AddManufacturingSite, manufacturingSiteToPB)
+}
+
+func (s *registryServer) GetManufacturingSite(ctx context.Context, in *registrypb.GetManufacturingSiteRequest) (*registrypb.ManufacturingSite, error) {
+	return get(ctx, s.db, in.GetId(), services.GetManufacturingSite, manufacturingSiteToPB)
+}
+
+func (s *registryServer) UpdateManufacturingSite(ctx context.Context, in *registrypb.UpdateManufacturingSiteRequest) (*registrypb.ManufacturingSite, error) {
+	return update(ctx, s.db, in.GetManufacturingSite().GetId(), in.GetManufacturingSite(), manufacturingSiteFromPB, services.UpdateManufacturingSite, manufacturingSiteToPB)
+}
+
+func (s *registryServer) DeleteManufacturingSite(ctx context.Context, in *registrypb.DeleteManufacturingSiteRequest) (*emptypb.Empty, error) {
+	return remove(ctx, s.db, in.GetId(), services.DeleteManufacturingSite)
+}
+
+func (s *registryServer) ListManufacturingSites(ctx context.Context, in *registrypb.ListManufacturingSitesRequest) (*registrypb.ListManufacturingSitesResponse, error) {
+	items, next, total, err := list(ctx, s.db, models.CountryFilter{Country: in.GetCountry(), Q: in.GetQ()}, in.GetPageSize(), in.GetPageToken(), in.GetOrderBy(), services.ListManufacturingSites, manufacturingSiteToPB)
+	if err != nil {
+		return nil, err
+	}
+	return &registrypb.ListManufacturingSitesResponse{Items: items, NextPageToken: next, TotalSize: total}, nil
+}
+
+// ===== Conversions =====
+
+func authHolderFromPB(p *registrypb.AuthHolder) (models.AuthHolder, error) {
+	return models.AuthHolder{Name: p.GetName(), RegistrationNumber: p.GetRegistrationNumber()}, nil
+}
+
+func authHolderToPB(m models.AuthHolder) *registrypb.AuthHolder {
+	return &registrypb.AuthHolder{Id: m.ID, Name: m.Name, RegistrationNumber: m.RegistrationNumber, CreatedAt: timestamp(m.CreatedAt), UpdatedAt: timestamp(m.UpdatedAt)}
+}
+
+func marketingAuthorizationFromPB(p *registrypb.MarketingAuthorization) (models.MarketingAuthorization, error) {
+	return models.MarketingAuthorization{Name: p.GetName(), Country: p.GetCountry()}, nil
+}
+
+func marketingAuthorizationToPB(m models.MarketingAuthorization) *registrypb.MarketingAuthorization {
+	return &registrypb.MarketingAuthorization{Id: m.ID, Name: m.Name, Country: m.Country, CreatedAt: timestamp(m.CreatedAt), UpdatedAt: timestamp(m.UpdatedAt)}
+}
+
+func manufacturingSiteFromPB(p *registrypb.ManufacturingSite) (models.ManufacturingSite, error) {
+	return models.ManufacturingSite{Name: p.GetName(), Names: p.GetNames(), Country: p.GetCountry()}, nil
+}
+
+func manufacturingSiteToPB(m models.ManufacturingSite) *registrypb.ManufacturingSite {
+	return &registrypb.ManufacturingSite{Id: m.ID, Name: m.Name, Names: m.Names, Country: m.Country, CreatedAt: timestamp(m.CreatedAt), UpdatedAt: timestamp(m.UpdatedAt)}
+}
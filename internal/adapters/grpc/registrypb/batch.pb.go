@@ -0,0 +1,655 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v5.29.3
+// source: moh/registry/v1/batch.proto
+
+package registrypb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type CreateBatchRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Batch         *Batch                 `protobuf:"bytes,1,opt,name=batch,proto3" json:"batch,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateBatchRequest) Reset() {
+	*x = CreateBatchRequest{}
+	mi := &file_moh_registry_v1_batch_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateBatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBatchRequest) ProtoMessage() {}
+
+func (x *CreateBatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_moh_registry_v1_batch_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBatchRequest.ProtoReflect.Descriptor instead.
+func (*CreateBatchRequest) Descriptor() ([]byte, []int) {
+	return file_moh_registry_v1_batch_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CreateBatchRequest) GetBatch() *Batch {
+	if x != nil {
+		return x.Batch
+	}
+	return nil
+}
+
+type GetBatchRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBatchRequest) Reset() {
+	*x = GetBatchRequest{}
+	mi := &file_moh_registry_v1_batch_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBatchRequest) ProtoMessage() {}
+
+func (x *GetBatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_moh_registry_v1_batch_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBatchRequest.ProtoReflect.Descriptor instead.
+func (*GetBatchRequest) Descriptor() ([]byte, []int) {
+	return file_moh_registry_v1_batch_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetBatchRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// UpdateBatchRequest replaces every editable field of batch.id.
+type UpdateBatchRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Batch         *Batch                 `protobuf:"bytes,1,opt,name=batch,proto3" json:"batch,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateBatchRequest) Reset() {
+	*x = UpdateBatchRequest{}
+	mi := &file_moh_registry_v1_batch_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateBatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateBatchRequest) ProtoMessage() {}
+
+func (x *UpdateBatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_moh_registry_v1_batch_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateBatchRequest.ProtoReflect.Descriptor instead.
+func (*UpdateBatchRequest) Descriptor() ([]byte, []int) {
+	return file_moh_registry_v1_batch_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *UpdateBatchRequest) GetBatch() *Batch {
+	if x != nil {
+		return x.Batch
+	}
+	return nil
+}
+
+type DeleteBatchRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteBatchRequest) Reset() {
+	*x = DeleteBatchRequest{}
+	mi := &file_moh_registry_v1_batch_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteBatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteBatchRequest) ProtoMessage() {}
+
+func (x *DeleteBatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_moh_registry_v1_batch_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteBatchRequest.ProtoReflect.Descriptor instead.
+func (*DeleteBatchRequest) Descriptor() ([]byte, []int) {
+	return file_moh_registry_v1_batch_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *DeleteBatchRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListBatchesRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	PageSize           int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`   // 1..500, default 50
+	PageToken          string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"` // next_page_token of the previous page
+	OrderBy            string                 `protobuf:"bytes,3,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`       // whitelisted field, "-" prefix for descending
+	Status             string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	DrugId             string                 `protobuf:"bytes,5,opt,name=drug_id,json=drugId,proto3" json:"drug_id,omitempty"`
+	DrugRegistrationId string                 `protobuf:"bytes,6,opt,name=drug_registration_id,json=drugRegistrationId,proto3" json:"drug_registration_id,omitempty"`
+	BatchNumber        string                 `protobuf:"bytes,7,opt,name=batch_number,json=batchNumber,proto3" json:"batch_number,omitempty"`
+	ExpireBefore       string                 `protobuf:"bytes,8,opt,name=expire_before,json=expireBefore,proto3" json:"expire_before,omitempty"` // YYYY-MM-DD, exclusive
+	ExpireAfter        string                 `protobuf:"bytes,9,opt,name=expire_after,json=expireAfter,proto3" json:"expire_after,omitempty"`    // YYYY-MM-DD, exclusive
+	PresentationId     string                 `protobuf:"bytes,10,opt,name=presentation_id,json=presentationId,proto3" json:"presentation_id,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *ListBatchesRequest) Reset() {
+	*x = ListBatchesRequest{}
+	mi := &file_moh_registry_v1_batch_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBatchesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBatchesRequest) ProtoMessage() {}
+
+func (x *ListBatchesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_moh_registry_v1_batch_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBatchesRequest.ProtoReflect.Descriptor instead.
+func (*ListBatchesRequest) Descriptor() ([]byte, []int) {
+	return file_moh_registry_v1_batch_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListBatchesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListBatchesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListBatchesRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+func (x *ListBatchesRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListBatchesRequest) GetDrugId() string {
+	if x != nil {
+		return x.DrugId
+	}
+	return ""
+}
+
+func (x *ListBatchesRequest) GetDrugRegistrationId() string {
+	if x != nil {
+		return x.DrugRegistrationId
+	}
+	return ""
+}
+
+func (x *ListBatchesRequest) GetBatchNumber() string {
+	if x != nil {
+		return x.BatchNumber
+	}
+	return ""
+}
+
+func (x *ListBatchesRequest) GetExpireBefore() string {
+	if x != nil {
+		return x.ExpireBefore
+	}
+	return ""
+}
+
+func (x *ListBatchesRequest) GetExpireAfter() string {
+	if x != nil {
+		return x.ExpireAfter
+	}
+	return ""
+}
+
+func (x *ListBatchesRequest) GetPresentationId() string {
+	if x != nil {
+		return x.PresentationId
+	}
+	return ""
+}
+
+type ListBatchesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*Batch               `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	TotalSize     int64                  `protobuf:"varint,3,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBatchesResponse) Reset() {
+	*x = ListBatchesResponse{}
+	mi := &file_moh_registry_v1_batch_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBatchesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBatchesResponse) ProtoMessage() {}
+
+func (x *ListBatchesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_moh_registry_v1_batch_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBatchesResponse.ProtoReflect.Descriptor instead.
+func (*ListBatchesResponse) Descriptor() ([]byte, []int) {
+	return file_moh_registry_v1_batch_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListBatchesResponse) GetItems() []*Batch {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ListBatchesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListBatchesResponse) GetTotalSize() int64 {
+	if x != nil {
+		return x.TotalSize
+	}
+	return 0
+}
+
+type Batch struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Id                 string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	DrugId             string                 `protobuf:"bytes,2,opt,name=drug_id,json=drugId,proto3" json:"drug_id,omitempty"`
+	DrugRegistrationId string                 `protobuf:"bytes,3,opt,name=drug_registration_id,json=drugRegistrationId,proto3" json:"drug_registration_id,omitempty"`
+	BatchNumber        string                 `protobuf:"bytes,4,opt,name=batch_number,json=batchNumber,proto3" json:"batch_number,omitempty"`
+	MfgDate            string                 `protobuf:"bytes,5,opt,name=mfg_date,json=mfgDate,proto3" json:"mfg_date,omitempty"`          // YYYY-MM-DD
+	ExpireDate         string                 `protobuf:"bytes,6,opt,name=expire_date,json=expireDate,proto3" json:"expire_date,omitempty"` // YYYY-MM-DD
+	QtyInBatch         int64                  `protobuf:"varint,7,opt,name=qty_in_batch,json=qtyInBatch,proto3" json:"qty_in_batch,omitempty"`
+	Status             string                 `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"` // planned, released, on_hold, recalled, expired, sold_out, inactive
+	CreatedAt          *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt          *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Currency           string                 `protobuf:"bytes,13,opt,name=currency,proto3" json:"currency,omitempty"`                                   // ISO 4217, required with a price
+	PresentationId     string                 `protobuf:"bytes,15,opt,name=presentation_id,json=presentationId,proto3" json:"presentation_id,omitempty"` // one of the drug's presentations (packs with a GTIN)
+	QtyRemaining       int64                  `protobuf:"varint,16,opt,name=qty_remaining,json=qtyRemaining,proto3" json:"qty_remaining,omitempty"`      // output only: qty_in_batch less the stock ledger's net outflow
+	Price              string                 `protobuf:"bytes,17,opt,name=price,proto3" json:"price,omitempty"`                                         // decimal in currency, e.g. "4.750"; empty is zero
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *Batch) Reset() {
+	*x = Batch{}
+	mi := &file_moh_registry_v1_batch_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Batch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Batch) ProtoMessage() {}
+
+func (x *Batch) ProtoReflect() protoreflect.Message {
+	mi := &file_moh_registry_v1_batch_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Batch.ProtoReflect.Descriptor instead.
+func (*Batch) Descriptor() ([]byte, []int) {
+	return file_moh_registry_v1_batch_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Batch) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Batch) GetDrugId() string {
+	if x != nil {
+		return x.DrugId
+	}
+	return ""
+}
+
+func (x *Batch) GetDrugRegistrationId() string {
+	if x != nil {
+		return x.DrugRegistrationId
+	}
+	return ""
+}
+
+func (x *Batch) GetBatchNumber() string {
+	if x != nil {
+		return x.BatchNumber
+	}
+	return ""
+}
+
+func (x *Batch) GetMfgDate() string {
+	if x != nil {
+		return x.MfgDate
+	}
+	return ""
+}
+
+func (x *Batch) GetExpireDate() string {
+	if x != nil {
+		return x.ExpireDate
+	}
+	return ""
+}
+
+func (x *Batch) GetQtyInBatch() int64 {
+	if x != nil {
+		return x.QtyInBatch
+	}
+	return 0
+}
+
+func (x *Batch) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Batch) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Batch) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Batch) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *Batch) GetPresentationId() string {
+	if x != nil {
+		return x.PresentationId
+	}
+	return ""
+}
+
+func (x *Batch) GetQtyRemaining() int64 {
+	if x != nil {
+		return x.QtyRemaining
+	}
+	return 0
+}
+
+func (x *Batch) GetPrice() string {
+	if x != nil {
+		return x.Price
+	}
+	return ""
+}
+
+var File_moh_registry_v1_batch_proto protoreflect.FileDescriptor
+
+const file_moh_registry_v1_batch_proto_rawDesc = "" +
+	"\n" +
+	"\x1bmoh/registry/v1/batch.proto\x12\x0fmoh.registry.v1\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"B\n" +
+	"\x12CreateBatchRequest\x12,\n" +
+	"\x05batch\x18\x01 \x01(\v2\x16.moh.registry.v1.BatchR\x05batch\"!\n" +
+	"\x0fGetBatchRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"B\n" +
+	"\x12UpdateBatchRequest\x12,\n" +
+	"\x05batch\x18\x01 \x01(\v2\x16.moh.registry.v1.BatchR\x05batch\"$\n" +
+	"\x12DeleteBatchRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\xe2\x02\n" +
+	"\x12ListBatchesRequest\x12\x1b\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\x12\x19\n" +
+	"\border_by\x18\x03 \x01(\tR\aorderBy\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12\x17\n" +
+	"\adrug_id\x18\x05 \x01(\tR\x06drugId\x120\n" +
+	"\x14drug_registration_id\x18\x06 \x01(\tR\x12drugRegistrationId\x12!\n" +
+	"\fbatch_number\x18\a \x01(\tR\vbatchNumber\x12#\n" +
+	"\rexpire_before\x18\b \x01(\tR\fexpireBefore\x12!\n" +
+	"\fexpire_after\x18\t \x01(\tR\vexpireAfter\x12'\n" +
+	"\x0fpresentation_id\x18\n" +
+	" \x01(\tR\x0epresentationId\"\x8a\x01\n" +
+	"\x13ListBatchesResponse\x12,\n" +
+	"\x05items\x18\x01 \x03(\v2\x16.moh.registry.v1.BatchR\x05items\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1d\n" +
+	"\n" +
+	"total_size\x18\x03 \x01(\x03R\ttotalSize\"\x98\x04\n" +
+	"\x05Batch\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\adrug_id\x18\x02 \x01(\tR\x06drugId\x120\n" +
+	"\x14drug_registration_id\x18\x03 \x01(\tR\x12drugRegistrationId\x12!\n" +
+	"\fbatch_number\x18\x04 \x01(\tR\vbatchNumber\x12\x19\n" +
+	"\bmfg_date\x18\x05 \x01(\tR\amfgDate\x12\x1f\n" +
+	"\vexpire_date\x18\x06 \x01(\tR\n" +
+	"expireDate\x12 \n" +
+	"\fqty_in_batch\x18\a \x01(\x03R\n" +
+	"qtyInBatch\x12\x16\n" +
+	"\x06status\x18\b \x01(\tR\x06status\x129\n" +
+	"\n" +
+	"created_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x1a\n" +
+	"\bcurrency\x18\r \x01(\tR\bcurrency\x12'\n" +
+	"\x0fpresentation_id\x18\x0f \x01(\tR\x0epresentationId\x12#\n" +
+	"\rqty_remaining\x18\x10 \x01(\x03R\fqtyRemaining\x12\x14\n" +
+	"\x05price\x18\x11 \x01(\tR\x05priceJ\x04\b\t\x10\n" +
+	"J\x04\b\n" +
+	"\x10\vJ\x04\b\x0e\x10\x0fR\rrecall_reasonR\x04pack2\x92\x03\n" +
+	"\fBatchService\x12J\n" +
+	"\vCreateBatch\x12#.moh.registry.v1.CreateBatchRequest\x1a\x16.moh.registry.v1.Batch\x12D\n" +
+	"\bGetBatch\x12 .moh.registry.v1.GetBatchRequest\x1a\x16.moh.registry.v1.Batch\x12J\n" +
+	"\vUpdateBatch\x12#.moh.registry.v1.UpdateBatchRequest\x1a\x16.moh.registry.v1.Batch\x12J\n" +
+	"\vDeleteBatch\x12#.moh.registry.v1.DeleteBatchRequest\x1a\x16.google.protobuf.Empty\x12X\n" +
+	"\vListBatches\x12#.moh.registry.v1.ListBatchesRequest\x1a$.moh.registry.v1.ListBatchesResponseB2Z0moh/internal/adapters/grpc/registrypb;registrypbb\x06proto3"
+
+var (
+	file_moh_registry_v1_batch_proto_rawDescOnce sync.Once
+	file_moh_registry_v1_batch_proto_rawDescData []byte
+)
+
+func file_moh_registry_v1_batch_proto_rawDescGZIP() []byte {
+	file_moh_registry_v1_batch_proto_rawDescOnce.Do(func() {
+		file_moh_registry_v1_batch_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_moh_registry_v1_batch_proto_rawDesc), len(file_moh_registry_v1_batch_proto_rawDesc)))
+	})
+	return file_moh_registry_v1_batch_proto_rawDescData
+}
+
+var file_moh_registry_v1_batch_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_moh_registry_v1_batch_proto_goTypes = []any{
+	(*CreateBatchRequest)(nil),    // 0: moh.registry.v1.CreateBatchRequest
+	(*GetBatchRequest)(nil),       // 1: moh.registry.v1.GetBatchRequest
+	(*UpdateBatchRequest)(nil),    // 2: moh.registry.v1.UpdateBatchRequest
+	(*DeleteBatchRequest)(nil),    // 3: moh.registry.v1.DeleteBatchRequest
+	(*ListBatchesRequest)(nil),    // 4: moh.registry.v1.ListBatchesRequest
+	(*ListBatchesResponse)(nil),   // 5: moh.registry.v1.ListBatchesResponse
+	(*Batch)(nil),                 // 6: moh.registry.v1.Batch
+	(*timestamppb.Timestamp)(nil), // 7: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),         // 8: google.protobuf.Empty
+}
+var file_moh_registry_v1_batch_proto_depIdxs = []int32{
+	6,  // 0: moh.registry.v1.CreateBatchRequest.batch:type_name -> moh.registry.v1.Batch
+	6,  // 1: moh.registry.v1.UpdateBatchRequest.batch:type_name -> moh.registry.v1.Batch
+	6,  // 2: moh.registry.v1.ListBatchesResponse.items:type_name -> moh.registry.v1.Batch
+	7,  // 3: moh.registry.v1.Batch.created_at:type_name -> google.protobuf.Timestamp
+	7,  // 4: moh.registry.v1.Batch.updated_at:type_name -> google.protobuf.Timestamp
+	0,  // 5: moh.registry.v1.BatchService.CreateBatch:input_type -> moh.registry.v1.CreateBatchRequest
+	1,  // 6: moh.registry.v1.BatchService.GetBatch:input_type -> moh.registry.v1.GetBatchRequest
+	2,  // 7: moh.registry.v1.BatchService.UpdateBatch:input_type -> moh.registry.v1.UpdateBatchRequest
+	3,  // 8: moh.registry.v1.BatchService.DeleteBatch:input_type -> moh.registry.v1.DeleteBatchRequest
+	4,  // 9: moh.registry.v1.BatchService.ListBatches:input_type -> moh.registry.v1.ListBatchesRequest
+	6,  // 10: moh.registry.v1.BatchService.CreateBatch:output_type -> moh.registry.v1.Batch
+	6,  // 11: moh.registry.v1.BatchService.GetBatch:output_type -> moh.registry.v1.Batch
+	6,  // 12: moh.registry.v1.BatchService.UpdateBatch:output_type -> moh.registry.v1.Batch
+	8,  // 13: moh.registry.v1.BatchService.DeleteBatch:output_type -> google.protobuf.Empty
+	5,  // 14: moh.registry.v1.BatchService.ListBatches:output_type -> moh.registry.v1.ListBatchesResponse
+	10, // [10:15] is the sub-list for method output_type
+	5,  // [5:10] is the sub-list for method input_type
+	5,  // [5:5] is the sub-list for extension type_name
+	5,  // [5:5] is the sub-list for extension extendee
+	0,  // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_moh_registry_v1_batch_proto_init() }
+func file_moh_registry_v1_batch_proto_init() {
+	if File_moh_registry_v1_batch_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_moh_registry_v1_batch_proto_rawDesc), len(file_moh_registry_v1_batch_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_moh_registry_v1_batch_proto_goTypes,
+		DependencyIndexes: file_moh_registry_v1_batch_proto_depIdxs,
+		MessageInfos:      file_moh_registry_v1_batch_proto_msgTypes,
+	}.Build()
+	File_moh_registry_v1_batch_proto = out.File
+	file_moh_registry_v1_batch_proto_goTypes = nil
+	file_moh_registry_v1_batch_proto_depIdxs = nil
+}
@@ -0,0 +1,1513 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v5.29.3
+// source: moh/registry/v1/catalog.proto
+
+package registrypb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type CreateDosageFormRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DosageForm    *DosageForm            `protobuf:"bytes,1,opt,name=dosage_form,json=dosageForm,proto3" json:"dosage_form,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateDosageFormRequest) Reset() {
+	*x = CreateDosageFormRequest{}
+	mi := &file_moh_registry_v1_catalog_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateDosageFormRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateDosageFormRequest) ProtoMessage() {}
+
+func (x *CreateDosageFormRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_moh_registry_v1_catalog_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateDosageFormRequest.ProtoReflect.Descriptor instead.
+func (*CreateDosageFormRequest) Descriptor() ([]byte, []int) {
+	return file_moh_registry_v1_catalog_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CreateDosageFormRequest) GetDosageForm() *DosageForm {
+	if x != nil {
+		return x.DosageForm
+	}
+	return nil
+}
+
+type GetDosageFormRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDosageFormRequest) Reset() {
+	*x = GetDosageFormRequest{}
+	mi := &file_moh_registry_v1_catalog_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDosageFormRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDosageFormRequest) ProtoMessage() {}
+
+func (x *GetDosageFormRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_moh_registry_v1_catalog_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDosageFormRequest.ProtoReflect.Descriptor instead.
+func (*GetDosageFormRequest) Descriptor() ([]byte, []int) {
+	return file_moh_registry_v1_catalog_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetDosageFormRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// UpdateDosageFormRequest replaces every editable field of dosage_form.id.
+type UpdateDosageFormRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DosageForm    *DosageForm            `protobuf:"bytes,1,opt,name=dosage_form,json=dosageForm,proto3" json:"dosage_form,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateDosageFormRequest) Reset() {
+	*x = UpdateDosageFormRequest{}
+	mi := &file_moh_registry_v1_catalog_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateDosageFormRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateDosageFormRequest) ProtoMessage() {}
+
+func (x *UpdateDosageFormRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_moh_registry_v1_catalog_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateDosageFormRequest.ProtoReflect.Descriptor instead.
+func (*UpdateDosageFormRequest) Descriptor() ([]byte, []int) {
+	return file_moh_registry_v1_catalog_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *UpdateDosageFormRequest) GetDosageForm() *DosageForm {
+	if x != nil {
+		return x.DosageForm
+	}
+	return nil
+}
+
+type DeleteDosageFormRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteDosageFormRequest) Reset() {
+	*x = DeleteDosageFormRequest{}
+	mi := &file_moh_registry_v1_catalog_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteDosageFormRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteDosageFormRequest) ProtoMessage() {}
+
+func (x *DeleteDosageFormRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_moh_registry_v1_catalog_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteDosageFormRequest.ProtoReflect.Descriptor instead.
+func (*DeleteDosageFormRequest) Descriptor() ([]byte, []int) {
+	return file_moh_registry_v1_catalog_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *DeleteDosageFormRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListDosageFormsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageSize      int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`   // 1..500, default 50
+	PageToken     string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"` // next_page_token of the previous page
+	OrderBy       string                 `protobuf:"bytes,3,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`       // whitelisted field, "-" prefix for descending
+	Code          string                 `protobuf:"bytes,4,opt,name=code,proto3" json:"code,omitempty"`
+	Q             string                 `protobuf:"bytes,5,opt,name=q,proto3" json:"q,omitempty"` // substring of code or name
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDosageFormsRequest) Reset() {
+	*x = ListDosageFormsRequest{}
+	mi := &file_moh_registry_v1_catalog_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDosageFormsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDosageFormsRequest) ProtoMessage() {}
+
+func (x *ListDosageFormsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_moh_registry_v1_catalog_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDosageFormsRequest.ProtoReflect.Descriptor instead.
+func (*ListDosageFormsRequest) Descriptor() ([]byte, []int) {
+	return file_moh_registry_v1_catalog_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListDosageFormsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListDosageFormsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListDosageFormsRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+func (x *ListDosageFormsRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *ListDosageFormsRequest) GetQ() string {
+	if x != nil {
+		return x.Q
+	}
+	return ""
+}
+
+type ListDosageFormsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*DosageForm          `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	TotalSize     int64                  `protobuf:"varint,3,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDosageFormsResponse) Reset() {
+	*x = ListDosageFormsResponse{}
+	mi := &file_moh_registry_v1_catalog_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDosageFormsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDosageFormsResponse) ProtoMessage() {}
+
+func (x *ListDosageFormsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_moh_registry_v1_catalog_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDosageFormsResponse.ProtoReflect.Descriptor instead.
+func (*ListDosageFormsResponse) Descriptor() ([]byte, []int) {
+	return file_moh_registry_v1_catalog_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListDosageFormsResponse) GetItems() []*DosageForm {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ListDosageFormsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListDosageFormsResponse) GetTotalSize() int64 {
+	if x != nil {
+		return x.TotalSize
+	}
+	return 0
+}
+
+type CreateStrengthUnitRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StrengthUnit  *StrengthUnit          `protobuf:"bytes,1,opt,name=strength_unit,json=strengthUnit,proto3" json:"strength_unit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateStrengthUnitRequest) Reset() {
+	*x = CreateStrengthUnitRequest{}
+	mi := &file_moh_registry_v1_catalog_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateStrengthUnitRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateStrengthUnitRequest) ProtoMessage() {}
+
+func (x *CreateStrengthUnitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_moh_registry_v1_catalog_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateStrengthUnitRequest.ProtoReflect.Descriptor instead.
+func (*CreateStrengthUnitRequest) Descriptor() ([]byte, []int) {
+	return file_moh_registry_v1_catalog_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CreateStrengthUnitRequest) GetStrengthUnit() *StrengthUnit {
+	if x != nil {
+		return x.StrengthUnit
+	}
+	return nil
+}
+
+type GetStrengthUnitRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStrengthUnitRequest) Reset() {
+	*x = GetStrengthUnitRequest{}
+	mi := &file_moh_registry_v1_catalog_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStrengthUnitRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStrengthUnitRequest) ProtoMessage() {}
+
+func (x *GetStrengthUnitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_moh_registry_v1_catalog_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStrengthUnitRequest.ProtoReflect.Descriptor instead.
+func (*GetStrengthUnitRequest) Descriptor() ([]byte, []int) {
+	return file_moh_registry_v1_catalog_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetStrengthUnitRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// UpdateStrengthUnitRequest replaces every editable field of strength_unit.id.
+type UpdateStrengthUnitRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StrengthUnit  *StrengthUnit          `protobuf:"bytes,1,opt,name=strength_unit,json=strengthUnit,proto3" json:"strength_unit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateStrengthUnitRequest) Reset() {
+	*x = UpdateStrengthUnitRequest{}
+	mi := &file_moh_registry_v1_catalog_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateStrengthUnitRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateStrengthUnitRequest) ProtoMessage() {}
+
+func (x *UpdateStrengthUnitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_moh_registry_v1_catalog_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateStrengthUnitRequest.ProtoReflect.Descriptor instead.
+func (*UpdateStrengthUnitRequest) Descriptor() ([]byte, []int) {
+	return file_moh_registry_v1_catalog_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *UpdateStrengthUnitRequest) GetStrengthUnit() *StrengthUnit {
+	if x != nil {
+		return x.StrengthUnit
+	}
+	return nil
+}
+
+type DeleteStrengthUnitRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteStrengthUnitRequest) Reset() {
+	*x = DeleteStrengthUnitRequest{}
+	mi := &file_moh_registry_v1_catalog_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteStrengthUnitRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteStrengthUnitRequest) ProtoMessage() {}
+
+func (x *DeleteStrengthUnitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_moh_registry_v1_catalog_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteStrengthUnitRequest.ProtoReflect.Descriptor instead.
+func (*DeleteStrengthUnitRequest) Descriptor() ([]byte, []int) {
+	return file_moh_registry_v1_catalog_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *DeleteStrengthUnitRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListStrengthUnitsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageSize      int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`   // 1..500, default 50
+	PageToken     string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"` // next_page_token of the previous page
+	OrderBy       string                 `protobuf:"bytes,3,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`       // whitelisted field, "-" prefix for descending
+	Code          string                 `protobuf:"bytes,4,opt,name=code,proto3" json:"code,omitempty"`
+	Q             string                 `protobuf:"bytes,5,opt,name=q,proto3" json:"q,omitempty"` // substring of code or name
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListStrengthUnitsRequest) Reset() {
+	*x = ListStrengthUnitsRequest{}
+	mi := &file_moh_registry_v1_catalog_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListStrengthUnitsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStrengthUnitsRequest) ProtoMessage() {}
+
+func (x *ListStrengthUnitsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_moh_registry_v1_catalog_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStrengthUnitsRequest.ProtoReflect.Descriptor instead.
+func (*ListStrengthUnitsRequest) Descriptor() ([]byte, []int) {
+	return file_moh_registry_v1_catalog_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListStrengthUnitsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListStrengthUnitsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListStrengthUnitsRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+func (x *ListStrengthUnitsRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *ListStrengthUnitsRequest) GetQ() string {
+	if x != nil {
+		return x.Q
+	}
+	return ""
+}
+
+type ListStrengthUnitsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*StrengthUnit        `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	TotalSize     int64                  `protobuf:"varint,3,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListStrengthUnitsResponse) Reset() {
+	*x = ListStrengthUnitsResponse{}
+	mi := &file_moh_registry_v1_catalog_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListStrengthUnitsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStrengthUnitsResponse) ProtoMessage() {}
+
+func (x *ListStrengthUnitsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_moh_registry_v1_catalog_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStrengthUnitsResponse.ProtoReflect.Descriptor instead.
+func (*ListStrengthUnitsResponse) Descriptor() ([]byte, []int) {
+	return file_moh_registry_v1_catalog_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ListStrengthUnitsResponse) GetItems() []*StrengthUnit {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ListStrengthUnitsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListStrengthUnitsResponse) GetTotalSize() int64 {
+	if x != nil {
+		return x.TotalSize
+	}
+	return 0
+}
+
+type CreateRouteOfAdminRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RouteOfAdmin  *RouteOfAdmin          `protobuf:"bytes,1,opt,name=route_of_admin,json=routeOfAdmin,proto3" json:"route_of_admin,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateRouteOfAdminRequest) Reset() {
+	*x = CreateRouteOfAdminRequest{}
+	mi := &file_moh_registry_v1_catalog_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateRouteOfAdminRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRouteOfAdminRequest) ProtoMessage() {}
+
+func (x *CreateRouteOfAdminRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_moh_registry_v1_catalog_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRouteOfAdminRequest.ProtoReflect.Descriptor instead.
+func (*CreateRouteOfAdminRequest) Descriptor() ([]byte, []int) {
+	return file_moh_registry_v1_catalog_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *CreateRouteOfAdminRequest) GetRouteOfAdmin() *RouteOfAdmin {
+	if x != nil {
+		return x.RouteOfAdmin
+	}
+	return nil
+}
+
+type GetRouteOfAdminRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRouteOfAdminRequest) Reset() {
+	*x = GetRouteOfAdminRequest{}
+	mi := &file_moh_registry_v1_catalog_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRouteOfAdminRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRouteOfAdminRequest) ProtoMessage() {}
+
+func (x *GetRouteOfAdminRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_moh_registry_v1_catalog_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRouteOfAdminRequest.ProtoReflect.Descriptor instead.
+func (*GetRouteOfAdminRequest) Descriptor() ([]byte, []int) {
+	return file_moh_registry_v1_catalog_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetRouteOfAdminRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// UpdateRouteOfAdminRequest replaces every editable field of route_of_admin.id.
+type UpdateRouteOfAdminRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RouteOfAdmin  *RouteOfAdmin          `protobuf:"bytes,1,opt,name=route_of_admin,json=routeOfAdmin,proto3" json:"route_of_admin,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateRouteOfAdminRequest) Reset() {
+	*x = UpdateRouteOfAdminRequest{}
+	mi := &file_moh_registry_v1_catalog_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateRouteOfAdminRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRouteOfAdminRequest) ProtoMessage() {}
+
+func (x *UpdateRouteOfAdminRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_moh_registry_v1_catalog_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRouteOfAdminRequest.ProtoReflect.Descriptor instead.
+func (*UpdateRouteOfAdminRequest) Descriptor() ([]byte, []int) {
+	return file_moh_registry_v1_catalog_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *UpdateRouteOfAdminRequest) GetRouteOfAdmin() *RouteOfAdmin {
+	if x != nil {
+		return x.RouteOfAdmin
+	}
+	return nil
+}
+
+type DeleteRouteOfAdminRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteRouteOfAdminRequest) Reset() {
+	*x = DeleteRouteOfAdminRequest{}
+	mi := &file_moh_registry_v1_catalog_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteRouteOfAdminRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRouteOfAdminRequest) ProtoMessage() {}
+
+func (x *DeleteRouteOfAdminRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_moh_registry_v1_catalog_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRouteOfAdminRequest.ProtoReflect.Descriptor instead.
+func (*DeleteRouteOfAdminRequest) Descriptor() ([]byte, []int) {
+	return file_moh_registry_v1_catalog_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *DeleteRouteOfAdminRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListRoutesOfAdminRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageSize      int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`   // 1..500, default 50
+	PageToken     string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"` // next_page_token of the previous page
+	OrderBy       string                 `protobuf:"bytes,3,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`       // whitelisted field, "-" prefix for descending
+	Code          string                 `protobuf:"bytes,4,opt,name=code,proto3" json:"code,omitempty"`
+	Q             string                 `protobuf:"bytes,5,opt,name=q,proto3" json:"q,omitempty"` // substring of code or name
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRoutesOfAdminRequest) Reset() {
+	*x = ListRoutesOfAdminRequest{}
+	mi := &file_moh_registry_v1_catalog_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRoutesOfAdminRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRoutesOfAdminRequest) ProtoMessage() {}
+
+func (x *ListRoutesOfAdminRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_moh_registry_v1_catalog_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRoutesOfAdminRequest.ProtoReflect.Descriptor instead.
+func (*ListRoutesOfAdminRequest) Descriptor() ([]byte, []int) {
+	return file_moh_registry_v1_catalog_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ListRoutesOfAdminRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListRoutesOfAdminRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListRoutesOfAdminRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+func (x *ListRoutesOfAdminRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *ListRoutesOfAdminRequest) GetQ() string {
+	if x != nil {
+		return x.Q
+	}
+	return ""
+}
+
+type ListRoutesOfAdminResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*RouteOfAdmin        `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	TotalSize     int64                  `protobuf:"varint,3,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRoutesOfAdminResponse) Reset() {
+	*x = ListRoutesOfAdminResponse{}
+	mi := &file_moh_registry_v1_catalog_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRoutesOfAdminResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRoutesOfAdminResponse) ProtoMessage() {}
+
+func (x *ListRoutesOfAdminResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_moh_registry_v1_catalog_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRoutesOfAdminResponse.ProtoReflect.Descriptor instead.
+func (*ListRoutesOfAdminResponse) Descriptor() ([]byte, []int) {
+	return file_moh_registry_v1_catalog_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ListRoutesOfAdminResponse) GetItems() []*RouteOfAdmin {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ListRoutesOfAdminResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListRoutesOfAdminResponse) GetTotalSize() int64 {
+	if x != nil {
+		return x.TotalSize
+	}
+	return 0
+}
+
+type CreateApiRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Api           *Api                   `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateApiRequest) Reset() {
+	*x = CreateApiRequest{}
+	mi := &file_moh_registry_v1_catalog_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateApiRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateApiRequest) ProtoMessage() {}
+
+func (x *CreateApiRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_moh_registry_v1_catalog_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateApiRequest.ProtoReflect.Descriptor instead.
+func (*CreateApiRequest) Descriptor() ([]byte, []int) {
+	return file_moh_registry_v1_catalog_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *CreateApiRequest) GetApi() *Api {
+	if x != nil {
+		return x.Api
+	}
+	return nil
+}
+
+type GetApiRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetApiRequest) Reset() {
+	*x = GetApiRequest{}
+	mi := &file_moh_registry_v1_catalog_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetApiRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetApiRequest) ProtoMessage() {}
+
+func (x *GetApiRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_moh_registry_v1_catalog_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetApiRequest.ProtoReflect.Descriptor instead.
+func (*GetApiRequest) Descriptor() ([]byte, []int) {
+	return file_moh_registry_v1_catalog_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetApiRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// UpdateApiRequest replaces every editable field of api.id.
+type UpdateApiRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Api           *Api                   `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateApiRequest) Reset() {
+	*x = UpdateApiRequest{}
+	mi := &file_moh_registry_v1_catalog_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateApiRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateApiRequest) ProtoMessage() {}
+
+func (x *UpdateApiRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_moh_registry_v1_catalog_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateApiRequest.ProtoReflect.Descriptor instead.
+func (*UpdateApiRequest) Descriptor() ([]byte, []int) {
+	return file_moh_registry_v1_catalog_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *UpdateApiRequest) GetApi() *Api {
+	if x != nil {
+		return x.Api
+	}
+	return nil
+}
+
+type DeleteApiRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteApiRequest) Reset() {
+	*x = DeleteApiRequest{}
+	mi := &file_moh_registry_v1_catalog_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteApiRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteApiRequest) ProtoMessage() {}
+
+func (x *DeleteApiRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_moh_registry_v1_catalog_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteApiRequest.ProtoReflect.Descriptor instead.
+func (*DeleteApiRequest) Descriptor() ([]byte, []int) {
+	return file_moh_registry_v1_catalog_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *DeleteApiRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListApisRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageSize      int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`   // 1..500, default 50
+	PageToken     string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"` // next_page_token of the previous page
+	OrderBy       string                 `protobuf:"bytes,3,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`       // whitelisted field, "-" prefix for descending
+	Status        string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	Q             string                 `protobuf:"bytes,5,opt,name=q,proto3" json:"q,omitempty"` // substring of name
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListApisRequest) Reset() {
+	*x = ListApisRequest{}
+	mi := &file_moh_registry_v1_catalog_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListApisRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListApisRequest) ProtoMessage() {}
+
+func (x *ListApisRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_moh_registry_v1_catalog_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListApisRequest.ProtoReflect.Descriptor instead.
+func (*ListApisRequest) Descriptor() ([]byte, []int) {
+	return file_moh_registry_v1_catalog_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ListApisRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListApisRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListApisRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+func (x *ListApisRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListApisRequest) GetQ() string {
+	if x != nil {
+		return x.Q
+	}
+	return ""
+}
+
+type ListApisResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*Api                 `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	TotalSize     int64                  `protobuf:"varint,3,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListApisResponse) Reset() {
+	*x = ListApisResponse{}
+	mi := &file_moh_registry_v1_catalog_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListApisResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListApisResponse) ProtoMessage() {}
+
+func (x *ListApisResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_moh_registry_v1_catalog_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListApisResponse.ProtoReflect.Descriptor instead.
+func (*ListApisResponse) Descriptor() ([]byte, []int) {
+	return file_moh_registry_v1_catalog_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ListApisResponse) GetItems() []*Api {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ListApisResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListApisResponse) GetTotalSize() int64 {
+	if x != nil {
+		return x.TotalSize
+	}
+	return 0
+}
+
+var File_moh_registry_v1_catalog_proto protoreflect.FileDescriptor
+
+const file_moh_registry_v1_catalog_proto_rawDesc = "" +
+	"\n" +
+	"\x1dmoh/registry/v1/catalog.proto\x12\x0fmoh.registry.v1\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1cmoh/registry/v1/common.proto\"W\n" +
+	"\x17CreateDosageFormRequest\x12<\n" +
+	"\vdosage_form\x18\x01 \x01(\v2\x1b.moh.registry.v1.DosageFormR\n" +
+	"dosageForm\"&\n" +
+	"\x14GetDosageFormRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"W\n" +
+	"\x17UpdateDosageFormRequest\x12<\n" +
+	"\vdosage_form\x18\x01 \x01(\v2\x1b.moh.registry.v1.DosageFormR\n" +
+	"dosageForm\")\n" +
+	"\x17DeleteDosageFormRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x91\x01\n" +
+	"\x16ListDosageFormsRequest\x12\x1b\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\x12\x19\n" +
+	"\border_by\x18\x03 \x01(\tR\aorderBy\x12\x12\n" +
+	"\x04code\x18\x04 \x01(\tR\x04code\x12\f\n" +
+	"\x01q\x18\x05 \x01(\tR\x01q\"\x93\x01\n" +
+	"\x17ListDosageFormsResponse\x121\n" +
+	"\x05items\x18\x01 \x03(\v2\x1b.moh.registry.v1.DosageFormR\x05items\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1d\n" +
+	"\n" +
+	"total_size\x18\x03 \x01(\x03R\ttotalSize\"_\n" +
+	"\x19CreateStrengthUnitRequest\x12B\n" +
+	"\rstrength_unit\x18\x01 \x01(\v2\x1d.moh.registry.v1.StrengthUnitR\fstrengthUnit\"(\n" +
+	"\x16GetStrengthUnitRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"_\n" +
+	"\x19UpdateStrengthUnitRequest\x12B\n" +
+	"\rstrength_unit\x18\x01 \x01(\v2\x1d.moh.registry.v1.StrengthUnitR\fstrengthUnit\"+\n" +
+	"\x19DeleteStrengthUnitRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x93\x01\n" +
+	"\x18ListStrengthUnitsRequest\x12\x1b\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\x12\x19\n" +
+	"\border_by\x18\x03 \x01(\tR\aorderBy\x12\x12\n" +
+	"\x04code\x18\x04 \x01(\tR\x04code\x12\f\n" +
+	"\x01q\x18\x05 \x01(\tR\x01q\"\x97\x01\n" +
+	"\x19ListStrengthUnitsResponse\x123\n" +
+	"\x05items\x18\x01 \x03(\v2\x1d.moh.registry.v1.StrengthUnitR\x05items\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1d\n" +
+	"\n" +
+	"total_size\x18\x03 \x01(\x03R\ttotalSize\"`\n" +
+	"\x19CreateRouteOfAdminRequest\x12C\n" +
+	"\x0eroute_of_admin\x18\x01 \x01(\v2\x1d.moh.registry.v1.RouteOfAdminR\frouteOfAdmin\"(\n" +
+	"\x16GetRouteOfAdminRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"`\n" +
+	"\x19UpdateRouteOfAdminRequest\x12C\n" +
+	"\x0eroute_of_admin\x18\x01 \x01(\v2\x1d.moh.registry.v1.RouteOfAdminR\frouteOfAdmin\"+\n" +
+	"\x19DeleteRouteOfAdminRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x93\x01\n" +
+	"\x18ListRoutesOfAdminRequest\x12\x1b\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\x12\x19\n" +
+	"\border_by\x18\x03 \x01(\tR\aorderBy\x12\x12\n" +
+	"\x04code\x18\x04 \x01(\tR\x04code\x12\f\n" +
+	"\x01q\x18\x05 \x01(\tR\x01q\"\x97\x01\n" +
+	"\x19ListRoutesOfAdminResponse\x123\n" +
+	"\x05items\x18\x01 \x03(\v2\x1d.moh.registry.v1.RouteOfAdminR\x05items\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1d\n" +
+	"\n" +
+	"total_size\x18\x03 \x01(\x03R\ttotalSize\":\n" +
+	"\x10CreateApiRequest\x12&\n" +
+	"\x03api\x18\x01 \x01(\v2\x14.moh.registry.v1.ApiR\x03api\"\x1f\n" +
+	"\rGetApiRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\":\n" +
+	"\x10UpdateApiRequest\x12&\n" +
+	"\x03api\x18\x01 \x01(\v2\x14.moh.registry.v1.ApiR\x03api\"\"\n" +
+	"\x10DeleteApiRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x8e\x01\n" +
+	"\x0fListApisRequest\x12\x1b\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\x12\x19\n" +
+	"\border_by\x18\x03 \x01(\tR\aorderBy\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12\f\n" +
+	"\x01q\x18\x05 \x01(\tR\x01q\"\x85\x01\n" +
+	"\x10ListApisResponse\x12*\n" +
+	"\x05items\x18\x01 \x03(\v2\x14.moh.registry.v1.ApiR\x05items\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1d\n" +
+	"\n" +
+	"total_size\x18\x03 \x01(\x03R\ttotalSize2\x82\x0e\n" +
+	"\x0eCatalogService\x12Y\n" +
+	"\x10CreateDosageForm\x12(.moh.registry.v1.CreateDosageFormRequest\x1a\x1b.moh.registry.v1.DosageForm\x12S\n" +
+	"\rGetDosageForm\x12%.moh.registry.v1.GetDosageFormRequest\x1a\x1b.moh.registry.v1.DosageForm\x12Y\n" +
+	"\x10UpdateDosageForm\x12(.moh.registry.v1.UpdateDosageFormRequest\x1a\x1b.moh.registry.v1.DosageForm\x12T\n" +
+	"\x10DeleteDosageForm\x12(.moh.registry.v1.DeleteDosageFormRequest\x1a\x16.google.protobuf.Empty\x12d\n" +
+	"\x0fListDosageForms\x12'.moh.registry.v1.ListDosageFormsRequest\x1a(.moh.registry.v1.ListDosageFormsResponse\x12_\n" +
+	"\x12CreateStrengthUnit\x12*.moh.registry.v1.CreateStrengthUnitRequest\x1a\x1d.moh.registry.v1.StrengthUnit\x12Y\n" +
+	"\x0fGetStrengthUnit\x12'.moh.registry.v1.GetStrengthUnitRequest\x1a\x1d.moh.registry.v1.StrengthUnit\x12_\n" +
+	"\x12UpdateStrengthUnit\x12*.moh.registry.v1.UpdateStrengthUnitRequest\x1a\x1d.moh.registry.v1.StrengthUnit\x12X\n" +
+	"\x12DeleteStrengthUnit\x12*.moh.registry.v1.DeleteStrengthUnitRequest\x1a\x16.google.protobuf.Empty\x12j\n" +
+	"\x11ListStrengthUnits\x12).moh.registry.v1.ListStrengthUnitsRequest\x1a*.moh.registry.v1.ListStrengthUnitsResponse\x12_\n" +
+	"\x12CreateRouteOfAdmin\x12*.moh.registry.v1.CreateRouteOfAdminRequest\x1a\x1d.moh.registry.v1.RouteOfAdmin\x12Y\n" +
+	"\x0fGetRouteOfAdmin\x12'.moh.registry.v1.GetRouteOfAdminRequest\x1a\x1d.moh.registry.v1.RouteOfAdmin\x12_\n" +
+	"\x12UpdateRouteOfAdmin\x12*.moh.registry.v1.UpdateRouteOfAdminRequest\x1a\x1d.moh.registry.v1.RouteOfAdmin\x12X\n" +
+	"\x12DeleteRouteOfAdmin\x12*.moh.registry.v1.DeleteRouteOfAdminRequest\x1a\x16.google.protobuf.Empty\x12j\n" +
+	"\x11ListRoutesOfAdmin\x12).moh.registry.v1.ListRoutesOfAdminRequest\x1a*.moh.registry.v1.ListRoutesOfAdminResponse\x12D\n" +
+	"\tCreateApi\x12!.moh.registry.v1.CreateApiRequest\x1a\x14.moh.registry.v1.Api\x12>\n" +
+	"\x06GetApi\x12\x1e.moh.registry.v1.GetApiRequest\x1a\x14.moh.registry.v1.Api\x12D\n" +
+	"\tUpdateApi\x12!.moh.registry.v1.UpdateApiRequest\x1a\x14.moh.registry.v1.Api\x12F\n" +
+	"\tDeleteApi\x12!.moh.registry.v1.DeleteApiRequest\x1a\x16.google.protobuf.Empty\x12O\n" +
+	"\bListApis\x12 .moh.registry.v1.ListApisRequest\x1a!.moh.registry.v1.ListApisResponseB2Z0moh/internal/adapters/grpc/registrypb;registrypbb\x06proto3"
+
+var (
+	file_moh_registry_v1_catalog_proto_rawDescOnce sync.Once
+	file_moh_registry_v1_catalog_proto_rawDescData []byte
+)
+
+func file_moh_registry_v1_catalog_proto_rawDescGZIP() []byte {
+	file_moh_registry_v1_catalog_proto_rawDescOnce.Do(func() {
+		file_moh_registry_v1_catalog_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_moh_registry_v1_catalog_proto_rawDesc), len(file_moh_registry_v1_catalog_proto_rawDesc)))
+	})
+	return file_moh_registry_v1_catalog_proto_rawDescData
+}
+
+var file_moh_registry_v1_catalog_proto_msgTypes = make([]protoimpl.MessageInfo, 24)
+var file_moh_registry_v1_catalog_proto_goTypes = []any{
+	(*CreateDosageFormRequest)(nil),   // 0: moh.registry.v1.CreateDosageFormRequest
+	(*GetDosageFormRequest)(nil),      // 1: moh.registry.v1.GetDosageFormRequest
+	(*UpdateDosageFormRequest)(nil),   // 2: moh.registry.v1.UpdateDosageFormRequest
+	(*DeleteDosageFormRequest)(nil),   // 3: moh.registry.v1.DeleteDosageFormRequest
+	(*ListDosageFormsRequest)(nil),    // 4: moh.registry.v1.ListDosageFormsRequest
+	(*ListDosageFormsResponse)(nil),   // 5: moh.registry.v1.ListDosageFormsResponse
+	(*CreateStrengthUnitRequest)(nil), // 6: moh.registry.v1.CreateStrengthUnitRequest
+	(*GetStrengthUnitRequest)(nil),    // 7: moh.registry.v1.GetStrengthUnitRequest
+	(*UpdateStrengthUnitRequest)(nil), // 8: moh.registry.v1.UpdateStrengthUnitRequest
+	(*DeleteStrengthUnitRequest)(nil), // 9: moh.registry.v1.DeleteStrengthUnitRequest
+	(*ListStrengthUnitsRequest)(nil),  // 10: moh.registry.v1.ListStrengthUnitsRequest
+	(*ListStrengthUnitsResponse)(nil), // 11: moh.registry.v1.ListStrengthUnitsResponse
+	(*CreateRouteOfAdminRequest)(nil), // 12: moh.registry.v1.CreateRouteOfAdminRequest
+	(*GetRouteOfAdminRequest)(nil),    // 13: moh.registry.v1.GetRouteOfAdminRequest
+	(*UpdateRouteOfAdminRequest)(nil), // 14: moh.registry.v1.UpdateRouteOfAdminRequest
+	(*DeleteRouteOfAdminRequest)(nil), // 15: moh.registry.v1.DeleteRouteOfAdminRequest
+	(*ListRoutesOfAdminRequest)(nil),  // 16: moh.registry.v1.ListRoutesOfAdminRequest
+	(*ListRoutesOfAdminResponse)(nil), // 17: moh.registry.v1.ListRoutesOfAdminResponse
+	(*CreateApiRequest)(nil),          // 18: moh.registry.v1.CreateApiRequest
+	(*GetApiRequest)(nil),             // 19: moh.registry.v1.GetApiRequest
+	(*UpdateApiRequest)(nil),          // 20: moh.registry.v1.UpdateApiRequest
+	(*DeleteApiRequest)(nil),          // 21: moh.registry.v1.DeleteApiRequest
+	(*ListApisRequest)(nil),           // 22: moh.registry.v1.ListApisRequest
+	(*ListApisResponse)(nil),          // 23: moh.registry.v1.ListApisResponse
+	(*DosageForm)(nil),                // 24: moh.registry.v1.DosageForm
+	(*StrengthUnit)(nil),              // 25: moh.registry.v1.StrengthUnit
+	(*RouteOfAdmin)(nil),              // 26: moh.registry.v1.RouteOfAdmin
+	(*Api)(nil),                       // 27: moh.registry.v1.Api
+	(*emptypb.Empty)(nil),             // 28: google.protobuf.Empty
+}
+var file_moh_registry_v1_catalog_proto_depIdxs = []int32{
+	24, // 0: moh.registry.v1.CreateDosageFormRequest.dosage_form:type_name -> moh.registry.v1.DosageForm
+	24, // 1: moh.registry.v1.UpdateDosageFormRequest.dosage_form:type_name -> moh.registry.v1.DosageForm
+	24, // 2: moh.registry.v1.ListDosageFormsResponse.items:type_name -> moh.registry.v1.DosageForm
+	25, // 3: moh.registry.v1.CreateStrengthUnitRequest.strength_unit:type_name -> moh.registry.v1.StrengthUnit
+	25, // 4: moh.registry.v1.UpdateStrengthUnitRequest.strength_unit:type_name -> moh.registry.v1.StrengthUnit
+	25, // 5: moh.registry.v1.ListStrengthUnitsResponse.items:type_name -> moh.registry.v1.StrengthUnit
+	26, // 6: moh.registry.v1.CreateRouteOfAdminRequest.route_of_admin:type_name -> moh.registry.v1.RouteOfAdmin
+	26, // 7: moh.registry.v1.UpdateRouteOfAdminRequest.route_of_admin:type_name -> moh.registry.v1.RouteOfAdmin
+	26, // 8: moh.registry.v1.ListRoutesOfAdminResponse.items:type_name -> moh.registry.v1.RouteOfAdmin
+	27, // 9: moh.registry.v1.CreateApiRequest.api:type_name -> moh.registry.v1.Api
+	27, // 10: moh.registry.v1.UpdateApiRequest.api:type_name -> moh.registry.v1.Api
+	27, // 11: moh.registry.v1.ListApisResponse.items:type_name -> moh.registry.v1.Api
+	0,  // 12: moh.registry.v1.CatalogService.CreateDosageForm:input_type -> moh.registry.v1.CreateDosageFormRequest
+	1,  // 13: moh.registry.v1.CatalogService.GetDosageForm:input_type -> moh.registry.v1.GetDosageFormRequest
+	2,  // 14: moh.registry.v1.CatalogService.UpdateDosageForm:input_type -> moh.registry.v1.UpdateDosageFormRequest
+	3,  // 15: moh.registry.v1.CatalogService.DeleteDosageForm:input_type -> moh.registry.v1.DeleteDosageFormRequest
+	4,  // 16: moh.registry.v1.CatalogService.ListDosageForms:input_type -> moh.registry.v1.ListDosageFormsRequest
+	6,  // 17: moh.registry.v1.CatalogService.CreateStrengthUnit:input_type -> moh.registry.v1.CreateStrengthUnitRequest
+	7,  // 18: moh.registry.v1.CatalogService.GetStrengthUnit:input_type -> moh.registry.v1.GetStrengthUnitRequest
+	8,  // 19: moh.registry.v1.CatalogService.UpdateStrengthUnit:input_type -> moh.registry.v1.UpdateStrengthUnitRequest
+	9,  // 20: moh.registry.v1.CatalogService.DeleteStrengthUnit:input_type -> moh.registry.v1.DeleteStrengthUnitRequest
+	10, // 21: moh.registry.v1.CatalogService.ListStrengthUnits:input_type -> moh.registry.v1.ListStrengthUnitsRequest
+	12, // 22: moh.registry.v1.CatalogService.CreateRouteOfAdmin:input_type -> moh.registry.v1.CreateRouteOfAdminRequest
+	13, // 23: moh.registry.v1.CatalogService.GetRouteOfAdmin:input_type -> moh.registry.v1.GetRouteOfAdminRequest
+	14, // 24: moh.registry.v1.CatalogService.UpdateRouteOfAdmin:input_type -> moh.registry.v1.UpdateRouteOfAdminRequest
+	15, // 25: moh.registry.v1.CatalogService.DeleteRouteOfAdmin:input_type -> moh.registry.v1.DeleteRouteOfAdminRequest
+	16, // 26: moh.registry.v1.CatalogService.ListRoutesOfAdmin:input_type -> moh.registry.v1.ListRoutesOfAdminRequest
+	18, // 27: moh.registry.v1.CatalogService.CreateApi:input_type -> moh.registry.v1.CreateApiRequest
+	19, // 28: moh.registry.v1.CatalogService.GetApi:input_type -> moh.registry.v1.GetApiRequest
+	20, // 29: moh.registry.v1.CatalogService.UpdateApi:input_type -> moh.registry.v1.UpdateApiRequest
+	21, // 30: moh.registry.v1.CatalogService.DeleteApi:input_type -> moh.registry.v1.DeleteApiRequest
+	22, // 31: moh.registry.v1.CatalogService.ListApis:input_type -> moh.registry.v1.ListApisRequest
+	24, // 32: moh.registry.v1.CatalogService.CreateDosageForm:output_type -> moh.registry.v1.DosageForm
+	24, // 33: moh.registry.v1.CatalogService.GetDosageForm:output_type -> moh.registry.v1.DosageForm
+	24, // 34: moh.registry.v1.CatalogService.UpdateDosageForm:output_type -> moh.registry.v1.DosageForm
+	28, // 35: moh.registry.v1.CatalogService.DeleteDosageForm:output_type -> google.protobuf.Empty
+	5,  // 36: moh.registry.v1.CatalogService.ListDosageForms:output_type -> moh.registry.v1.ListDosageFormsResponse
+	25, // 37: moh.registry.v1.CatalogService.CreateStrengthUnit:output_type -> moh.registry.v1.StrengthUnit
+	25, // 38: moh.registry.v1.CatalogService.GetStrengthUnit:output_type -> moh.registry.v1.StrengthUnit
+	25, // 39: moh.registry.v1.CatalogService.UpdateStrengthUnit:output_type -> moh.registry.v1.StrengthUnit
+	28, // 40: moh.registry.v1.CatalogService.DeleteStrengthUnit:output_type -> google.protobuf.Empty
+	11, // 41: moh.registry.v1.CatalogService.ListStrengthUnits:output_type -> moh.registry.v1.ListStrengthUnitsResponse
+	26, // 42: moh.registry.v1.CatalogService.CreateRouteOfAdmin:output_type -> moh.registry.v1.RouteOfAdmin
+	26, // 43: moh.registry.v1.CatalogService.GetRouteOfAdmin:output_type -> moh.registry.v1.RouteOfAdmin
+	26, // 44: moh.registry.v1.CatalogService.UpdateRouteOfAdmin:output_type -> moh.registry.v1.RouteOfAdmin
+	28, // 45: moh.registry.v1.CatalogService.DeleteRouteOfAdmin:output_type -> google.protobuf.Empty
+	17, // 46: moh.registry.v1.CatalogService.ListRoutesOfAdmin:output_type -> moh.registry.v1.ListRoutesOfAdminResponse
+	27, // 47: moh.registry.v1.CatalogService.CreateApi:output_type -> moh.registry.v1.Api
+	27, // 48: moh.registry.v1.CatalogService.GetApi:output_type -> moh.registry.v1.Api
+	27, // 49: moh.registry.v1.CatalogService.UpdateApi:output_type -> moh.registry.v1.Api
+	28, // 50: moh.registry.v1.CatalogService.DeleteApi:output_type -> google.protobuf.Empty
+	23, // 51: moh.registry.v1.CatalogService.ListApis:output_type -> moh.registry.v1.ListApisResponse
+	32, // [32:52] is the sub-list for method output_type
+	12, // [12:32] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
+}
+
+func init() { file_moh_registry_v1_catalog_proto_init() }
+func file_moh_registry_v1_catalog_proto_init() {
+	if File_moh_registry_v1_catalog_proto != nil {
+		return
+	}
+	file_moh_registry_v1_common_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_moh_registry_v1_catalog_proto_rawDesc), len(file_moh_registry_v1_catalog_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   24,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_moh_registry_v1_catalog_proto_goTypes,
+		DependencyIndexes: file_moh_registry_v1_catalog_proto_depIdxs,
+		MessageInfos:      file_moh_registry_v1_catalog_proto_msgTypes,
+	}.Build()
+	File_moh_registry_v1_catalog_proto = out.File
+	file_moh_registry_v1_catalog_proto_goTypes = nil
+	file_moh_registry_v1_catalog_proto_depIdxs = nil
+}
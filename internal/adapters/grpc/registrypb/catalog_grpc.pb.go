@@ -0,0 +1,848 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.29.3
+// source: moh/registry/v1/catalog.proto
+
+package registrypb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	CatalogService_CreateDosageForm_FullMethodName   = "/moh.registry.v1.CatalogService/CreateDosageForm"
+	CatalogService_GetDosageForm_FullMethodName      = "/moh.registry.v1.CatalogService/GetDosageForm"
+	CatalogService_UpdateDosageForm_FullMethodName   = "/moh.registry.v1.CatalogService/UpdateDosageForm"
+	CatalogService_DeleteDosageForm_FullMethodName   = "/moh.registry.v1.CatalogService/DeleteDosageForm"
+	CatalogService_ListDosageForms_FullMethodName    = "/moh.registry.v1.CatalogService/ListDosageForms"
+	CatalogService_CreateStrengthUnit_FullMethodName = "/moh.registry.v1.CatalogService/CreateStrengthUnit"
+	CatalogService_GetStrengthUnit_FullMethodName    = "/moh.registry.v1.CatalogService/GetStrengthUnit"
+	CatalogService_UpdateStrengthUnit_FullMethodName = "/moh.registry.v1.CatalogService/UpdateStrengthUnit"
+	CatalogService_DeleteStrengthUnit_FullMethodName = "/moh.registry.v1.CatalogService/DeleteStrengthUnit"
+	CatalogService_ListStrengthUnits_FullMethodName  = "/moh.registry.v1.CatalogService/ListStrengthUnits"
+	CatalogService_CreateRouteOfAdmin_FullMethodName = "/moh.registry.v1.CatalogService/CreateRouteOfAdmin"
+	CatalogService_GetRouteOfAdmin_FullMethodName    = "/moh.registry.v1.CatalogService/GetRouteOfAdmin"
+	CatalogService_UpdateRouteOfAdmin_FullMethodName = "/moh.registry.v1.CatalogService/UpdateRouteOfAdmin"
+	CatalogService_DeleteRouteOfAdmin_FullMethodName = "/moh.registry.v1.CatalogService/DeleteRouteOfAdmin"
+	CatalogService_ListRoutesOfAdmin_FullMethodName  = "/moh.registry.v1.CatalogService/ListRoutesOfAdmin"
+	CatalogService_CreateApi_FullMethodName          = "/moh.registry.v1.CatalogService/CreateApi"
+	CatalogService_GetApi_FullMethodName             = "/moh.registry.v1.CatalogService/GetApi"
+	CatalogService_UpdateApi_FullMethodName          = "/moh.registry.v1.CatalogService/UpdateApi"
+	CatalogService_DeleteApi_FullMethodName          = "/moh.registry.v1.CatalogService/DeleteApi"
+	CatalogService_ListApis_FullMethodName           = "/moh.registry.v1.CatalogService/ListApis"
+)
+
+// CatalogServiceClient is the client API for CatalogService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// CatalogService manages dosage forms, strength units, routes and active ingredients.
+type CatalogServiceClient interface {
+	CreateDosageForm(ctx context.Context, in *CreateDosageFormRequest, opts ...grpc.CallOption) (*DosageForm, error)
+	GetDosageForm(ctx context.Context, in *GetDosageFormRequest, opts ...grpc.CallOption) (*DosageForm, error)
+	UpdateDosageForm(ctx context.Context, in *UpdateDosageFormRequest, opts ...grpc.CallOption) (*DosageForm, error)
+	DeleteDosageForm(ctx context.Context, in *DeleteDosageFormRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ListDosageForms(ctx context.Context, in *ListDosageFormsRequest, opts ...grpc.CallOption) (*ListDosageFormsResponse, error)
+	CreateStrengthUnit(ctx context.Context, in *CreateStrengthUnitRequest, opts ...grpc.CallOption) (*StrengthUnit, error)
+	GetStrengthUnit(ctx context.Context, in *GetStrengthUnitRequest, opts ...grpc.CallOption) (*StrengthUnit, error)
+	UpdateStrengthUnit(ctx context.Context, in *UpdateStrengthUnitRequest, opts ...grpc.CallOption) (*StrengthUnit, error)
+	DeleteStrengthUnit(ctx context.Context, in *DeleteStrengthUnitRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ListStrengthUnits(ctx context.Context, in *ListStrengthUnitsRequest, opts ...grpc.CallOption) (*ListStrengthUnitsResponse, error)
+	CreateRouteOfAdmin(ctx context.Context, in *CreateRouteOfAdminRequest, opts ...grpc.CallOption) (*RouteOfAdmin, error)
+	GetRouteOfAdmin(ctx context.Context, in *GetRouteOfAdminRequest, opts ...grpc.CallOption) (*RouteOfAdmin, error)
+	UpdateRouteOfAdmin(ctx context.Context, in *UpdateRouteOfAdminRequest, opts ...grpc.CallOption) (*RouteOfAdmin, error)
+	DeleteRouteOfAdmin(ctx context.Context, in *DeleteRouteOfAdminRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ListRoutesOfAdmin(ctx context.Context, in *ListRoutesOfAdminRequest, opts ...grpc.CallOption) (*ListRoutesOfAdminResponse, error)
+	CreateApi(ctx context.Context, in *CreateApiRequest, opts ...grpc.CallOption) (*Api, error)
+	GetApi(ctx context.Context, in *GetApiRequest, opts ...grpc.CallOption) (*Api, error)
+	UpdateApi(ctx context.Context, in *UpdateApiRequest, opts ...grpc.CallOption) (*Api, error)
+	DeleteApi(ctx context.Context, in *DeleteApiRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ListApis(ctx context.Context, in *ListApisRequest, opts ...grpc.CallOption) (*ListApisResponse, error)
+}
+
+type catalogServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCatalogServiceClient(cc grpc.ClientConnInterface) CatalogServiceClient {
+	return &catalogServiceClient{cc}
+}
+
+func (c *catalogServiceClient) CreateDosageForm(ctx context.Context, in *CreateDosageFormRequest, opts ...grpc.CallOption) (*DosageForm, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DosageForm)
+	err := c.cc.Invoke(ctx, CatalogService_CreateDosageForm_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) GetDosageForm(ctx context.Context, in *GetDosageFormRequest, opts ...grpc.CallOption) (*DosageForm, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DosageForm)
+	err := c.cc.Invoke(ctx, CatalogService_GetDosageForm_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) UpdateDosageForm(ctx context.Context, in *UpdateDosageFormRequest, opts ...grpc.CallOption) (*DosageForm, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DosageForm)
+	err := c.cc.Invoke(ctx, CatalogService_UpdateDosageForm_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) DeleteDosageForm(ctx context.Context, in *DeleteDosageFormRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, CatalogService_DeleteDosageForm_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) ListDosageForms(ctx context.Context, in *ListDosageFormsRequest, opts ...grpc.CallOption) (*ListDosageFormsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDosageFormsResponse)
+	err := c.cc.Invoke(ctx, CatalogService_ListDosageForms_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) CreateStrengthUnit(ctx context.Context, in *CreateStrengthUnitRequest, opts ...grpc.CallOption) (*StrengthUnit, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StrengthUnit)
+	err := c.cc.Invoke(ctx, CatalogService_CreateStrengthUnit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) GetStrengthUnit(ctx context.Context, in *GetStrengthUnitRequest, opts ...grpc.CallOption) (*StrengthUnit, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StrengthUnit)
+	err := c.cc.Invoke(ctx, CatalogService_GetStrengthUnit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) UpdateStrengthUnit(ctx context.Context, in *UpdateStrengthUnitRequest, opts ...grpc.CallOption) (*StrengthUnit, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StrengthUnit)
+	err := c.cc.Invoke(ctx, CatalogService_UpdateStrengthUnit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) DeleteStrengthUnit(ctx context.Context, in *DeleteStrengthUnitRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, CatalogService_DeleteStrengthUnit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) ListStrengthUnits(ctx context.Context, in *ListStrengthUnitsRequest, opts ...grpc.CallOption) (*ListStrengthUnitsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListStrengthUnitsResponse)
+	err := c.cc.Invoke(ctx, CatalogService_ListStrengthUnits_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) CreateRouteOfAdmin(ctx context.Context, in *CreateRouteOfAdminRequest, opts ...grpc.CallOption) (*RouteOfAdmin, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RouteOfAdmin)
+	err := c.cc.Invoke(ctx, CatalogService_CreateRouteOfAdmin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) GetRouteOfAdmin(ctx context.Context, in *GetRouteOfAdminRequest, opts ...grpc.CallOption) (*RouteOfAdmin, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RouteOfAdmin)
+	err := c.cc.Invoke(ctx, CatalogService_GetRouteOfAdmin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) UpdateRouteOfAdmin(ctx context.Context, in *UpdateRouteOfAdminRequest, opts ...grpc.CallOption) (*RouteOfAdmin, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RouteOfAdmin)
+	err := c.cc.Invoke(ctx, CatalogService_UpdateRouteOfAdmin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) DeleteRouteOfAdmin(ctx context.Context, in *DeleteRouteOfAdminRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, CatalogService_DeleteRouteOfAdmin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) ListRoutesOfAdmin(ctx context.Context, in *ListRoutesOfAdminRequest, opts ...grpc.CallOption) (*ListRoutesOfAdminResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRoutesOfAdminResponse)
+	err := c.cc.Invoke(ctx, CatalogService_ListRoutesOfAdmin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) CreateApi(ctx context.Context, in *CreateApiRequest, opts ...grpc.CallOption) (*Api, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Api)
+	err := c.cc.Invoke(ctx, CatalogService_CreateApi_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) GetApi(ctx context.Context, in *GetApiRequest, opts ...grpc.CallOption) (*Api, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Api)
+	err := c.cc.Invoke(ctx, CatalogService_GetApi_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) UpdateApi(ctx context.Context, in *UpdateApiRequest, opts ...grpc.CallOption) (*Api, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Api)
+	err := c.cc.Invoke(ctx, CatalogService_UpdateApi_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) DeleteApi(ctx context.Context, in *DeleteApiRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, CatalogService_DeleteApi_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) ListApis(ctx context.Context, in *ListApisRequest, opts ...grpc.CallOption) (*ListApisResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListApisResponse)
+	err := c.cc.Invoke(ctx, CatalogService_ListApis_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CatalogServiceServer is the server API for CatalogService service.
+// All implementations must embed UnimplementedCatalogServiceServer
+// for forward compatibility.
+//
+// CatalogService manages dosage forms, strength units, routes and active ingredients.
+type CatalogServiceServer interface {
+	CreateDosageForm(context.Context, *CreateDosageFormRequest) (*DosageForm, error)
+	GetDosageForm(context.Context, *GetDosageFormRequest) (*DosageForm, error)
+	UpdateDosageForm(context.Context, *UpdateDosageFormRequest) (*DosageForm, error)
+	DeleteDosageForm(context.Context, *DeleteDosageFormRequest) (*emptypb.Empty, error)
+	ListDosageForms(context.Context, *ListDosageFormsRequest) (*ListDosageFormsResponse, error)
+	CreateStrengthUnit(context.Context, *CreateStrengthUnitRequest) (*StrengthUnit, error)
+	GetStrengthUnit(context.Context, *GetStrengthUnitRequest) (*StrengthUnit, error)
+	UpdateStrengthUnit(context.Context, *UpdateStrengthUnitRequest) (*StrengthUnit, error)
+	DeleteStrengthUnit(context.Context, *DeleteStrengthUnitRequest) (*emptypb.Empty, error)
+	ListStrengthUnits(context.Context, *ListStrengthUnitsRequest) (*ListStrengthUnitsResponse, error)
+	CreateRouteOfAdmin(context.Context, *CreateRouteOfAdminRequest) (*RouteOfAdmin, error)
+	GetRouteOfAdmin(context.Context, *GetRouteOfAdminRequest) (*RouteOfAdmin, error)
+	UpdateRouteOfAdmin(context.Context, *UpdateRouteOfAdminRequest) (*RouteOfAdmin, error)
+	DeleteRouteOfAdmin(context.Context, *DeleteRouteOfAdminRequest) (*emptypb.Empty, error)
+	ListRoutesOfAdmin(context.Context, *ListRoutesOfAdminRequest) (*ListRoutesOfAdminResponse, error)
+	CreateApi(context.Context, *CreateApiRequest) (*Api, error)
+	GetApi(context.Context, *GetApiRequest) (*Api, error)
+	UpdateApi(context.Context, *UpdateApiRequest) (*Api, error)
+	DeleteApi(context.Context, *DeleteApiRequest) (*emptypb.Empty, error)
+	ListApis(context.Context, *ListApisRequest) (*ListApisResponse, error)
+	mustEmbedUnimplementedCatalogServiceServer()
+}
+
+// UnimplementedCatalogServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedCatalogServiceServer struct{}
+
+func (UnimplementedCatalogServiceServer) CreateDosageForm(context.Context, *CreateDosageFormRequest) (*DosageForm, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateDosageForm not implemented")
+}
+func (UnimplementedCatalogServiceServer) GetDosageForm(context.Context, *GetDosageFormRequest) (*DosageForm, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDosageForm not implemented")
+}
+func (UnimplementedCatalogServiceServer) UpdateDosageForm(context.Context, *UpdateDosageFormRequest) (*DosageForm, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateDosageForm not implemented")
+}
+func (UnimplementedCatalogServiceServer) DeleteDosageForm(context.Context, *DeleteDosageFormRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteDosageForm not implemented")
+}
+func (UnimplementedCatalogServiceServer) ListDosageForms(context.Context, *ListDosageFormsRequest) (*ListDosageFormsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDosageForms not implemented")
+}
+func (UnimplementedCatalogServiceServer) CreateStrengthUnit(context.Context, *CreateStrengthUnitRequest) (*StrengthUnit, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateStrengthUnit not implemented")
+}
+func (UnimplementedCatalogServiceServer) GetStrengthUnit(context.Context, *GetStrengthUnitRequest) (*StrengthUnit, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStrengthUnit not implemented")
+}
+func (UnimplementedCatalogServiceServer) UpdateStrengthUnit(context.Context, *UpdateStrengthUnitRequest) (*StrengthUnit, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateStrengthUnit not implemented")
+}
+func (UnimplementedCatalogServiceServer) DeleteStrengthUnit(context.Context, *DeleteStrengthUnitRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteStrengthUnit not implemented")
+}
+func (UnimplementedCatalogServiceServer) ListStrengthUnits(context.Context, *ListStrengthUnitsRequest) (*ListStrengthUnitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListStrengthUnits not implemented")
+}
+func (UnimplementedCatalogServiceServer) CreateRouteOfAdmin(context.Context, *CreateRouteOfAdminRequest) (*RouteOfAdmin, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRouteOfAdmin not implemented")
+}
+func (UnimplementedCatalogServiceServer) GetRouteOfAdmin(context.Context, *GetRouteOfAdminRequest) (*RouteOfAdmin, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRouteOfAdmin not implemented")
+}
+func (UnimplementedCatalogServiceServer) UpdateRouteOfAdmin(context.Context, *UpdateRouteOfAdminRequest) (*RouteOfAdmin, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRouteOfAdmin not implemented")
+}
+func (UnimplementedCatalogServiceServer) DeleteRouteOfAdmin(context.Context, *DeleteRouteOfAdminRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRouteOfAdmin not implemented")
+}
+func (UnimplementedCatalogServiceServer) ListRoutesOfAdmin(context.Context, *ListRoutesOfAdminRequest) (*ListRoutesOfAdminResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRoutesOfAdmin not implemented")
+}
+func (UnimplementedCatalogServiceServer) CreateApi(context.Context, *CreateApiRequest) (*Api, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateApi not implemented")
+}
+func (UnimplementedCatalogServiceServer) GetApi(context.Context, *GetApiRequest) (*Api, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetApi not implemented")
+}
+func (UnimplementedCatalogServiceServer) UpdateApi(context.Context, *UpdateApiRequest) (*Api, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateApi not implemented")
+}
+func (UnimplementedCatalogServiceServer) DeleteApi(context.Context, *DeleteApiRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteApi not implemented")
+}
+func (UnimplementedCatalogServiceServer) ListApis(context.Context, *ListApisRequest) (*ListApisResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListApis not implemented")
+}
+func (UnimplementedCatalogServiceServer) mustEmbedUnimplementedCatalogServiceServer() {}
+func (UnimplementedCatalogServiceServer) testEmbeddedByValue()                        {}
+
+// UnsafeCatalogServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CatalogServiceServer will
+// result in compilation errors.
+type UnsafeCatalogServiceServer interface {
+	mustEmbedUnimplementedCatalogServiceServer()
+}
+
+func RegisterCatalogServiceServer(s grpc.ServiceRegistrar, srv CatalogServiceServer) {
+	// If the following call pancis, it indicates UnimplementedCatalogServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&CatalogService_ServiceDesc, srv)
+}
+
+func _CatalogService_CreateDosageForm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateDosageFormRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).CreateDosageForm(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_CreateDosageForm_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).CreateDosageForm(ctx, req.(*CreateDosageFormRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_GetDosageForm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDosageFormRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).GetDosageForm(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_GetDosageForm_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).GetDosageForm(ctx, req.(*GetDosageFormRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_UpdateDosageForm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateDosageFormRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).UpdateDosageForm(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_UpdateDosageForm_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).UpdateDosageForm(ctx, req.(*UpdateDosageFormRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_DeleteDosageForm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteDosageFormRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).DeleteDosageForm(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_DeleteDosageForm_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).DeleteDosageForm(ctx, req.(*DeleteDosageFormRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_ListDosageForms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDosageFormsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).ListDosageForms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_ListDosageForms_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).ListDosageForms(ctx, req.(*ListDosageFormsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_CreateStrengthUnit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateStrengthUnitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).CreateStrengthUnit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_CreateStrengthUnit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).CreateStrengthUnit(ctx, req.(*CreateStrengthUnitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_GetStrengthUnit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStrengthUnitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).GetStrengthUnit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_GetStrengthUnit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).GetStrengthUnit(ctx, req.(*GetStrengthUnitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_UpdateStrengthUnit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateStrengthUnitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).UpdateStrengthUnit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_UpdateStrengthUnit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).UpdateStrengthUnit(ctx, req.(*UpdateStrengthUnitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_DeleteStrengthUnit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteStrengthUnitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).DeleteStrengthUnit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_DeleteStrengthUnit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).DeleteStrengthUnit(ctx, req.(*DeleteStrengthUnitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_ListStrengthUnits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListStrengthUnitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).ListStrengthUnits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_ListStrengthUnits_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).ListStrengthUnits(ctx, req.(*ListStrengthUnitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_CreateRouteOfAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRouteOfAdminRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).CreateRouteOfAdmin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_CreateRouteOfAdmin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).CreateRouteOfAdmin(ctx, req.(*CreateRouteOfAdminRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_GetRouteOfAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRouteOfAdminRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).GetRouteOfAdmin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_GetRouteOfAdmin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).GetRouteOfAdmin(ctx, req.(*GetRouteOfAdminRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_UpdateRouteOfAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRouteOfAdminRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).UpdateRouteOfAdmin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_UpdateRouteOfAdmin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).UpdateRouteOfAdmin(ctx, req.(*UpdateRouteOfAdminRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_DeleteRouteOfAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRouteOfAdminRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).DeleteRouteOfAdmin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_DeleteRouteOfAdmin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).DeleteRouteOfAdmin(ctx, req.(*DeleteRouteOfAdminRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_ListRoutesOfAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRoutesOfAdminRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).ListRoutesOfAdmin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_ListRoutesOfAdmin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).ListRoutesOfAdmin(ctx, req.(*ListRoutesOfAdminRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_CreateApi_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateApiRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).CreateApi(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_CreateApi_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).CreateApi(ctx, req.(*CreateApiRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_GetApi_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetApiRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).GetApi(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_GetApi_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).GetApi(ctx, req.(*GetApiRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_UpdateApi_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateApiRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).UpdateApi(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_UpdateApi_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).UpdateApi(ctx, req.(*UpdateApiRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_DeleteApi_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteApiRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).DeleteApi(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_DeleteApi_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).DeleteApi(ctx, req.(*DeleteApiRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_ListApis_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListApisRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).ListApis(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_ListApis_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).ListApis(ctx, req.(*ListApisRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// CatalogService_ServiceDesc is the grpc.ServiceDesc for CatalogService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CatalogService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "moh.registry.v1.CatalogService",
+	HandlerType: (*CatalogServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateDosageForm",
+			Handler:    _CatalogService_CreateDosageForm_Handler,
+		},
+		{
+			MethodName: "GetDosageForm",
+			Handler:    _CatalogService_GetDosageForm_Handler,
+		},
+		{
+			MethodName: "UpdateDosageForm",
+			Handler:    _CatalogService_UpdateDosageForm_Handler,
+		},
+		{
+			MethodName: "DeleteDosageForm",
+			Handler:    _CatalogService_DeleteDosageForm_Handler,
+		},
+		{
+			MethodName: "ListDosageForms",
+			Handler:    _CatalogService_ListDosageForms_Handler,
+		},
+		{
+			MethodName: "CreateStrengthUnit",
+			Handler:    _CatalogService_CreateStrengthUnit_Handler,
+		},
+		{
+			MethodName: "GetStrengthUnit",
+			Handler:    _CatalogService_GetStrengthUnit_Handler,
+		},
+		{
+			MethodName: "UpdateStrengthUnit",
+			Handler:    _CatalogService_UpdateStrengthUnit_Handler,
+		},
+		{
+			MethodName: "DeleteStrengthUnit",
+			Handler:    _CatalogService_DeleteStrengthUnit_Handler,
+		},
+		{
+			MethodName: "ListStrengthUnits",
+			Handler:    _CatalogService_ListStrengthUnits_Handler,
+		},
+		{
+			MethodName: "CreateRouteOfAdmin",
+			Handler:    _CatalogService_CreateRouteOfAdmin_Handler,
+		},
+		{
+			MethodName: "GetRouteOfAdmin",
+			Handler:    _CatalogService_GetRouteOfAdmin_Handler,
+		},
+		{
+			MethodName: "UpdateRouteOfAdmin",
+			Handler:    _CatalogService_UpdateRouteOfAdmin_Handler,
+		},
+		{
+			MethodName: "DeleteRouteOfAdmin",
+			Handler:    _CatalogService_DeleteRouteOfAdmin_Handler,
+		},
+		{
+			MethodName: "ListRoutesOfAdmin",
+			Handler:    _CatalogService_ListRoutesOfAdmin_Handler,
+		},
+		{
+			MethodName: "CreateApi",
+			Handler:    _CatalogService_CreateApi_Handler,
+		},
+		{
+			MethodName: "GetApi",
+			Handler:    _CatalogService_GetApi_Handler,
+		},
+		{
+			MethodName: "UpdateApi",
+			Handler:    _CatalogService_UpdateApi_Handler,
+		},
+		{
+			MethodName: "DeleteApi",
+			Handler:    _CatalogService_DeleteApi_Handler,
+		},
+		{
+			MethodName: "ListApis",
+			Handler:    _CatalogService_ListApis_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "moh/registry/v1/catalog.proto",
+}
@@ -0,0 +1,791 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v5.29.3
+// source: moh/registry/v1/common.proto
+
+package registrypb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type DosageForm struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Names         map[string]string      `protobuf:"bytes,6,rep,name=names,proto3" json:"names,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // by locale (en, ar, fr); en is required and mirrors name
+	Group         string                 `protobuf:"bytes,7,opt,name=group,proto3" json:"group,omitempty"`                                                                           // forms in the same group are interchangeable for equivalence
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DosageForm) Reset() {
+	*x = DosageForm{}
+	mi := &file_moh_registry_v1_common_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DosageForm) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DosageForm) ProtoMessage() {}
+
+func (x *DosageForm) ProtoReflect() protoreflect.Message {
+	mi := &file_moh_registry_v1_common_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DosageForm.ProtoReflect.Descriptor instead.
+func (*DosageForm) Descriptor() ([]byte, []int) {
+	return file_moh_registry_v1_common_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *DosageForm) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DosageForm) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *DosageForm) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DosageForm) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *DosageForm) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *DosageForm) GetNames() map[string]string {
+	if x != nil {
+		return x.Names
+	}
+	return nil
+}
+
+func (x *DosageForm) GetGroup() string {
+	if x != nil {
+		return x.Group
+	}
+	return ""
+}
+
+type StrengthUnit struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Names         map[string]string      `protobuf:"bytes,6,rep,name=names,proto3" json:"names,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // by locale (en, ar, fr); en is required and mirrors name
+	Dimension     string                 `protobuf:"bytes,7,opt,name=dimension,proto3" json:"dimension,omitempty"`                                                                   // mass, volume, activity or amount; empty if not convertible
+	Factor        string                 `protobuf:"bytes,8,opt,name=factor,proto3" json:"factor,omitempty"`                                                                         // decimal: one unit in the dimension's base unit (g, mL, IU, mol)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StrengthUnit) Reset() {
+	*x = StrengthUnit{}
+	mi := &file_moh_registry_v1_common_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StrengthUnit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StrengthUnit) ProtoMessage() {}
+
+func (x *StrengthUnit) ProtoReflect() protoreflect.Message {
+	mi := &file_moh_registry_v1_common_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StrengthUnit.ProtoReflect.Descriptor instead.
+func (*StrengthUnit) Descriptor() ([]byte, []int) {
+	return file_moh_registry_v1_common_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *StrengthUnit) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *StrengthUnit) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *StrengthUnit) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *StrengthUnit) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *StrengthUnit) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *StrengthUnit) GetNames() map[string]string {
+	if x != nil {
+		return x.Names
+	}
+	return nil
+}
+
+func (x *StrengthUnit) GetDimension() string {
+	if x != nil {
+		return x.Dimension
+	}
+	return ""
+}
+
+func (x *StrengthUnit) GetFactor() string {
+	if x != nil {
+		return x.Factor
+	}
+	return ""
+}
+
+type RouteOfAdmin struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Names         map[string]string      `protobuf:"bytes,6,rep,name=names,proto3" json:"names,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // by locale (en, ar, fr); en is required and mirrors name
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RouteOfAdmin) Reset() {
+	*x = RouteOfAdmin{}
+	mi := &file_moh_registry_v1_common_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RouteOfAdmin) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RouteOfAdmin) ProtoMessage() {}
+
+func (x *RouteOfAdmin) ProtoReflect() protoreflect.Message {
+	mi := &file_moh_registry_v1_common_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RouteOfAdmin.ProtoReflect.Descriptor instead.
+func (*RouteOfAdmin) Descriptor() ([]byte, []int) {
+	return file_moh_registry_v1_common_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *RouteOfAdmin) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RouteOfAdmin) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *RouteOfAdmin) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RouteOfAdmin) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *RouteOfAdmin) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *RouteOfAdmin) GetNames() map[string]string {
+	if x != nil {
+		return x.Names
+	}
+	return nil
+}
+
+// Api is an active pharmaceutical ingredient (INN).
+type Api struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"` // active, inactive, withdrawn, banned
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Names         map[string]string      `protobuf:"bytes,6,rep,name=names,proto3" json:"names,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // by locale (en, ar, fr); en is required and mirrors name
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Api) Reset() {
+	*x = Api{}
+	mi := &file_moh_registry_v1_common_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Api) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Api) ProtoMessage() {}
+
+func (x *Api) ProtoReflect() protoreflect.Message {
+	mi := &file_moh_registry_v1_common_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Api.ProtoReflect.Descriptor instead.
+func (*Api) Descriptor() ([]byte, []int) {
+	return file_moh_registry_v1_common_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Api) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Api) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Api) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Api) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Api) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Api) GetNames() map[string]string {
+	if x != nil {
+		return x.Names
+	}
+	return nil
+}
+
+type AuthHolder struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Id                 string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name               string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	RegistrationNumber string                 `protobuf:"bytes,3,opt,name=registration_number,json=registrationNumber,proto3" json:"registration_number,omitempty"`
+	CreatedAt          *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt          *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *AuthHolder) Reset() {
+	*x = AuthHolder{}
+	mi := &file_moh_registry_v1_common_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuthHolder) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthHolder) ProtoMessage() {}
+
+func (x *AuthHolder) ProtoReflect() protoreflect.Message {
+	mi := &file_moh_registry_v1_common_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthHolder.ProtoReflect.Descriptor instead.
+func (*AuthHolder) Descriptor() ([]byte, []int) {
+	return file_moh_registry_v1_common_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *AuthHolder) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AuthHolder) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AuthHolder) GetRegistrationNumber() string {
+	if x != nil {
+		return x.RegistrationNumber
+	}
+	return ""
+}
+
+func (x *AuthHolder) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *AuthHolder) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type MarketingAuthorization struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Country       string                 `protobuf:"bytes,3,opt,name=country,proto3" json:"country,omitempty"` // ISO 3166-1 alpha-2
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarketingAuthorization) Reset() {
+	*x = MarketingAuthorization{}
+	mi := &file_moh_registry_v1_common_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarketingAuthorization) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarketingAuthorization) ProtoMessage() {}
+
+func (x *MarketingAuthorization) ProtoReflect() protoreflect.Message {
+	mi := &file_moh_registry_v1_common_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarketingAuthorization.ProtoReflect.Descriptor instead.
+func (*MarketingAuthorization) Descriptor() ([]byte, []int) {
+	return file_moh_registry_v1_common_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *MarketingAuthorization) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *MarketingAuthorization) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MarketingAuthorization) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+func (x *MarketingAuthorization) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *MarketingAuthorization) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type ManufacturingSite struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Country       string                 `protobuf:"bytes,3,opt,name=country,proto3" json:"country,omitempty"` // ISO 3166-1 alpha-2
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Names         map[string]string      `protobuf:"bytes,6,rep,name=names,proto3" json:"names,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // by locale (en, ar, fr); en is required and mirrors name
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ManufacturingSite) Reset() {
+	*x = ManufacturingSite{}
+	mi := &file_moh_registry_v1_common_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ManufacturingSite) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ManufacturingSite) ProtoMessage() {}
+
+func (x *ManufacturingSite) ProtoReflect() protoreflect.Message {
+	mi := &file_moh_registry_v1_common_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ManufacturingSite.ProtoReflect.Descriptor instead.
+func (*ManufacturingSite) Descriptor() ([]byte, []int) {
+	return file_moh_registry_v1_common_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ManufacturingSite) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ManufacturingSite) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ManufacturingSite) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+func (x *ManufacturingSite) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ManufacturingSite) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *ManufacturingSite) GetNames() map[string]string {
+	if x != nil {
+		return x.Names
+	}
+	return nil
+}
+
+var File_moh_registry_v1_common_proto protoreflect.FileDescriptor
+
+const file_moh_registry_v1_common_proto_rawDesc = "" +
+	"\n" +
+	"\x1cmoh/registry/v1/common.proto\x12\x0fmoh.registry.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xc8\x02\n" +
+	"\n" +
+	"DosageForm\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12<\n" +
+	"\x05names\x18\x06 \x03(\v2&.moh.registry.v1.DosageForm.NamesEntryR\x05names\x12\x14\n" +
+	"\x05group\x18\a \x01(\tR\x05group\x1a8\n" +
+	"\n" +
+	"NamesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xec\x02\n" +
+	"\fStrengthUnit\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12>\n" +
+	"\x05names\x18\x06 \x03(\v2(.moh.registry.v1.StrengthUnit.NamesEntryR\x05names\x12\x1c\n" +
+	"\tdimension\x18\a \x01(\tR\tdimension\x12\x16\n" +
+	"\x06factor\x18\b \x01(\tR\x06factor\x1a8\n" +
+	"\n" +
+	"NamesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xb6\x02\n" +
+	"\fRouteOfAdmin\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12>\n" +
+	"\x05names\x18\x06 \x03(\v2(.moh.registry.v1.RouteOfAdmin.NamesEntryR\x05names\x1a8\n" +
+	"\n" +
+	"NamesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xa8\x02\n" +
+	"\x03Api\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x125\n" +
+	"\x05names\x18\x06 \x03(\v2\x1f.moh.registry.v1.Api.NamesEntryR\x05names\x1a8\n" +
+	"\n" +
+	"NamesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xd7\x01\n" +
+	"\n" +
+	"AuthHolder\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12/\n" +
+	"\x13registration_number\x18\x03 \x01(\tR\x12registrationNumber\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xcc\x01\n" +
+	"\x16MarketingAuthorization\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x18\n" +
+	"\acountry\x18\x03 \x01(\tR\acountry\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xc6\x02\n" +
+	"\x11ManufacturingSite\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x18\n" +
+	"\acountry\x18\x03 \x01(\tR\acountry\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12C\n" +
+	"\x05names\x18\x06 \x03(\v2-.moh.registry.v1.ManufacturingSite.NamesEntryR\x05names\x1a8\n" +
+	"\n" +
+	"NamesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B2Z0moh/internal/adapters/grpc/registrypb;registrypbb\x06proto3"
+
+var (
+	file_moh_registry_v1_common_proto_rawDescOnce sync.Once
+	file_moh_registry_v1_common_proto_rawDescData []byte
+)
+
+func file_moh_registry_v1_common_proto_rawDescGZIP() []byte {
+	file_moh_registry_v1_common_proto_rawDescOnce.Do(func() {
+		file_moh_registry_v1_common_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_moh_registry_v1_common_proto_rawDesc), len(file_moh_registry_v1_common_proto_rawDesc)))
+	})
+	return file_moh_registry_v1_common_proto_rawDescData
+}
+
+var file_moh_registry_v1_common_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_moh_registry_v1_common_proto_goTypes = []any{
+	(*DosageForm)(nil),             // 0: moh.registry.v1.DosageForm
+	(*StrengthUnit)(nil),           // 1: moh.registry.v1.StrengthUnit
+	(*RouteOfAdmin)(nil),           // 2: moh.registry.v1.RouteOfAdmin
+	(*Api)(nil),                    // 3: moh.registry.v1.Api
+	(*AuthHolder)(nil),             // 4: moh.registry.v1.AuthHolder
+	(*MarketingAuthorization)(nil), // 5: moh.registry.v1.MarketingAuthorization
+	(*ManufacturingSite)(nil),      // 6: moh.registry.v1.ManufacturingSite
+	nil,                            // 7: moh.registry.v1.DosageForm.NamesEntry
+	nil,                            // 8: moh.registry.v1.StrengthUnit.NamesEntry
+	nil,                            // 9: moh.registry.v1.RouteOfAdmin.NamesEntry
+	nil,                            // 10: moh.registry.v1.Api.NamesEntry
+	nil,                            // 11: moh.registry.v1.ManufacturingSite.NamesEntry
+	(*timestamppb.Timestamp)(nil),  // 12: google.protobuf.Timestamp
+}
+var file_moh_registry_v1_common_proto_depIdxs = []int32{
+	12, // 0: moh.registry.v1.DosageForm.created_at:type_name -> google.protobuf.Timestamp
+	12, // 1: moh.registry.v1.DosageForm.updated_at:type_name -> google.protobuf.Timestamp
+	7,  // 2: moh.registry.v1.DosageForm.names:type_name -> moh.registry.v1.DosageForm.NamesEntry
+	12, // 3: moh.registry.v1.StrengthUnit.created_at:type_name -> google.protobuf.Timestamp
+	12, // 4: moh.registry.v1.StrengthUnit.updated_at:type_name -> google.protobuf.Timestamp
+	8,  // 5: moh.registry.v1.StrengthUnit.names:type_name -> moh.registry.v1.StrengthUnit.NamesEntry
+	12, // 6: moh.registry.v1.RouteOfAdmin.created_at:type_name -> google.protobuf.Timestamp
+	12, // 7: moh.registry.v1.RouteOfAdmin.updated_at:type_name -> google.protobuf.Timestamp
+	9,  // 8: moh.registry.v1.RouteOfAdmin.names:type_name -> moh.registry.v1.RouteOfAdmin.NamesEntry
+	12, // 9: moh.registry.v1.Api.created_at:type_name -> google.protobuf.Timestamp
+	12, // 10: moh.registry.v1.Api.updated_at:type_name -> google.protobuf.Timestamp
+	10, // 11: moh.registry.v1.Api.names:type_name -> moh.registry.v1.Api.NamesEntry
+	12, // 12: moh.registry.v1.AuthHolder.created_at:type_name -> google.protobuf.Timestamp
+	12, // 13: moh.registry.v1.AuthHolder.updated_at:type_name -> google.protobuf.Timestamp
+	12, // 14: moh.registry.v1.MarketingAuthorization.created_at:type_name -> google.protobuf.Timestamp
+	12, // 15: moh.registry.v1.MarketingAuthorization.updated_at:type_name -> google.protobuf.Timestamp
+	12, // 16: moh.registry.v1.ManufacturingSite.created_at:type_name -> google.protobuf.Timestamp
+	12, // 17: moh.registry.v1.ManufacturingSite.updated_at:type_name -> google.protobuf.Timestamp
+	11, // 18: moh.registry.v1.ManufacturingSite.names:type_name -> moh.registry.v1.ManufacturingSite.NamesEntry
+	19, // [19:19] is the sub-list for method output_type
+	19, // [19:19] is the sub-list for method input_type
+	19, // [19:19] is the sub-list for extension type_name
+	19, // [19:19] is the sub-list for extension extendee
+	0,  // [0:19] is the sub-list for field type_name
+}
+
+func init() { file_moh_registry_v1_common_proto_init() }
+func file_moh_registry_v1_common_proto_init() {
+	if File_moh_registry_v1_common_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_moh_registry_v1_common_proto_rawDesc), len(file_moh_registry_v1_common_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   12,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_moh_registry_v1_common_proto_goTypes,
+		DependencyIndexes: file_moh_registry_v1_common_proto_depIdxs,
+		MessageInfos:      file_moh_registry_v1_common_proto_msgTypes,
+	}.Build()
+	File_moh_registry_v1_common_proto = out.File
+	file_moh_registry_v1_common_proto_goTypes = nil
+	file_moh_registry_v1_common_proto_depIdxs = nil
+}
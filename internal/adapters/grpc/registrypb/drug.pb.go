@@ -0,0 +1,616 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v5.29.3
+// source: moh/registry/v1/drug.proto
+
+package registrypb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type CreateDrugRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Drug          *Drug                  `protobuf:"bytes,1,opt,name=drug,proto3" json:"drug,omitempty"`
+	OverrideLasa  bool                   `protobuf:"varint,2,opt,name=override_lasa,json=overrideLasa,proto3" json:"override_lasa,omitempty"` // register a brand name even if it looks or sounds like a registered one
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateDrugRequest) Reset() {
+	*x = CreateDrugRequest{}
+	mi := &file_moh_registry_v1_drug_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateDrugRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateDrugRequest) ProtoMessage() {}
+
+func (x *CreateDrugRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_moh_registry_v1_drug_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateDrugRequest.ProtoReflect.Descriptor instead.
+func (*CreateDrugRequest) Descriptor() ([]byte, []int) {
+	return file_moh_registry_v1_drug_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CreateDrugRequest) GetDrug() *Drug {
+	if x != nil {
+		return x.Drug
+	}
+	return nil
+}
+
+func (x *CreateDrugRequest) GetOverrideLasa() bool {
+	if x != nil {
+		return x.OverrideLasa
+	}
+	return false
+}
+
+type GetDrugRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDrugRequest) Reset() {
+	*x = GetDrugRequest{}
+	mi := &file_moh_registry_v1_drug_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDrugRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDrugRequest) ProtoMessage() {}
+
+func (x *GetDrugRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_moh_registry_v1_drug_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDrugRequest.ProtoReflect.Descriptor instead.
+func (*GetDrugRequest) Descriptor() ([]byte, []int) {
+	return file_moh_registry_v1_drug_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetDrugRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// UpdateDrugRequest replaces every editable field of drug.id.
+type UpdateDrugRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Drug          *Drug                  `protobuf:"bytes,1,opt,name=drug,proto3" json:"drug,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateDrugRequest) Reset() {
+	*x = UpdateDrugRequest{}
+	mi := &file_moh_registry_v1_drug_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateDrugRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateDrugRequest) ProtoMessage() {}
+
+func (x *UpdateDrugRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_moh_registry_v1_drug_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateDrugRequest.ProtoReflect.Descriptor instead.
+func (*UpdateDrugRequest) Descriptor() ([]byte, []int) {
+	return file_moh_registry_v1_drug_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *UpdateDrugRequest) GetDrug() *Drug {
+	if x != nil {
+		return x.Drug
+	}
+	return nil
+}
+
+type DeleteDrugRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteDrugRequest) Reset() {
+	*x = DeleteDrugRequest{}
+	mi := &file_moh_registry_v1_drug_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteDrugRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteDrugRequest) ProtoMessage() {}
+
+func (x *DeleteDrugRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_moh_registry_v1_drug_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteDrugRequest.ProtoReflect.Descriptor instead.
+func (*DeleteDrugRequest) Descriptor() ([]byte, []int) {
+	return file_moh_registry_v1_drug_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *DeleteDrugRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListDrugsRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	PageSize       int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`   // 1..500, default 50
+	PageToken      string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"` // next_page_token of the previous page
+	OrderBy        string                 `protobuf:"bytes,3,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`       // whitelisted field, "-" prefix for descending
+	ApiId          string                 `protobuf:"bytes,4,opt,name=api_id,json=apiId,proto3" json:"api_id,omitempty"`
+	DosageFormId   string                 `protobuf:"bytes,5,opt,name=dosage_form_id,json=dosageFormId,proto3" json:"dosage_form_id,omitempty"`
+	RouteId        string                 `protobuf:"bytes,6,opt,name=route_id,json=routeId,proto3" json:"route_id,omitempty"`
+	StrengthUnitId string                 `protobuf:"bytes,7,opt,name=strength_unit_id,json=strengthUnitId,proto3" json:"strength_unit_id,omitempty"`
+	Q              string                 `protobuf:"bytes,8,opt,name=q,proto3" json:"q,omitempty"` // substring of brand name
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListDrugsRequest) Reset() {
+	*x = ListDrugsRequest{}
+	mi := &file_moh_registry_v1_drug_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDrugsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDrugsRequest) ProtoMessage() {}
+
+func (x *ListDrugsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_moh_registry_v1_drug_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDrugsRequest.ProtoReflect.Descriptor instead.
+func (*ListDrugsRequest) Descriptor() ([]byte, []int) {
+	return file_moh_registry_v1_drug_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListDrugsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListDrugsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListDrugsRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+func (x *ListDrugsRequest) GetApiId() string {
+	if x != nil {
+		return x.ApiId
+	}
+	return ""
+}
+
+func (x *ListDrugsRequest) GetDosageFormId() string {
+	if x != nil {
+		return x.DosageFormId
+	}
+	return ""
+}
+
+func (x *ListDrugsRequest) GetRouteId() string {
+	if x != nil {
+		return x.RouteId
+	}
+	return ""
+}
+
+func (x *ListDrugsRequest) GetStrengthUnitId() string {
+	if x != nil {
+		return x.StrengthUnitId
+	}
+	return ""
+}
+
+func (x *ListDrugsRequest) GetQ() string {
+	if x != nil {
+		return x.Q
+	}
+	return ""
+}
+
+type ListDrugsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*Drug                `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	TotalSize     int64                  `protobuf:"varint,3,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDrugsResponse) Reset() {
+	*x = ListDrugsResponse{}
+	mi := &file_moh_registry_v1_drug_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDrugsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDrugsResponse) ProtoMessage() {}
+
+func (x *ListDrugsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_moh_registry_v1_drug_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDrugsResponse.ProtoReflect.Descriptor instead.
+func (*ListDrugsResponse) Descriptor() ([]byte, []int) {
+	return file_moh_registry_v1_drug_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListDrugsResponse) GetItems() []*Drug {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ListDrugsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListDrugsResponse) GetTotalSize() int64 {
+	if x != nil {
+		return x.TotalSize
+	}
+	return 0
+}
+
+type Drug struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	BrandName      string                 `protobuf:"bytes,2,opt,name=brand_name,json=brandName,proto3" json:"brand_name,omitempty"`
+	DosageFormId   string                 `protobuf:"bytes,3,opt,name=dosage_form_id,json=dosageFormId,proto3" json:"dosage_form_id,omitempty"`
+	RouteId        string                 `protobuf:"bytes,4,opt,name=route_id,json=routeId,proto3" json:"route_id,omitempty"`
+	StrengthUnitId string                 `protobuf:"bytes,5,opt,name=strength_unit_id,json=strengthUnitId,proto3" json:"strength_unit_id,omitempty"`
+	ApiId          string                 `protobuf:"bytes,7,opt,name=api_id,json=apiId,proto3" json:"api_id,omitempty"`
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt      *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	BrandNames     map[string]string      `protobuf:"bytes,10,rep,name=brand_names,json=brandNames,proto3" json:"brand_names,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // by locale (en, ar, fr); en is required and mirrors brand_name
+	Dose           string                 `protobuf:"bytes,11,opt,name=dose,proto3" json:"dose,omitempty"`                                                                                                         // decimal, e.g. "0.3"; empty is zero
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Drug) Reset() {
+	*x = Drug{}
+	mi := &file_moh_registry_v1_drug_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Drug) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Drug) ProtoMessage() {}
+
+func (x *Drug) ProtoReflect() protoreflect.Message {
+	mi := &file_moh_registry_v1_drug_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Drug.ProtoReflect.Descriptor instead.
+func (*Drug) Descriptor() ([]byte, []int) {
+	return file_moh_registry_v1_drug_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Drug) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Drug) GetBrandName() string {
+	if x != nil {
+		return x.BrandName
+	}
+	return ""
+}
+
+func (x *Drug) GetDosageFormId() string {
+	if x != nil {
+		return x.DosageFormId
+	}
+	return ""
+}
+
+func (x *Drug) GetRouteId() string {
+	if x != nil {
+		return x.RouteId
+	}
+	return ""
+}
+
+func (x *Drug) GetStrengthUnitId() string {
+	if x != nil {
+		return x.StrengthUnitId
+	}
+	return ""
+}
+
+func (x *Drug) GetApiId() string {
+	if x != nil {
+		return x.ApiId
+	}
+	return ""
+}
+
+func (x *Drug) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Drug) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Drug) GetBrandNames() map[string]string {
+	if x != nil {
+		return x.BrandNames
+	}
+	return nil
+}
+
+func (x *Drug) GetDose() string {
+	if x != nil {
+		return x.Dose
+	}
+	return ""
+}
+
+var File_moh_registry_v1_drug_proto protoreflect.FileDescriptor
+
+const file_moh_registry_v1_drug_proto_rawDesc = "" +
+	"\n" +
+	"\x1amoh/registry/v1/drug.proto\x12\x0fmoh.registry.v1\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"c\n" +
+	"\x11CreateDrugRequest\x12)\n" +
+	"\x04drug\x18\x01 \x01(\v2\x15.moh.registry.v1.DrugR\x04drug\x12#\n" +
+	"\roverride_lasa\x18\x02 \x01(\bR\foverrideLasa\" \n" +
+	"\x0eGetDrugRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\">\n" +
+	"\x11UpdateDrugRequest\x12)\n" +
+	"\x04drug\x18\x01 \x01(\v2\x15.moh.registry.v1.DrugR\x04drug\"#\n" +
+	"\x11DeleteDrugRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\xf9\x01\n" +
+	"\x10ListDrugsRequest\x12\x1b\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\x12\x19\n" +
+	"\border_by\x18\x03 \x01(\tR\aorderBy\x12\x15\n" +
+	"\x06api_id\x18\x04 \x01(\tR\x05apiId\x12$\n" +
+	"\x0edosage_form_id\x18\x05 \x01(\tR\fdosageFormId\x12\x19\n" +
+	"\broute_id\x18\x06 \x01(\tR\arouteId\x12(\n" +
+	"\x10strength_unit_id\x18\a \x01(\tR\x0estrengthUnitId\x12\f\n" +
+	"\x01q\x18\b \x01(\tR\x01q\"\x87\x01\n" +
+	"\x11ListDrugsResponse\x12+\n" +
+	"\x05items\x18\x01 \x03(\v2\x15.moh.registry.v1.DrugR\x05items\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1d\n" +
+	"\n" +
+	"total_size\x18\x03 \x01(\x03R\ttotalSize\"\xce\x03\n" +
+	"\x04Drug\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"brand_name\x18\x02 \x01(\tR\tbrandName\x12$\n" +
+	"\x0edosage_form_id\x18\x03 \x01(\tR\fdosageFormId\x12\x19\n" +
+	"\broute_id\x18\x04 \x01(\tR\arouteId\x12(\n" +
+	"\x10strength_unit_id\x18\x05 \x01(\tR\x0estrengthUnitId\x12\x15\n" +
+	"\x06api_id\x18\a \x01(\tR\x05apiId\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12F\n" +
+	"\vbrand_names\x18\n" +
+	" \x03(\v2%.moh.registry.v1.Drug.BrandNamesEntryR\n" +
+	"brandNames\x12\x12\n" +
+	"\x04dose\x18\v \x01(\tR\x04dose\x1a=\n" +
+	"\x0fBrandNamesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01J\x04\b\x06\x10\a2\x80\x03\n" +
+	"\vDrugService\x12G\n" +
+	"\n" +
+	"CreateDrug\x12\".moh.registry.v1.CreateDrugRequest\x1a\x15.moh.registry.v1.Drug\x12A\n" +
+	"\aGetDrug\x12\x1f.moh.registry.v1.GetDrugRequest\x1a\x15.moh.registry.v1.Drug\x12G\n" +
+	"\n" +
+	"UpdateDrug\x12\".moh.registry.v1.UpdateDrugRequest\x1a\x15.moh.registry.v1.Drug\x12H\n" +
+	"\n" +
+	"DeleteDrug\x12\".moh.registry.v1.DeleteDrugRequest\x1a\x16.google.protobuf.Empty\x12R\n" +
+	"\tListDrugs\x12!.moh.registry.v1.ListDrugsRequest\x1a\".moh.registry.v1.ListDrugsResponseB2Z0moh/internal/adapters/grpc/registrypb;registrypbb\x06proto3"
+
+var (
+	file_moh_registry_v1_drug_proto_rawDescOnce sync.Once
+	file_moh_registry_v1_drug_proto_rawDescData []byte
+)
+
+func file_moh_registry_v1_drug_proto_rawDescGZIP() []byte {
+	file_moh_registry_v1_drug_proto_rawDescOnce.Do(func() {
+		file_moh_registry_v1_drug_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_moh_registry_v1_drug_proto_rawDesc), len(file_moh_registry_v1_drug_proto_rawDesc)))
+	})
+	return file_moh_registry_v1_drug_proto_rawDescData
+}
+
+var file_moh_registry_v1_drug_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_moh_registry_v1_drug_proto_goTypes = []any{
+	(*CreateDrugRequest)(nil),     // 0: moh.registry.v1.CreateDrugRequest
+	(*GetDrugRequest)(nil),        // 1: moh.registry.v1.GetDrugRequest
+	(*UpdateDrugRequest)(nil),     // 2: moh.registry.v1.UpdateDrugRequest
+	(*DeleteDrugRequest)(nil),     // 3: moh.registry.v1.DeleteDrugRequest
+	(*ListDrugsRequest)(nil),      // 4: moh.registry.v1.ListDrugsRequest
+	(*ListDrugsResponse)(nil),     // 5: moh.registry.v1.ListDrugsResponse
+	(*Drug)(nil),                  // 6: moh.registry.v1.Drug
+	nil,                           // 7: moh.registry.v1.Drug.BrandNamesEntry
+	(*timestamppb.Timestamp)(nil), // 8: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),         // 9: google.protobuf.Empty
+}
+var file_moh_registry_v1_drug_proto_depIdxs = []int32{
+	6,  // 0: moh.registry.v1.CreateDrugRequest.drug:type_name -> moh.registry.v1.Drug
+	6,  // 1: moh.registry.v1.UpdateDrugRequest.drug:type_name -> moh.registry.v1.Drug
+	6,  // 2: moh.registry.v1.ListDrugsResponse.items:type_name -> moh.registry.v1.Drug
+	8,  // 3: moh.registry.v1.Drug.created_at:type_name -> google.protobuf.Timestamp
+	8,  // 4: moh.registry.v1.Drug.updated_at:type_name -> google.protobuf.Timestamp
+	7,  // 5: moh.registry.v1.Drug.brand_names:type_name -> moh.registry.v1.Drug.BrandNamesEntry
+	0,  // 6: moh.registry.v1.DrugService.CreateDrug:input_type -> moh.registry.v1.CreateDrugRequest
+	1,  // 7: moh.registry.v1.DrugService.GetDrug:input_type -> moh.registry.v1.GetDrugRequest
+	2,  // 8: moh.registry.v1.DrugService.UpdateDrug:input_type -> moh.registry.v1.UpdateDrugRequest
+	3,  // 9: moh.registry.v1.DrugService.DeleteDrug:input_type -> moh.registry.v1.DeleteDrugRequest
+	4,  // 10: moh.registry.v1.DrugService.ListDrugs:input_type -> moh.registry.v1.ListDrugsRequest
+	6,  // 11: moh.registry.v1.DrugService.CreateDrug:output_type -> moh.registry.v1.Drug
+	6,  // 12: moh.registry.v1.DrugService.GetDrug:output_type -> moh.registry.v1.Drug
+	6,  // 13: moh.registry.v1.DrugService.UpdateDrug:output_type -> moh.registry.v1.Drug
+	9,  // 14: moh.registry.v1.DrugService.DeleteDrug:output_type -> google.protobuf.Empty
+	5,  // 15: moh.registry.v1.DrugService.ListDrugs:output_type -> moh.registry.v1.ListDrugsResponse
+	11, // [11:16] is the sub-list for method output_type
+	6,  // [6:11] is the sub-list for method input_type
+	6,  // [6:6] is the sub-list for extension type_name
+	6,  // [6:6] is the sub-list for extension extendee
+	0,  // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_moh_registry_v1_drug_proto_init() }
+func file_moh_registry_v1_drug_proto_init() {
+	if File_moh_registry_v1_drug_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_moh_registry_v1_drug_proto_rawDesc), len(file_moh_registry_v1_drug_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   8,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_moh_registry_v1_drug_proto_goTypes,
+		DependencyIndexes: file_moh_registry_v1_drug_proto_depIdxs,
+		MessageInfos:      file_moh_registry_v1_drug_proto_msgTypes,
+	}.Build()
+	File_moh_registry_v1_drug_proto = out.File
+	file_moh_registry_v1_drug_proto_goTypes = nil
+	file_moh_registry_v1_drug_proto_depIdxs = nil
+}
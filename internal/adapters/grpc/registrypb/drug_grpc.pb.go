@@ -0,0 +1,278 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.29.3
+// source: moh/registry/v1/drug.proto
+
+package registrypb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	DrugService_CreateDrug_FullMethodName = "/moh.registry.v1.DrugService/CreateDrug"
+	DrugService_GetDrug_FullMethodName    = "/moh.registry.v1.DrugService/GetDrug"
+	DrugService_UpdateDrug_FullMethodName = "/moh.registry.v1.DrugService/UpdateDrug"
+	DrugService_DeleteDrug_FullMethodName = "/moh.registry.v1.DrugService/DeleteDrug"
+	DrugService_ListDrugs_FullMethodName  = "/moh.registry.v1.DrugService/ListDrugs"
+)
+
+// DrugServiceClient is the client API for DrugService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// DrugService manages drug formulations.
+type DrugServiceClient interface {
+	CreateDrug(ctx context.Context, in *CreateDrugRequest, opts ...grpc.CallOption) (*Drug, error)
+	GetDrug(ctx context.Context, in *GetDrugRequest, opts ...grpc.CallOption) (*Drug, error)
+	UpdateDrug(ctx context.Context, in *UpdateDrugRequest, opts ...grpc.CallOption) (*Drug, error)
+	DeleteDrug(ctx context.Context, in *DeleteDrugRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ListDrugs(ctx context.Context, in *ListDrugsRequest, opts ...grpc.CallOption) (*ListDrugsResponse, error)
+}
+
+type drugServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewDrugServiceClient(cc grpc.ClientConnInterface) DrugServiceClient {
+	return &drugServiceClient{cc}
+}
+
+func (c *drugServiceClient) CreateDrug(ctx context.Context, in *CreateDrugRequest, opts ...grpc.CallOption) (*Drug, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Drug)
+	err := c.cc.Invoke(ctx, DrugService_CreateDrug_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drugServiceClient) GetDrug(ctx context.Context, in *GetDrugRequest, opts ...grpc.CallOption) (*Drug, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Drug)
+	err := c.cc.Invoke(ctx, DrugService_GetDrug_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drugServiceClient) UpdateDrug(ctx context.Context, in *UpdateDrugRequest, opts ...grpc.CallOption) (*Drug, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Drug)
+	err := c.cc.Invoke(ctx, DrugService_UpdateDrug_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drugServiceClient) DeleteDrug(ctx context.Context, in *DeleteDrugRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, DrugService_DeleteDrug_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drugServiceClient) ListDrugs(ctx context.Context, in *ListDrugsRequest, opts ...grpc.CallOption) (*ListDrugsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDrugsResponse)
+	err := c.cc.Invoke(ctx, DrugService_ListDrugs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DrugServiceServer is the server API for DrugService service.
+// All implementations must embed UnimplementedDrugServiceServer
+// for forward compatibility.
+//
+// DrugService manages drug formulations.
+type DrugServiceServer interface {
+	CreateDrug(context.Context, *CreateDrugRequest) (*Drug, error)
+	GetDrug(context.Context, *GetDrugRequest) (*Drug, error)
+	UpdateDrug(context.Context, *UpdateDrugRequest) (*Drug, error)
+	DeleteDrug(context.Context, *DeleteDrugRequest) (*emptypb.Empty, error)
+	ListDrugs(context.Context, *ListDrugsRequest) (*ListDrugsResponse, error)
+	mustEmbedUnimplementedDrugServiceServer()
+}
+
+// UnimplementedDrugServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedDrugServiceServer struct{}
+
+func (UnimplementedDrugServiceServer) CreateDrug(context.Context, *CreateDrugRequest) (*Drug, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateDrug not implemented")
+}
+func (UnimplementedDrugServiceServer) GetDrug(context.Context, *GetDrugRequest) (*Drug, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDrug not implemented")
+}
+func (UnimplementedDrugServiceServer) UpdateDrug(context.Context, *UpdateDrugRequest) (*Drug, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateDrug not implemented")
+}
+func (UnimplementedDrugServiceServer) DeleteDrug(context.Context, *DeleteDrugRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteDrug not implemented")
+}
+func (UnimplementedDrugServiceServer) ListDrugs(context.Context, *ListDrugsRequest) (*ListDrugsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDrugs not implemented")
+}
+func (UnimplementedDrugServiceServer) mustEmbedUnimplementedDrugServiceServer() {}
+func (UnimplementedDrugServiceServer) testEmbeddedByValue()                     {}
+
+// UnsafeDrugServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to DrugServiceServer will
+// result in compilation errors.
+type UnsafeDrugServiceServer interface {
+	mustEmbedUnimplementedDrugServiceServer()
+}
+
+func RegisterDrugServiceServer(s grpc.ServiceRegistrar, srv DrugServiceServer) {
+	// If the following call pancis, it indicates UnimplementedDrugServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&DrugService_ServiceDesc, srv)
+}
+
+func _DrugService_CreateDrug_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateDrugRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DrugServiceServer).CreateDrug(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DrugService_CreateDrug_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DrugServiceServer).CreateDrug(ctx, req.(*CreateDrugRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DrugService_GetDrug_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDrugRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DrugServiceServer).GetDrug(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DrugService_GetDrug_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DrugServiceServer).GetDrug(ctx, req.(*GetDrugRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DrugService_UpdateDrug_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateDrugRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DrugServiceServer).UpdateDrug(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DrugService_UpdateDrug_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DrugServiceServer).UpdateDrug(ctx, req.(*UpdateDrugRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DrugService_DeleteDrug_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteDrugRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DrugServiceServer).DeleteDrug(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DrugService_DeleteDrug_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DrugServiceServer).DeleteDrug(ctx, req.(*DeleteDrugRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DrugService_ListDrugs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDrugsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DrugServiceServer).ListDrugs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DrugService_ListDrugs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DrugServiceServer).ListDrugs(ctx, req.(*ListDrugsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// DrugService_ServiceDesc is the grpc.ServiceDesc for DrugService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var DrugService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "moh.registry.v1.DrugService",
+	HandlerType: (*DrugServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateDrug",
+			Handler:    _DrugService_CreateDrug_Handler,
+		},
+		{
+			MethodName: "GetDrug",
+			Handler:    _DrugService_GetDrug_Handler,
+		},
+		{
+			MethodName: "UpdateDrug",
+			Handler:    _DrugService_UpdateDrug_Handler,
+		},
+		{
+			MethodName: "DeleteDrug",
+			Handler:    _DrugService_DeleteDrug_Handler,
+		},
+		{
+			MethodName: "ListDrugs",
+			Handler:    _DrugService_ListDrugs_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "moh/registry/v1/drug.proto",
+}
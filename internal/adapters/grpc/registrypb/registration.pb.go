@@ -0,0 +1,1464 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v5.29.3
+// source: moh/registry/v1/registration.proto
+
+package registrypb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type CreateDrugRegistrationRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	DrugRegistration *DrugRegistration      `protobuf:"bytes,1,opt,name=drug_registration,json=drugRegistration,proto3" json:"drug_registration,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *CreateDrugRegistrationRequest) Reset() {
+	*x = CreateDrugRegistrationRequest{}
+	mi := &file_moh_registry_v1_registration_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateDrugRegistrationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateDrugRegistrationRequest) ProtoMessage() {}
+
+func (x *CreateDrugRegistrationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_moh_registry_v1_registration_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateDrugRegistrationRequest.ProtoReflect.Descriptor instead.
+func (*CreateDrugRegistrationRequest) Descriptor() ([]byte, []int) {
+	return file_moh_registry_v1_registration_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CreateDrugRegistrationRequest) GetDrugRegistration() *DrugRegistration {
+	if x != nil {
+		return x.DrugRegistration
+	}
+	return nil
+}
+
+type GetDrugRegistrationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDrugRegistrationRequest) Reset() {
+	*x = GetDrugRegistrationRequest{}
+	mi := &file_moh_registry_v1_registration_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDrugRegistrationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDrugRegistrationRequest) ProtoMessage() {}
+
+func (x *GetDrugRegistrationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_moh_registry_v1_registration_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDrugRegistrationRequest.ProtoReflect.Descriptor instead.
+func (*GetDrugRegistrationRequest) Descriptor() ([]byte, []int) {
+	return file_moh_registry_v1_registration_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetDrugRegistrationRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// UpdateDrugRegistrationRequest replaces every editable field of drug_registration.id.
+type UpdateDrugRegistrationRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	DrugRegistration *DrugRegistration      `protobuf:"bytes,1,opt,name=drug_registration,json=drugRegistration,proto3" json:"drug_registration,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *UpdateDrugRegistrationRequest) Reset() {
+	*x = UpdateDrugRegistrationRequest{}
+	mi := &file_moh_registry_v1_registration_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateDrugRegistrationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateDrugRegistrationRequest) ProtoMessage() {}
+
+func (x *UpdateDrugRegistrationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_moh_registry_v1_registration_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateDrugRegistrationRequest.ProtoReflect.Descriptor instead.
+func (*UpdateDrugRegistrationRequest) Descriptor() ([]byte, []int) {
+	return file_moh_registry_v1_registration_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *UpdateDrugRegistrationRequest) GetDrugRegistration() *DrugRegistration {
+	if x != nil {
+		return x.DrugRegistration
+	}
+	return nil
+}
+
+type DeleteDrugRegistrationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteDrugRegistrationRequest) Reset() {
+	*x = DeleteDrugRegistrationRequest{}
+	mi := &file_moh_registry_v1_registration_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteDrugRegistrationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteDrugRegistrationRequest) ProtoMessage() {}
+
+func (x *DeleteDrugRegistrationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_moh_registry_v1_registration_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteDrugRegistrationRequest.ProtoReflect.Descriptor instead.
+func (*DeleteDrugRegistrationRequest) Descriptor() ([]byte, []int) {
+	return file_moh_registry_v1_registration_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *DeleteDrugRegistrationRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListDrugRegistrationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageSize      int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`   // 1..500, default 50
+	PageToken     string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"` // next_page_token of the previous page
+	OrderBy       string                 `protobuf:"bytes,3,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`       // whitelisted field, "-" prefix for descending
+	Status        string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	DrugId        string                 `protobuf:"bytes,5,opt,name=drug_id,json=drugId,proto3" json:"drug_id,omitempty"`
+	MaId          string                 `protobuf:"bytes,6,opt,name=ma_id,json=maId,proto3" json:"ma_id,omitempty"`
+	ValidOn       string                 `protobuf:"bytes,7,opt,name=valid_on,json=validOn,proto3" json:"valid_on,omitempty"` // YYYY-MM-DD
+	IsPrimary     *bool                  `protobuf:"varint,8,opt,name=is_primary,json=isPrimary,proto3,oneof" json:"is_primary,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDrugRegistrationsRequest) Reset() {
+	*x = ListDrugRegistrationsRequest{}
+	mi := &file_moh_registry_v1_registration_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDrugRegistrationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDrugRegistrationsRequest) ProtoMessage() {}
+
+func (x *ListDrugRegistrationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_moh_registry_v1_registration_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDrugRegistrationsRequest.ProtoReflect.Descriptor instead.
+func (*ListDrugRegistrationsRequest) Descriptor() ([]byte, []int) {
+	return file_moh_registry_v1_registration_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListDrugRegistrationsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListDrugRegistrationsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListDrugRegistrationsRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+func (x *ListDrugRegistrationsRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListDrugRegistrationsRequest) GetDrugId() string {
+	if x != nil {
+		return x.DrugId
+	}
+	return ""
+}
+
+func (x *ListDrugRegistrationsRequest) GetMaId() string {
+	if x != nil {
+		return x.MaId
+	}
+	return ""
+}
+
+func (x *ListDrugRegistrationsRequest) GetValidOn() string {
+	if x != nil {
+		return x.ValidOn
+	}
+	return ""
+}
+
+func (x *ListDrugRegistrationsRequest) GetIsPrimary() bool {
+	if x != nil && x.IsPrimary != nil {
+		return *x.IsPrimary
+	}
+	return false
+}
+
+type ListDrugRegistrationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*DrugRegistration    `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	TotalSize     int64                  `protobuf:"varint,3,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDrugRegistrationsResponse) Reset() {
+	*x = ListDrugRegistrationsResponse{}
+	mi := &file_moh_registry_v1_registration_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDrugRegistrationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDrugRegistrationsResponse) ProtoMessage() {}
+
+func (x *ListDrugRegistrationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_moh_registry_v1_registration_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDrugRegistrationsResponse.ProtoReflect.Descriptor instead.
+func (*ListDrugRegistrationsResponse) Descriptor() ([]byte, []int) {
+	return file_moh_registry_v1_registration_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListDrugRegistrationsResponse) GetItems() []*DrugRegistration {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ListDrugRegistrationsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListDrugRegistrationsResponse) GetTotalSize() int64 {
+	if x != nil {
+		return x.TotalSize
+	}
+	return 0
+}
+
+type CreateDrugRegistrationSiteRequest struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	DrugRegistrationSite *DrugRegistrationSite  `protobuf:"bytes,1,opt,name=drug_registration_site,json=drugRegistrationSite,proto3" json:"drug_registration_site,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *CreateDrugRegistrationSiteRequest) Reset() {
+	*x = CreateDrugRegistrationSiteRequest{}
+	mi := &file_moh_registry_v1_registration_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateDrugRegistrationSiteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateDrugRegistrationSiteRequest) ProtoMessage() {}
+
+func (x *CreateDrugRegistrationSiteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_moh_registry_v1_registration_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateDrugRegistrationSiteRequest.ProtoReflect.Descriptor instead.
+func (*CreateDrugRegistrationSiteRequest) Descriptor() ([]byte, []int) {
+	return file_moh_registry_v1_registration_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CreateDrugRegistrationSiteRequest) GetDrugRegistrationSite() *DrugRegistrationSite {
+	if x != nil {
+		return x.DrugRegistrationSite
+	}
+	return nil
+}
+
+type GetDrugRegistrationSiteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDrugRegistrationSiteRequest) Reset() {
+	*x = GetDrugRegistrationSiteRequest{}
+	mi := &file_moh_registry_v1_registration_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDrugRegistrationSiteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDrugRegistrationSiteRequest) ProtoMessage() {}
+
+func (x *GetDrugRegistrationSiteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_moh_registry_v1_registration_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDrugRegistrationSiteRequest.ProtoReflect.Descriptor instead.
+func (*GetDrugRegistrationSiteRequest) Descriptor() ([]byte, []int) {
+	return file_moh_registry_v1_registration_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetDrugRegistrationSiteRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// UpdateDrugRegistrationSiteRequest replaces every editable field of drug_registration_site.id.
+type UpdateDrugRegistrationSiteRequest struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	DrugRegistrationSite *DrugRegistrationSite  `protobuf:"bytes,1,opt,name=drug_registration_site,json=drugRegistrationSite,proto3" json:"drug_registration_site,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *UpdateDrugRegistrationSiteRequest) Reset() {
+	*x = UpdateDrugRegistrationSiteRequest{}
+	mi := &file_moh_registry_v1_registration_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateDrugRegistrationSiteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateDrugRegistrationSiteRequest) ProtoMessage() {}
+
+func (x *UpdateDrugRegistrationSiteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_moh_registry_v1_registration_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateDrugRegistrationSiteRequest.ProtoReflect.Descriptor instead.
+func (*UpdateDrugRegistrationSiteRequest) Descriptor() ([]byte, []int) {
+	return file_moh_registry_v1_registration_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *UpdateDrugRegistrationSiteRequest) GetDrugRegistrationSite() *DrugRegistrationSite {
+	if x != nil {
+		return x.DrugRegistrationSite
+	}
+	return nil
+}
+
+type DeleteDrugRegistrationSiteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteDrugRegistrationSiteRequest) Reset() {
+	*x = DeleteDrugRegistrationSiteRequest{}
+	mi := &file_moh_registry_v1_registration_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteDrugRegistrationSiteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteDrugRegistrationSiteRequest) ProtoMessage() {}
+
+func (x *DeleteDrugRegistrationSiteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_moh_registry_v1_registration_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteDrugRegistrationSiteRequest.ProtoReflect.Descriptor instead.
+func (*DeleteDrugRegistrationSiteRequest) Descriptor() ([]byte, []int) {
+	return file_moh_registry_v1_registration_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *DeleteDrugRegistrationSiteRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListDrugRegistrationSitesRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	PageSize           int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`   // 1..500, default 50
+	PageToken          string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"` // next_page_token of the previous page
+	OrderBy            string                 `protobuf:"bytes,3,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`       // whitelisted field, "-" prefix for descending
+	DrugRegistrationId string                 `protobuf:"bytes,4,opt,name=drug_registration_id,json=drugRegistrationId,proto3" json:"drug_registration_id,omitempty"`
+	SiteId             string                 `protobuf:"bytes,5,opt,name=site_id,json=siteId,proto3" json:"site_id,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *ListDrugRegistrationSitesRequest) Reset() {
+	*x = ListDrugRegistrationSitesRequest{}
+	mi := &file_moh_registry_v1_registration_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDrugRegistrationSitesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDrugRegistrationSitesRequest) ProtoMessage() {}
+
+func (x *ListDrugRegistrationSitesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_moh_registry_v1_registration_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDrugRegistrationSitesRequest.ProtoReflect.Descriptor instead.
+func (*ListDrugRegistrationSitesRequest) Descriptor() ([]byte, []int) {
+	return file_moh_registry_v1_registration_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListDrugRegistrationSitesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListDrugRegistrationSitesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListDrugRegistrationSitesRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+func (x *ListDrugRegistrationSitesRequest) GetDrugRegistrationId() string {
+	if x != nil {
+		return x.DrugRegistrationId
+	}
+	return ""
+}
+
+func (x *ListDrugRegistrationSitesRequest) GetSiteId() string {
+	if x != nil {
+		return x.SiteId
+	}
+	return ""
+}
+
+type ListDrugRegistrationSitesResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Items         []*DrugRegistrationSite `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	NextPageToken string                  `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	TotalSize     int64                   `protobuf:"varint,3,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDrugRegistrationSitesResponse) Reset() {
+	*x = ListDrugRegistrationSitesResponse{}
+	mi := &file_moh_registry_v1_registration_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDrugRegistrationSitesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDrugRegistrationSitesResponse) ProtoMessage() {}
+
+func (x *ListDrugRegistrationSitesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_moh_registry_v1_registration_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDrugRegistrationSitesResponse.ProtoReflect.Descriptor instead.
+func (*ListDrugRegistrationSitesResponse) Descriptor() ([]byte, []int) {
+	return file_moh_registry_v1_registration_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ListDrugRegistrationSitesResponse) GetItems() []*DrugRegistrationSite {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ListDrugRegistrationSitesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListDrugRegistrationSitesResponse) GetTotalSize() int64 {
+	if x != nil {
+		return x.TotalSize
+	}
+	return 0
+}
+
+type CreateDrugRegistrationAuthHolderRequest struct {
+	state                      protoimpl.MessageState      `protogen:"open.v1"`
+	DrugRegistrationAuthHolder *DrugRegistrationAuthHolder `protobuf:"bytes,1,opt,name=drug_registration_auth_holder,json=drugRegistrationAuthHolder,proto3" json:"drug_registration_auth_holder,omitempty"`
+	unknownFields              protoimpl.UnknownFields
+	sizeCache                  protoimpl.SizeCache
+}
+
+func (x *CreateDrugRegistrationAuthHolderRequest) Reset() {
+	*x = CreateDrugRegistrationAuthHolderRequest{}
+	mi := &file_moh_registry_v1_registration_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateDrugRegistrationAuthHolderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateDrugRegistrationAuthHolderRequest) ProtoMessage() {}
+
+func (x *CreateDrugRegistrationAuthHolderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_moh_registry_v1_registration_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateDrugRegistrationAuthHolderRequest.ProtoReflect.Descriptor instead.
+func (*CreateDrugRegistrationAuthHolderRequest) Descriptor() ([]byte, []int) {
+	return file_moh_registry_v1_registration_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *CreateDrugRegistrationAuthHolderRequest) GetDrugRegistrationAuthHolder() *DrugRegistrationAuthHolder {
+	if x != nil {
+		return x.DrugRegistrationAuthHolder
+	}
+	return nil
+}
+
+type GetDrugRegistrationAuthHolderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDrugRegistrationAuthHolderRequest) Reset() {
+	*x = GetDrugRegistrationAuthHolderRequest{}
+	mi := &file_moh_registry_v1_registration_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDrugRegistrationAuthHolderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDrugRegistrationAuthHolderRequest) ProtoMessage() {}
+
+func (x *GetDrugRegistrationAuthHolderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_moh_registry_v1_registration_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDrugRegistrationAuthHolderRequest.ProtoReflect.Descriptor instead.
+func (*GetDrugRegistrationAuthHolderRequest) Descriptor() ([]byte, []int) {
+	return file_moh_registry_v1_registration_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetDrugRegistrationAuthHolderRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// UpdateDrugRegistrationAuthHolderRequest replaces every editable field of drug_registration_auth_holder.id.
+type UpdateDrugRegistrationAuthHolderRequest struct {
+	state                      protoimpl.MessageState      `protogen:"open.v1"`
+	DrugRegistrationAuthHolder *DrugRegistrationAuthHolder `protobuf:"bytes,1,opt,name=drug_registration_auth_holder,json=drugRegistrationAuthHolder,proto3" json:"drug_registration_auth_holder,omitempty"`
+	unknownFields              protoimpl.UnknownFields
+	sizeCache                  protoimpl.SizeCache
+}
+
+func (x *UpdateDrugRegistrationAuthHolderRequest) Reset() {
+	*x = UpdateDrugRegistrationAuthHolderRequest{}
+	mi := &file_moh_registry_v1_registration_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateDrugRegistrationAuthHolderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateDrugRegistrationAuthHolderRequest) ProtoMessage() {}
+
+func (x *UpdateDrugRegistrationAuthHolderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_moh_registry_v1_registration_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateDrugRegistrationAuthHolderRequest.ProtoReflect.Descriptor instead.
+func (*UpdateDrugRegistrationAuthHolderRequest) Descriptor() ([]byte, []int) {
+	return file_moh_registry_v1_registration_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *UpdateDrugRegistrationAuthHolderRequest) GetDrugRegistrationAuthHolder() *DrugRegistrationAuthHolder {
+	if x != nil {
+		return x.DrugRegistrationAuthHolder
+	}
+	return nil
+}
+
+type DeleteDrugRegistrationAuthHolderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteDrugRegistrationAuthHolderRequest) Reset() {
+	*x = DeleteDrugRegistrationAuthHolderRequest{}
+	mi := &file_moh_registry_v1_registration_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteDrugRegistrationAuthHolderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteDrugRegistrationAuthHolderRequest) ProtoMessage() {}
+
+func (x *DeleteDrugRegistrationAuthHolderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_moh_registry_v1_registration_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteDrugRegistrationAuthHolderRequest.ProtoReflect.Descriptor instead.
+func (*DeleteDrugRegistrationAuthHolderRequest) Descriptor() ([]byte, []int) {
+	return file_moh_registry_v1_registration_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *DeleteDrugRegistrationAuthHolderRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListDrugRegistrationAuthHoldersRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	PageSize           int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`   // 1..500, default 50
+	PageToken          string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"` // next_page_token of the previous page
+	OrderBy            string                 `protobuf:"bytes,3,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`       // whitelisted field, "-" prefix for descending
+	DrugRegistrationId string                 `protobuf:"bytes,4,opt,name=drug_registration_id,json=drugRegistrationId,proto3" json:"drug_registration_id,omitempty"`
+	AuthHolderId       string                 `protobuf:"bytes,5,opt,name=auth_holder_id,json=authHolderId,proto3" json:"auth_holder_id,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *ListDrugRegistrationAuthHoldersRequest) Reset() {
+	*x = ListDrugRegistrationAuthHoldersRequest{}
+	mi := &file_moh_registry_v1_registration_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDrugRegistrationAuthHoldersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDrugRegistrationAuthHoldersRequest) ProtoMessage() {}
+
+func (x *ListDrugRegistrationAuthHoldersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_moh_registry_v1_registration_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDrugRegistrationAuthHoldersRequest.ProtoReflect.Descriptor instead.
+func (*ListDrugRegistrationAuthHoldersRequest) Descriptor() ([]byte, []int) {
+	return file_moh_registry_v1_registration_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ListDrugRegistrationAuthHoldersRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListDrugRegistrationAuthHoldersRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListDrugRegistrationAuthHoldersRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+func (x *ListDrugRegistrationAuthHoldersRequest) GetDrugRegistrationId() string {
+	if x != nil {
+		return x.DrugRegistrationId
+	}
+	return ""
+}
+
+func (x *ListDrugRegistrationAuthHoldersRequest) GetAuthHolderId() string {
+	if x != nil {
+		return x.AuthHolderId
+	}
+	return ""
+}
+
+type ListDrugRegistrationAuthHoldersResponse struct {
+	state         protoimpl.MessageState        `protogen:"open.v1"`
+	Items         []*DrugRegistrationAuthHolder `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	NextPageToken string                        `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	TotalSize     int64                         `protobuf:"varint,3,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDrugRegistrationAuthHoldersResponse) Reset() {
+	*x = ListDrugRegistrationAuthHoldersResponse{}
+	mi := &file_moh_registry_v1_registration_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDrugRegistrationAuthHoldersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDrugRegistrationAuthHoldersResponse) ProtoMessage() {}
+
+func (x *ListDrugRegistrationAuthHoldersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_moh_registry_v1_registration_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDrugRegistrationAuthHoldersResponse.ProtoReflect.Descriptor instead.
+func (*ListDrugRegistrationAuthHoldersResponse) Descriptor() ([]byte, []int) {
+	return file_moh_registry_v1_registration_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ListDrugRegistrationAuthHoldersResponse) GetItems() []*DrugRegistrationAuthHolder {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ListDrugRegistrationAuthHoldersResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListDrugRegistrationAuthHoldersResponse) GetTotalSize() int64 {
+	if x != nil {
+		return x.TotalSize
+	}
+	return 0
+}
+
+type DrugRegistration struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Id                 string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	DrugId             string                 `protobuf:"bytes,2,opt,name=drug_id,json=drugId,proto3" json:"drug_id,omitempty"`
+	MaId               string                 `protobuf:"bytes,3,opt,name=ma_id,json=maId,proto3" json:"ma_id,omitempty"`
+	RegistrationNumber string                 `protobuf:"bytes,4,opt,name=registration_number,json=registrationNumber,proto3" json:"registration_number,omitempty"`
+	Status             string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`                        // active, suspended, expired, withdrawn
+	ValidFrom          string                 `protobuf:"bytes,6,opt,name=valid_from,json=validFrom,proto3" json:"valid_from,omitempty"` // YYYY-MM-DD
+	ValidTo            string                 `protobuf:"bytes,7,opt,name=valid_to,json=validTo,proto3" json:"valid_to,omitempty"`       // YYYY-MM-DD
+	IsPrimary          bool                   `protobuf:"varint,8,opt,name=is_primary,json=isPrimary,proto3" json:"is_primary,omitempty"`
+	CreatedAt          *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt          *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *DrugRegistration) Reset() {
+	*x = DrugRegistration{}
+	mi := &file_moh_registry_v1_registration_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DrugRegistration) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DrugRegistration) ProtoMessage() {}
+
+func (x *DrugRegistration) ProtoReflect() protoreflect.Message {
+	mi := &file_moh_registry_v1_registration_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DrugRegistration.ProtoReflect.Descriptor instead.
+func (*DrugRegistration) Descriptor() ([]byte, []int) {
+	return file_moh_registry_v1_registration_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *DrugRegistration) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DrugRegistration) GetDrugId() string {
+	if x != nil {
+		return x.DrugId
+	}
+	return ""
+}
+
+func (x *DrugRegistration) GetMaId() string {
+	if x != nil {
+		return x.MaId
+	}
+	return ""
+}
+
+func (x *DrugRegistration) GetRegistrationNumber() string {
+	if x != nil {
+		return x.RegistrationNumber
+	}
+	return ""
+}
+
+func (x *DrugRegistration) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *DrugRegistration) GetValidFrom() string {
+	if x != nil {
+		return x.ValidFrom
+	}
+	return ""
+}
+
+func (x *DrugRegistration) GetValidTo() string {
+	if x != nil {
+		return x.ValidTo
+	}
+	return ""
+}
+
+func (x *DrugRegistration) GetIsPrimary() bool {
+	if x != nil {
+		return x.IsPrimary
+	}
+	return false
+}
+
+func (x *DrugRegistration) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *DrugRegistration) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type DrugRegistrationSite struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Id                 string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	DrugRegistrationId string                 `protobuf:"bytes,2,opt,name=drug_registration_id,json=drugRegistrationId,proto3" json:"drug_registration_id,omitempty"`
+	SiteId             string                 `protobuf:"bytes,3,opt,name=site_id,json=siteId,proto3" json:"site_id,omitempty"`
+	Role               string                 `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *DrugRegistrationSite) Reset() {
+	*x = DrugRegistrationSite{}
+	mi := &file_moh_registry_v1_registration_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DrugRegistrationSite) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DrugRegistrationSite) ProtoMessage() {}
+
+func (x *DrugRegistrationSite) ProtoReflect() protoreflect.Message {
+	mi := &file_moh_registry_v1_registration_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DrugRegistrationSite.ProtoReflect.Descriptor instead.
+func (*DrugRegistrationSite) Descriptor() ([]byte, []int) {
+	return file_moh_registry_v1_registration_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *DrugRegistrationSite) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DrugRegistrationSite) GetDrugRegistrationId() string {
+	if x != nil {
+		return x.DrugRegistrationId
+	}
+	return ""
+}
+
+func (x *DrugRegistrationSite) GetSiteId() string {
+	if x != nil {
+		return x.SiteId
+	}
+	return ""
+}
+
+func (x *DrugRegistrationSite) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+type DrugRegistrationAuthHolder struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Id                 string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	DrugRegistrationId string                 `protobuf:"bytes,2,opt,name=drug_registration_id,json=drugRegistrationId,proto3" json:"drug_registration_id,omitempty"`
+	AuthHolderId       string                 `protobuf:"bytes,3,opt,name=auth_holder_id,json=authHolderId,proto3" json:"auth_holder_id,omitempty"`
+	Role               string                 `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *DrugRegistrationAuthHolder) Reset() {
+	*x = DrugRegistrationAuthHolder{}
+	mi := &file_moh_registry_v1_registration_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DrugRegistrationAuthHolder) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DrugRegistrationAuthHolder) ProtoMessage() {}
+
+func (x *DrugRegistrationAuthHolder) ProtoReflect() protoreflect.Message {
+	mi := &file_moh_registry_v1_registration_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DrugRegistrationAuthHolder.ProtoReflect.Descriptor instead.
+func (*DrugRegistrationAuthHolder) Descriptor() ([]byte, []int) {
+	return file_moh_registry_v1_registration_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *DrugRegistrationAuthHolder) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DrugRegistrationAuthHolder) GetDrugRegistrationId() string {
+	if x != nil {
+		return x.DrugRegistrationId
+	}
+	return ""
+}
+
+func (x *DrugRegistrationAuthHolder) GetAuthHolderId() string {
+	if x != nil {
+		return x.AuthHolderId
+	}
+	return ""
+}
+
+func (x *DrugRegistrationAuthHolder) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+var File_moh_registry_v1_registration_proto protoreflect.FileDescriptor
+
+const file_moh_registry_v1_registration_proto_rawDesc = "" +
+	"\n" +
+	"\"moh/registry/v1/registration.proto\x12\x0fmoh.registry.v1\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"o\n" +
+	"\x1dCreateDrugRegistrationRequest\x12N\n" +
+	"\x11drug_registration\x18\x01 \x01(\v2!.moh.registry.v1.DrugRegistrationR\x10drugRegistration\",\n" +
+	"\x1aGetDrugRegistrationRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"o\n" +
+	"\x1dUpdateDrugRegistrationRequest\x12N\n" +
+	"\x11drug_registration\x18\x01 \x01(\v2!.moh.registry.v1.DrugRegistrationR\x10drugRegistration\"/\n" +
+	"\x1dDeleteDrugRegistrationRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x89\x02\n" +
+	"\x1cListDrugRegistrationsRequest\x12\x1b\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\x12\x19\n" +
+	"\border_by\x18\x03 \x01(\tR\aorderBy\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12\x17\n" +
+	"\adrug_id\x18\x05 \x01(\tR\x06drugId\x12\x13\n" +
+	"\x05ma_id\x18\x06 \x01(\tR\x04maId\x12\x19\n" +
+	"\bvalid_on\x18\a \x01(\tR\avalidOn\x12\"\n" +
+	"\n" +
+	"is_primary\x18\b \x01(\bH\x00R\tisPrimary\x88\x01\x01B\r\n" +
+	"\v_is_primary\"\x9f\x01\n" +
+	"\x1dListDrugRegistrationsResponse\x127\n" +
+	"\x05items\x18\x01 \x03(\v2!.moh.registry.v1.DrugRegistrationR\x05items\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1d\n" +
+	"\n" +
+	"total_size\x18\x03 \x01(\x03R\ttotalSize\"\x80\x01\n" +
+	"!CreateDrugRegistrationSiteRequest\x12[\n" +
+	"\x16drug_registration_site\x18\x01 \x01(\v2%.moh.registry.v1.DrugRegistrationSiteR\x14drugRegistrationSite\"0\n" +
+	"\x1eGetDrugRegistrationSiteRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x80\x01\n" +
+	"!UpdateDrugRegistrationSiteRequest\x12[\n" +
+	"\x16drug_registration_site\x18\x01 \x01(\v2%.moh.registry.v1.DrugRegistrationSiteR\x14drugRegistrationSite\"3\n" +
+	"!DeleteDrugRegistrationSiteRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\xc4\x01\n" +
+	" ListDrugRegistrationSitesRequest\x12\x1b\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\x12\x19\n" +
+	"\border_by\x18\x03 \x01(\tR\aorderBy\x120\n" +
+	"\x14drug_registration_id\x18\x04 \x01(\tR\x12drugRegistrationId\x12\x17\n" +
+	"\asite_id\x18\x05 \x01(\tR\x06siteId\"\xa7\x01\n" +
+	"!ListDrugRegistrationSitesResponse\x12;\n" +
+	"\x05items\x18\x01 \x03(\v2%.moh.registry.v1.DrugRegistrationSiteR\x05items\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1d\n" +
+	"\n" +
+	"total_size\x18\x03 \x01(\x03R\ttotalSize\"\x99\x01\n" +
+	"'CreateDrugRegistrationAuthHolderRequest\x12n\n" +
+	"\x1ddrug_registration_auth_holder\x18\x01 \x01(\v2+.moh.registry.v1.DrugRegistrationAuthHolderR\x1adrugRegistrationAuthHolder\"6\n" +
+	"$GetDrugRegistrationAuthHolderRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x99\x01\n" +
+	"'UpdateDrugRegistrationAuthHolderRequest\x12n\n" +
+	"\x1ddrug_registration_auth_holder\x18\x01 \x01(\v2+.moh.registry.v1.DrugRegistrationAuthHolderR\x1adrugRegistrationAuthHolder\"9\n" +
+	"'DeleteDrugRegistrationAuthHolderRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\xd7\x01\n" +
+	"&ListDrugRegistrationAuthHoldersRequest\x12\x1b\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\x12\x19\n" +
+	"\border_by\x18\x03 \x01(\tR\aorderBy\x120\n" +
+	"\x14drug_registration_id\x18\x04 \x01(\tR\x12drugRegistrationId\x12$\n" +
+	"\x0eauth_holder_id\x18\x05 \x01(\tR\fauthHolderId\"\xb3\x01\n" +
+	"'ListDrugRegistrationAuthHoldersResponse\x12A\n" +
+	"\x05items\x18\x01 \x03(\v2+.moh.registry.v1.DrugRegistrationAuthHolderR\x05items\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1d\n" +
+	"\n" +
+	"total_size\x18\x03 \x01(\x03R\ttotalSize\"\xe8\x02\n" +
+	"\x10DrugRegistration\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\adrug_id\x18\x02 \x01(\tR\x06drugId\x12\x13\n" +
+	"\x05ma_id\x18\x03 \x01(\tR\x04maId\x12/\n" +
+	"\x13registration_number\x18\x04 \x01(\tR\x12registrationNumber\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\x12\x1d\n" +
+	"\n" +
+	"valid_from\x18\x06 \x01(\tR\tvalidFrom\x12\x19\n" +
+	"\bvalid_to\x18\a \x01(\tR\avalidTo\x12\x1d\n" +
+	"\n" +
+	"is_primary\x18\b \x01(\bR\tisPrimary\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x85\x01\n" +
+	"\x14DrugRegistrationSite\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x120\n" +
+	"\x14drug_registration_id\x18\x02 \x01(\tR\x12drugRegistrationId\x12\x17\n" +
+	"\asite_id\x18\x03 \x01(\tR\x06siteId\x12\x12\n" +
+	"\x04role\x18\x04 \x01(\tR\x04role\"\x98\x01\n" +
+	"\x1aDrugRegistrationAuthHolder\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x120\n" +
+	"\x14drug_registration_id\x18\x02 \x01(\tR\x12drugRegistrationId\x12$\n" +
+	"\x0eauth_holder_id\x18\x03 \x01(\tR\fauthHolderId\x12\x12\n" +
+	"\x04role\x18\x04 \x01(\tR\x04role2\xaf\x0e\n" +
+	"\x13RegistrationService\x12k\n" +
+	"\x16CreateDrugRegistration\x12..moh.registry.v1.CreateDrugRegistrationRequest\x1a!.moh.registry.v1.DrugRegistration\x12e\n" +
+	"\x13GetDrugRegistration\x12+.moh.registry.v1.GetDrugRegistrationRequest\x1a!.moh.registry.v1.DrugRegistration\x12k\n" +
+	"\x16UpdateDrugRegistration\x12..moh.registry.v1.UpdateDrugRegistrationRequest\x1a!.moh.registry.v1.DrugRegistration\x12`\n" +
+	"\x16DeleteDrugRegistration\x12..moh.registry.v1.DeleteDrugRegistrationRequest\x1a\x16.google.protobuf.Empty\x12v\n" +
+	"\x15ListDrugRegistrations\x12-.moh.registry.v1.ListDrugRegistrationsRequest\x1a..moh.registry.v1.ListDrugRegistrationsResponse\x12w\n" +
+	"\x1aCreateDrugRegistrationSite\x122.moh.registry.v1.CreateDrugRegistrationSiteRequest\x1a%.moh.registry.v1.DrugRegistrationSite\x12q\n" +
+	"\x17GetDrugRegistrationSite\x12/.moh.registry.v1.GetDrugRegistrationSiteRequest\x1a%.moh.registry.v1.DrugRegistrationSite\x12w\n" +
+	"\x1aUpdateDrugRegistrationSite\x122.moh.registry.v1.UpdateDrugRegistrationSiteRequest\x1a%.moh.registry.v1.DrugRegistrationSite\x12h\n" +
+	"\x1aDeleteDrugRegistrationSite\x122.moh.registry.v1.DeleteDrugRegistrationSiteRequest\x1a\x16.google.protobuf.Empty\x12\x82\x01\n" +
+	"\x19ListDrugRegistrationSites\x121.moh.registry.v1.ListDrugRegistrationSitesRequest\x1a2.moh.registry.v1.ListDrugRegistrationSitesResponse\x12\x89\x01\n" +
+	" CreateDrugRegistrationAuthHolder\x128.moh.registry.v1.CreateDrugRegistrationAuthHolderRequest\x1a+.moh.registry.v1.DrugRegistrationAuthHolder\x12\x83\x01\n" +
+	"\x1dGetDrugRegistrationAuthHolder\x125.moh.registry.v1.GetDrugRegistrationAuthHolderRequest\x1a+.moh.registry.v1.DrugRegistrationAuthHolder\x12\x89\x01\n" +
+	" UpdateDrugRegistrationAuthHolder\x128.moh.registry.v1.UpdateDrugRegistrationAuthHolderRequest\x1a+.moh.registry.v1.DrugRegistrationAuthHolder\x12t\n" +
+	" DeleteDrugRegistrationAuthHolder\x128.moh.registry.v1.DeleteDrugRegistrationAuthHolderRequest\x1a\x16.google.protobuf.Empty\x12\x94\x01\n" +
+	"\x1fListDrugRegistrationAuthHolders\x127.moh.registry.v1.ListDrugRegistrationAuthHoldersRequest\x1a8.moh.registry.v1.ListDrugRegistrationAuthHoldersResponseB2Z0moh/internal/adapters/grpc/registrypb;registrypbb\x06proto3"
+
+var (
+	file_moh_registry_v1_registration_proto_rawDescOnce sync.Once
+	file_moh_registry_v1_registration_proto_rawDescData []byte
+)
+
+func file_moh_registry_v1_registration_proto_rawDescGZIP() []byte {
+	file_moh_registry_v1_registration_proto_rawDescOnce.Do(func() {
+		file_moh_registry_v1_registration_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_moh_registry_v1_registration_proto_rawDesc), len(file_moh_registry_v1_registration_proto_rawDesc)))
+	})
+	return file_moh_registry_v1_registration_proto_rawDescData
+}
+
+var file_moh_registry_v1_registration_proto_msgTypes = make([]protoimpl.MessageInfo, 21)
+var file_moh_registry_v1_registration_proto_goTypes = []any{
+	(*CreateDrugRegistrationRequest)(nil),           // 0: moh.registry.v1.CreateDrugRegistrationRequest
+	(*GetDrugRegistrationRequest)(nil),              // 1: moh.registry.v1.GetDrugRegistrationRequest
+	(*UpdateDrugRegistrationRequest)(nil),           // 2: moh.registry.v1.UpdateDrugRegistrationRequest
+	(*DeleteDrugRegistrationRequest)(nil),           // 3: moh.registry.v1.DeleteDrugRegistrationRequest
+	(*ListDrugRegistrationsRequest)(nil),            // 4: moh.registry.v1.ListDrugRegistrationsRequest
+	(*ListDrugRegistrationsResponse)(nil),           // 5: moh.registry.v1.ListDrugRegistrationsResponse
+	(*CreateDrugRegistrationSiteRequest)(nil),       // 6: moh.registry.v1.CreateDrugRegistrationSiteRequest
+	(*GetDrugRegistrationSiteRequest)(nil),          // 7: moh.registry.v1.GetDrugRegistrationSiteRequest
+	(*UpdateDrugRegistrationSiteRequest)(nil),       // 8: moh.registry.v1.UpdateDrugRegistrationSiteRequest
+	(*DeleteDrugRegistrationSiteRequest)(nil),       // 9: moh.registry.v1.DeleteDrugRegistrationSiteRequest
+	(*ListDrugRegistrationSitesRequest)(nil),        // 10: moh.registry.v1.ListDrugRegistrationSitesRequest
+	(*ListDrugRegistrationSitesResponse)(nil),       // 11: moh.registry.v1.ListDrugRegistrationSitesResponse
+	(*CreateDrugRegistrationAuthHolderRequest)(nil), // 12: moh.registry.v1.CreateDrugRegistrationAuthHolderRequest
+	(*GetDrugRegistrationAuthHolderRequest)(nil),    // 13: moh.registry.v1.GetDrugRegistrationAuthHolderRequest
+	(*UpdateDrugRegistrationAuthHolderRequest)(nil), // 14: moh.registry.v1.UpdateDrugRegistrationAuthHolderRequest
+	(*DeleteDrugRegistrationAuthHolderRequest)(nil), // 15: moh.registry.v1.DeleteDrugRegistrationAuthHolderRequest
+	(*ListDrugRegistrationAuthHoldersRequest)(nil),  // 16: moh.registry.v1.ListDrugRegistrationAuthHoldersRequest
+	(*ListDrugRegistrationAuthHoldersResponse)(nil), // 17: moh.registry.v1.ListDrugRegistrationAuthHoldersResponse
+	(*DrugRegistration)(nil),                        // 18: moh.registry.v1.DrugRegistration
+	(*DrugRegistrationSite)(nil),                    // 19: moh.registry.v1.DrugRegistrationSite
+	(*DrugRegistrationAuthHolder)(nil),              // 20: moh.registry.v1.DrugRegistrationAuthHolder
+	(*timestamppb.Timestamp)(nil),                   // 21: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),                           // 22: google.protobuf.Empty
+}
+var file_moh_registry_v1_registration_proto_depIdxs = []int32{
+	18, // 0: moh.registry.v1.CreateDrugRegistrationRequest.drug_registration:type_name -> moh.registry.v1.DrugRegistration
+	18, // 1: moh.registry.v1.UpdateDrugRegistrationRequest.drug_registration:type_name -> moh.registry.v1.DrugRegistration
+	18, // 2: moh.registry.v1.ListDrugRegistrationsResponse.items:type_name -> moh.registry.v1.DrugRegistration
+	19, // 3: moh.registry.v1.CreateDrugRegistrationSiteRequest.drug_registration_site:type_name -> moh.registry.v1.DrugRegistrationSite
+	19, // 4: moh.registry.v1.UpdateDrugRegistrationSiteRequest.drug_registration_site:type_name -> moh.registry.v1.DrugRegistrationSite
+	19, // 5: moh.registry.v1.ListDrugRegistrationSitesResponse.items:type_name -> moh.registry.v1.DrugRegistrationSite
+	20, // 6: moh.registry.v1.CreateDrugRegistrationAuthHolderRequest.drug_registration_auth_holder:type_name -> moh.registry.v1.DrugRegistrationAuthHolder
+	20, // 7: moh.registry.v1.UpdateDrugRegistrationAuthHolderRequest.drug_registration_auth_holder:type_name -> moh.registry.v1.DrugRegistrationAuthHolder
+	20, // 8: moh.registry.v1.ListDrugRegistrationAuthHoldersResponse.items:type_name -> moh.registry.v1.DrugRegistrationAuthHolder
+	21, // 9: moh.registry.v1.DrugRegistration.created_at:type_name -> google.protobuf.Timestamp
+	21, // 10: moh.registry.v1.DrugRegistration.updated_at:type_name -> google.protobuf.Timestamp
+	0,  // 11: moh.registry.v1.RegistrationService.CreateDrugRegistration:input_type -> moh.registry.v1.CreateDrugRegistrationRequest
+	1,  // 12: moh.registry.v1.RegistrationService.GetDrugRegistration:input_type -> moh.registry.v1.GetDrugRegistrationRequest
+	2,  // 13: moh.registry.v1.RegistrationService.UpdateDrugRegistration:input_type -> moh.registry.v1.UpdateDrugRegistrationRequest
+	3,  // 14: moh.registry.v1.RegistrationService.DeleteDrugRegistration:input_type -> moh.registry.v1.DeleteDrugRegistrationRequest
+	4,  // 15: moh.registry.v1.RegistrationService.ListDrugRegistrations:input_type -> moh.registry.v1.ListDrugRegistrationsRequest
+	6,  // 16: moh.registry.v1.RegistrationService.CreateDrugRegistrationSite:input_type -> moh.registry.v1.CreateDrugRegistrationSiteRequest
+	7,  // 17: moh.registry.v1.RegistrationService.GetDrugRegistrationSite:input_type -> moh.registry.v1.GetDrugRegistrationSiteRequest
+	8,  // 18: moh.registry.v1.RegistrationService.UpdateDrugRegistrationSite:input_type -> moh.registry.v1.UpdateDrugRegistrationSiteRequest
+	9,  // 19: moh.registry.v1.RegistrationService.DeleteDrugRegistrationSite:input_type -> moh.registry.v1.DeleteDrugRegistrationSiteRequest
+	10, // 20: moh.registry.v1.RegistrationService.ListDrugRegistrationSites:input_type -> moh.registry.v1.ListDrugRegistrationSitesRequest
+	12, // 21: moh.registry.v1.RegistrationService.CreateDrugRegistrationAuthHolder:input_type -> moh.registry.v1.CreateDrugRegistrationAuthHolderRequest
+	13, // 22: moh.registry.v1.RegistrationService.GetDrugRegistrationAuthHolder:input_type -> moh.registry.v1.GetDrugRegistrationAuthHolderRequest
+	14, // 23: moh.registry.v1.RegistrationService.UpdateDrugRegistrationAuthHolder:input_type -> moh.registry.v1.UpdateDrugRegistrationAuthHolderRequest
+	15, // 24: moh.registry.v1.RegistrationService.DeleteDrugRegistrationAuthHolder:input_type -> moh.registry.v1.DeleteDrugRegistrationAuthHolderRequest
+	16, // 25: moh.registry.v1.RegistrationService.ListDrugRegistrationAuthHolders:input_type -> moh.registry.v1.ListDrugRegistrationAuthHoldersRequest
+	18, // 26: moh.registry.v1.RegistrationService.CreateDrugRegistration:output_type -> moh.registry.v1.DrugRegistration
+	18, // 27: moh.registry.v1.RegistrationService.GetDrugRegistration:output_type -> moh.registry.v1.DrugRegistration
+	18, // 28: moh.registry.v1.RegistrationService.UpdateDrugRegistration:output_type -> moh.registry.v1.DrugRegistration
+	22, // 29: moh.registry.v1.RegistrationService.DeleteDrugRegistration:output_type -> google.protobuf.Empty
+	5,  // 30: moh.registry.v1.RegistrationService.ListDrugRegistrations:output_type -> moh.registry.v1.ListDrugRegistrationsResponse
+	19, // 31: moh.registry.v1.RegistrationService.CreateDrugRegistrationSite:output_type -> moh.registry.v1.DrugRegistrationSite
+	19, // 32: moh.registry.v1.RegistrationService.GetDrugRegistrationSite:output_type -> moh.registry.v1.DrugRegistrationSite
+	19, // 33: moh.registry.v1.RegistrationService.UpdateDrugRegistrationSite:output_type -> moh.registry.v1.DrugRegistrationSite
+	22, // 34: moh.registry.v1.RegistrationService.DeleteDrugRegistrationSite:output_type -> google.protobuf.Empty
+	11, // 35: moh.registry.v1.RegistrationService.ListDrugRegistrationSites:output_type -> moh.registry.v1.ListDrugRegistrationSitesResponse
+	20, // 36: moh.registry.v1.RegistrationService.CreateDrugRegistrationAuthHolder:output_type -> moh.registry.v1.DrugRegistrationAuthHolder
+	20, // 37: moh.registry.v1.RegistrationService.GetDrugRegistrationAuthHolder:output_type -> moh.registry.v1.DrugRegistrationAuthHolder
+	20, // 38: moh.registry.v1.RegistrationService.UpdateDrugRegistrationAuthHolder:output_type -> moh.registry.v1.DrugRegistrationAuthHolder
+	22, // 39: moh.registry.v1.RegistrationService.DeleteDrugRegistrationAuthHolder:output_type -> google.protobuf.Empty
+	17, // 40: moh.registry.v1.RegistrationService.ListDrugRegistrationAuthHolders:output_type -> moh.registry.v1.ListDrugRegistrationAuthHoldersResponse
+	26, // [26:41] is the sub-list for method output_type
+	11, // [11:26] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
+}
+
+func init() { file_moh_registry_v1_registration_proto_init() }
+func file_moh_registry_v1_registration_proto_init() {
+	if File_moh_registry_v1_registration_proto != nil {
+		return
+	}
+	file_moh_registry_v1_registration_proto_msgTypes[4].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_moh_registry_v1_registration_proto_rawDesc), len(file_moh_registry_v1_registration_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   21,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_moh_registry_v1_registration_proto_goTypes,
+		DependencyIndexes: file_moh_registry_v1_registration_proto_depIdxs,
+		MessageInfos:      file_moh_registry_v1_registration_proto_msgTypes,
+	}.Build()
+	File_moh_registry_v1_registration_proto = out.File
+	file_moh_registry_v1_registration_proto_goTypes = nil
+	file_moh_registry_v1_registration_proto_depIdxs = nil
+}
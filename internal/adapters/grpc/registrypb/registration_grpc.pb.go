@@ -0,0 +1,658 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.29.3
+// source: moh/registry/v1/registration.proto
+
+package registrypb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	RegistrationService_CreateDrugRegistration_FullMethodName           = "/moh.registry.v1.RegistrationService/CreateDrugRegistration"
+	RegistrationService_GetDrugRegistration_FullMethodName              = "/moh.registry.v1.RegistrationService/GetDrugRegistration"
+	RegistrationService_UpdateDrugRegistration_FullMethodName           = "/moh.registry.v1.RegistrationService/UpdateDrugRegistration"
+	RegistrationService_DeleteDrugRegistration_FullMethodName           = "/moh.registry.v1.RegistrationService/DeleteDrugRegistration"
+	RegistrationService_ListDrugRegistrations_FullMethodName            = "/moh.registry.v1.RegistrationService/ListDrugRegistrations"
+	RegistrationService_CreateDrugRegistrationSite_FullMethodName       = "/moh.registry.v1.RegistrationService/CreateDrugRegistrationSite"
+	RegistrationService_GetDrugRegistrationSite_FullMethodName          = "/moh.registry.v1.RegistrationService/GetDrugRegistrationSite"
+	RegistrationService_UpdateDrugRegistrationSite_FullMethodName       = "/moh.registry.v1.RegistrationService/UpdateDrugRegistrationSite"
+	RegistrationService_DeleteDrugRegistrationSite_FullMethodName       = "/moh.registry.v1.RegistrationService/DeleteDrugRegistrationSite"
+	RegistrationService_ListDrugRegistrationSites_FullMethodName        = "/moh.registry.v1.RegistrationService/ListDrugRegistrationSites"
+	RegistrationService_CreateDrugRegistrationAuthHolder_FullMethodName = "/moh.registry.v1.RegistrationService/CreateDrugRegistrationAuthHolder"
+	RegistrationService_GetDrugRegistrationAuthHolder_FullMethodName    = "/moh.registry.v1.RegistrationService/GetDrugRegistrationAuthHolder"
+	RegistrationService_UpdateDrugRegistrationAuthHolder_FullMethodName = "/moh.registry.v1.RegistrationService/UpdateDrugRegistrationAuthHolder"
+	RegistrationService_DeleteDrugRegistrationAuthHolder_FullMethodName = "/moh.registry.v1.RegistrationService/DeleteDrugRegistrationAuthHolder"
+	RegistrationService_ListDrugRegistrationAuthHolders_FullMethodName  = "/moh.registry.v1.RegistrationService/ListDrugRegistrationAuthHolders"
+)
+
+// RegistrationServiceClient is the client API for RegistrationService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// RegistrationService manages drug registrations and their site and holder links.
+type RegistrationServiceClient interface {
+	CreateDrugRegistration(ctx context.Context, in *CreateDrugRegistrationRequest, opts ...grpc.CallOption) (*DrugRegistration, error)
+	GetDrugRegistration(ctx context.Context, in *GetDrugRegistrationRequest, opts ...grpc.CallOption) (*DrugRegistration, error)
+	UpdateDrugRegistration(ctx context.Context, in *UpdateDrugRegistrationRequest, opts ...grpc.CallOption) (*DrugRegistration, error)
+	DeleteDrugRegistration(ctx context.Context, in *DeleteDrugRegistrationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ListDrugRegistrations(ctx context.Context, in *ListDrugRegistrationsRequest, opts ...grpc.CallOption) (*ListDrugRegistrationsResponse, error)
+	CreateDrugRegistrationSite(ctx context.Context, in *CreateDrugRegistrationSiteRequest, opts ...grpc.CallOption) (*DrugRegistrationSite, error)
+	GetDrugRegistrationSite(ctx context.Context, in *GetDrugRegistrationSiteRequest, opts ...grpc.CallOption) (*DrugRegistrationSite, error)
+	UpdateDrugRegistrationSite(ctx context.Context, in *UpdateDrugRegistrationSiteRequest, opts ...grpc.CallOption) (*DrugRegistrationSite, error)
+	DeleteDrugRegistrationSite(ctx context.Context, in *DeleteDrugRegistrationSiteRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ListDrugRegistrationSites(ctx context.Context, in *ListDrugRegistrationSitesRequest, opts ...grpc.CallOption) (*ListDrugRegistrationSitesResponse, error)
+	CreateDrugRegistrationAuthHolder(ctx context.Context, in *CreateDrugRegistrationAuthHolderRequest, opts ...grpc.CallOption) (*DrugRegistrationAuthHolder, error)
+	GetDrugRegistrationAuthHolder(ctx context.Context, in *GetDrugRegistrationAuthHolderRequest, opts ...grpc.CallOption) (*DrugRegistrationAuthHolder, error)
+	UpdateDrugRegistrationAuthHolder(ctx context.Context, in *UpdateDrugRegistrationAuthHolderRequest, opts ...grpc.CallOption) (*DrugRegistrationAuthHolder, error)
+	DeleteDrugRegistrationAuthHolder(ctx context.Context, in *DeleteDrugRegistrationAuthHolderRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ListDrugRegistrationAuthHolders(ctx context.Context, in *ListDrugRegistrationAuthHoldersRequest, opts ...grpc.CallOption) (*ListDrugRegistrationAuthHoldersResponse, error)
+}
+
+type registrationServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewRegistrationServiceClient(cc grpc.ClientConnInterface) RegistrationServiceClient {
+	return &registrationServiceClient{cc}
+}
+
+func (c *registrationServiceClient) CreateDrugRegistration(ctx context.Context, in *CreateDrugRegistrationRequest, opts ...grpc.CallOption) (*DrugRegistration, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DrugRegistration)
+	err := c.cc.Invoke(ctx, RegistrationService_CreateDrugRegistration_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *registrationServiceClient) GetDrugRegistration(ctx context.Context, in *GetDrugRegistrationRequest, opts ...grpc.CallOption) (*DrugRegistration, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DrugRegistration)
+	err := c.cc.Invoke(ctx, RegistrationService_GetDrugRegistration_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *registrationServiceClient) UpdateDrugRegistration(ctx context.Context, in *UpdateDrugRegistrationRequest, opts ...grpc.CallOption) (*DrugRegistration, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DrugRegistration)
+	err := c.cc.Invoke(ctx, RegistrationService_UpdateDrugRegistration_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *registrationServiceClient) DeleteDrugRegistration(ctx context.Context, in *DeleteDrugRegistrationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, RegistrationService_DeleteDrugRegistration_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *registrationServiceClient) ListDrugRegistrations(ctx context.Context, in *ListDrugRegistrationsRequest, opts ...grpc.CallOption) (*ListDrugRegistrationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDrugRegistrationsResponse)
+	err := c.cc.Invoke(ctx, RegistrationService_ListDrugRegistrations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *registrationServiceClient) CreateDrugRegistrationSite(ctx context.Context, in *CreateDrugRegistrationSiteRequest, opts ...grpc.CallOption) (*DrugRegistrationSite, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DrugRegistrationSite)
+	err := c.cc.Invoke(ctx, RegistrationService_CreateDrugRegistrationSite_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *registrationServiceClient) GetDrugRegistrationSite(ctx context.Context, in *GetDrugRegistrationSiteRequest, opts ...grpc.CallOption) (*DrugRegistrationSite, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DrugRegistrationSite)
+	err := c.cc.Invoke(ctx, RegistrationService_GetDrugRegistrationSite_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *registrationServiceClient) UpdateDrugRegistrationSite(ctx context.Context, in *UpdateDrugRegistrationSiteRequest, opts ...grpc.CallOption) (*DrugRegistrationSite, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DrugRegistrationSite)
+	err := c.cc.Invoke(ctx, RegistrationService_UpdateDrugRegistrationSite_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *registrationServiceClient) DeleteDrugRegistrationSite(ctx context.Context, in *DeleteDrugRegistrationSiteRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, RegistrationService_DeleteDrugRegistrationSite_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *registrationServiceClient) ListDrugRegistrationSites(ctx context.Context, in *ListDrugRegistrationSitesRequest, opts ...grpc.CallOption) (*ListDrugRegistrationSitesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDrugRegistrationSitesResponse)
+	err := c.cc.Invoke(ctx, RegistrationService_ListDrugRegistrationSites_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *registrationServiceClient) CreateDrugRegistrationAuthHolder(ctx context.Context, in *CreateDrugRegistrationAuthHolderRequest, opts ...grpc.CallOption) (*DrugRegistrationAuthHolder, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DrugRegistrationAuthHolder)
+	err := c.cc.Invoke(ctx, RegistrationService_CreateDrugRegistrationAuthHolder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *registrationServiceClient) GetDrugRegistrationAuthHolder(ctx context.Context, in *GetDrugRegistrationAuthHolderRequest, opts ...grpc.CallOption) (*DrugRegistrationAuthHolder, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DrugRegistrationAuthHolder)
+	err := c.cc.Invoke(ctx, RegistrationService_GetDrugRegistrationAuthHolder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *registrationServiceClient) UpdateDrugRegistrationAuthHolder(ctx context.Context, in *UpdateDrugRegistrationAuthHolderRequest, opts ...grpc.CallOption) (*DrugRegistrationAuthHolder, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DrugRegistrationAuthHolder)
+	err := c.cc.Invoke(ctx, RegistrationService_UpdateDrugRegistrationAuthHolder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *registrationServiceClient) DeleteDrugRegistrationAuthHolder(ctx context.Context, in *DeleteDrugRegistrationAuthHolderRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, RegistrationService_DeleteDrugRegistrationAuthHolder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *registrationServiceClient) ListDrugRegistrationAuthHolders(ctx context.Context, in *ListDrugRegistrationAuthHoldersRequest, opts ...grpc.CallOption) (*ListDrugRegistrationAuthHoldersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDrugRegistrationAuthHoldersResponse)
+	err := c.cc.Invoke(ctx, RegistrationService_ListDrugRegistrationAuthHolders_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RegistrationServiceServer is the server API for RegistrationService service.
+// All implementations must embed UnimplementedRegistrationServiceServer
+// for forward compatibility.
+//
+// RegistrationService manages drug registrations and their site and holder links.
+type RegistrationServiceServer interface {
+	CreateDrugRegistration(context.Context, *CreateDrugRegistrationRequest) (*DrugRegistration, error)
+	GetDrugRegistration(context.Context, *GetDrugRegistrationRequest) (*DrugRegistration, error)
+	UpdateDrugRegistration(context.Context, *UpdateDrugRegistrationRequest) (*DrugRegistration, error)
+	DeleteDrugRegistration(context.Context, *DeleteDrugRegistrationRequest) (*emptypb.Empty, error)
+	ListDrugRegistrations(context.Context, *ListDrugRegistrationsRequest) (*ListDrugRegistrationsResponse, error)
+	CreateDrugRegistrationSite(context.Context, *CreateDrugRegistrationSiteRequest) (*DrugRegistrationSite, error)
+	GetDrugRegistrationSite(context.Context, *GetDrugRegistrationSiteRequest) (*DrugRegistrationSite, error)
+	UpdateDrugRegistrationSite(context.Context, *UpdateDrugRegistrationSiteRequest) (*DrugRegistrationSite, error)
+	DeleteDrugRegistrationSite(context.Context, *DeleteDrugRegistrationSiteRequest) (*emptypb.Empty, error)
+	ListDrugRegistrationSites(context.Context, *ListDrugRegistrationSitesRequest) (*ListDrugRegistrationSitesResponse, error)
+	CreateDrugRegistrationAuthHolder(context.Context, *CreateDrugRegistrationAuthHolderRequest) (*DrugRegistrationAuthHolder, error)
+	GetDrugRegistrationAuthHolder(context.Context, *GetDrugRegistrationAuthHolderRequest) (*DrugRegistrationAuthHolder, error)
+	UpdateDrugRegistrationAuthHolder(context.Context, *UpdateDrugRegistrationAuthHolderRequest) (*DrugRegistrationAuthHolder, error)
+	DeleteDrugRegistrationAuthHolder(context.Context, *DeleteDrugRegistrationAuthHolderRequest) (*emptypb.Empty, error)
+	ListDrugRegistrationAuthHolders(context.Context, *ListDrugRegistrationAuthHoldersRequest) (*ListDrugRegistrationAuthHoldersResponse, error)
+	mustEmbedUnimplementedRegistrationServiceServer()
+}
+
+// UnimplementedRegistrationServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedRegistrationServiceServer struct{}
+
+func (UnimplementedRegistrationServiceServer) CreateDrugRegistration(context.Context, *CreateDrugRegistrationRequest) (*DrugRegistration, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateDrugRegistration not implemented")
+}
+func (UnimplementedRegistrationServiceServer) GetDrugRegistration(context.Context, *GetDrugRegistrationRequest) (*DrugRegistration, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDrugRegistration not implemented")
+}
+func (UnimplementedRegistrationServiceServer) UpdateDrugRegistration(context.Context, *UpdateDrugRegistrationRequest) (*DrugRegistration, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateDrugRegistration not implemented")
+}
+func (UnimplementedRegistrationServiceServer) DeleteDrugRegistration(context.Context, *DeleteDrugRegistrationRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteDrugRegistration not implemented")
+}
+func (UnimplementedRegistrationServiceServer) ListDrugRegistrations(context.Context, *ListDrugRegistrationsRequest) (*ListDrugRegistrationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDrugRegistrations not implemented")
+}
+func (UnimplementedRegistrationServiceServer) CreateDrugRegistrationSite(context.Context, *CreateDrugRegistrationSiteRequest) (*DrugRegistrationSite, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateDrugRegistrationSite not implemented")
+}
+func (UnimplementedRegistrationServiceServer) GetDrugRegistrationSite(context.Context, *GetDrugRegistrationSiteRequest) (*DrugRegistrationSite, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDrugRegistrationSite not implemented")
+}
+func (UnimplementedRegistrationServiceServer) UpdateDrugRegistrationSite(context.Context, *UpdateDrugRegistrationSiteRequest) (*DrugRegistrationSite, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateDrugRegistrationSite not implemented")
+}
+func (UnimplementedRegistrationServiceServer) DeleteDrugRegistrationSite(context.Context, *DeleteDrugRegistrationSiteRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteDrugRegistrationSite not implemented")
+}
+func (UnimplementedRegistrationServiceServer) ListDrugRegistrationSites(context.Context, *ListDrugRegistrationSitesRequest) (*ListDrugRegistrationSitesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDrugRegistrationSites not implemented")
+}
+func (UnimplementedRegistrationServiceServer) CreateDrugRegistrationAuthHolder(context.Context, *CreateDrugRegistrationAuthHolderRequest) (*DrugRegistrationAuthHolder, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateDrugRegistrationAuthHolder not implemented")
+}
+func (UnimplementedRegistrationServiceServer) GetDrugRegistrationAuthHolder(context.Context, *GetDrugRegistrationAuthHolderRequest) (*DrugRegistrationAuthHolder, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDrugRegistrationAuthHolder not implemented")
+}
+func (UnimplementedRegistrationServiceServer) UpdateDrugRegistrationAuthHolder(context.Context, *UpdateDrugRegistrationAuthHolderRequest) (*DrugRegistrationAuthHolder, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateDrugRegistrationAuthHolder not implemented")
+}
+func (UnimplementedRegistrationServiceServer) DeleteDrugRegistrationAuthHolder(context.Context, *DeleteDrugRegistrationAuthHolderRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteDrugRegistrationAuthHolder not implemented")
+}
+func (UnimplementedRegistrationServiceServer) ListDrugRegistrationAuthHolders(context.Context, *ListDrugRegistrationAuthHoldersRequest) (*ListDrugRegistrationAuthHoldersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDrugRegistrationAuthHolders not implemented")
+}
+func (UnimplementedRegistrationServiceServer) mustEmbedUnimplementedRegistrationServiceServer() {}
+func (UnimplementedRegistrationServiceServer) testEmbeddedByValue()                             {}
+
+// UnsafeRegistrationServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to RegistrationServiceServer will
+// result in compilation errors.
+type UnsafeRegistrationServiceServer interface {
+	mustEmbedUnimplementedRegistrationServiceServer()
+}
+
+func RegisterRegistrationServiceServer(s grpc.ServiceRegistrar, srv RegistrationServiceServer) {
+	// If the following call pancis, it indicates UnimplementedRegistrationServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&RegistrationService_ServiceDesc, srv)
+}
+
+func _RegistrationService_CreateDrugRegistration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateDrugRegistrationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RegistrationServiceServer).CreateDrugRegistration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RegistrationService_CreateDrugRegistration_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RegistrationServiceServer).CreateDrugRegistration(ctx, req.(*CreateDrugRegistrationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RegistrationService_GetDrugRegistration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDrugRegistrationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RegistrationServiceServer).GetDrugRegistration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RegistrationService_GetDrugRegistration_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RegistrationServiceServer).GetDrugRegistration(ctx, req.(*GetDrugRegistrationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RegistrationService_UpdateDrugRegistration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateDrugRegistrationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RegistrationServiceServer).UpdateDrugRegistration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RegistrationService_UpdateDrugRegistration_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RegistrationServiceServer).UpdateDrugRegistration(ctx, req.(*UpdateDrugRegistrationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RegistrationService_DeleteDrugRegistration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteDrugRegistrationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RegistrationServiceServer).DeleteDrugRegistration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RegistrationService_DeleteDrugRegistration_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RegistrationServiceServer).DeleteDrugRegistration(ctx, req.(*DeleteDrugRegistrationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RegistrationService_ListDrugRegistrations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDrugRegistrationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RegistrationServiceServer).ListDrugRegistrations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RegistrationService_ListDrugRegistrations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RegistrationServiceServer).ListDrugRegistrations(ctx, req.(*ListDrugRegistrationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RegistrationService_CreateDrugRegistrationSite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateDrugRegistrationSiteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RegistrationServiceServer).CreateDrugRegistrationSite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RegistrationService_CreateDrugRegistrationSite_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RegistrationServiceServer).CreateDrugRegistrationSite(ctx, req.(*CreateDrugRegistrationSiteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RegistrationService_GetDrugRegistrationSite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDrugRegistrationSiteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RegistrationServiceServer).GetDrugRegistrationSite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RegistrationService_GetDrugRegistrationSite_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RegistrationServiceServer).GetDrugRegistrationSite(ctx, req.(*GetDrugRegistrationSiteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RegistrationService_UpdateDrugRegistrationSite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateDrugRegistrationSiteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RegistrationServiceServer).UpdateDrugRegistrationSite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RegistrationService_UpdateDrugRegistrationSite_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RegistrationServiceServer).UpdateDrugRegistrationSite(ctx, req.(*UpdateDrugRegistrationSiteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RegistrationService_DeleteDrugRegistrationSite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteDrugRegistrationSiteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RegistrationServiceServer).DeleteDrugRegistrationSite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RegistrationService_DeleteDrugRegistrationSite_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RegistrationServiceServer).DeleteDrugRegistrationSite(ctx, req.(*DeleteDrugRegistrationSiteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RegistrationService_ListDrugRegistrationSites_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDrugRegistrationSitesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RegistrationServiceServer).ListDrugRegistrationSites(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RegistrationService_ListDrugRegistrationSites_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RegistrationServiceServer).ListDrugRegistrationSites(ctx, req.(*ListDrugRegistrationSitesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RegistrationService_CreateDrugRegistrationAuthHolder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateDrugRegistrationAuthHolderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RegistrationServiceServer).CreateDrugRegistrationAuthHolder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RegistrationService_CreateDrugRegistrationAuthHolder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RegistrationServiceServer).CreateDrugRegistrationAuthHolder(ctx, req.(*CreateDrugRegistrationAuthHolderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RegistrationService_GetDrugRegistrationAuthHolder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDrugRegistrationAuthHolderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RegistrationServiceServer).GetDrugRegistrationAuthHolder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RegistrationService_GetDrugRegistrationAuthHolder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RegistrationServiceServer).GetDrugRegistrationAuthHolder(ctx, req.(*GetDrugRegistrationAuthHolderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RegistrationService_UpdateDrugRegistrationAuthHolder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateDrugRegistrationAuthHolderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RegistrationServiceServer).UpdateDrugRegistrationAuthHolder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RegistrationService_UpdateDrugRegistrationAuthHolder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RegistrationServiceServer).UpdateDrugRegistrationAuthHolder(ctx, req.(*UpdateDrugRegistrationAuthHolderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RegistrationService_DeleteDrugRegistrationAuthHolder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteDrugRegistrationAuthHolderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RegistrationServiceServer).DeleteDrugRegistrationAuthHolder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RegistrationService_DeleteDrugRegistrationAuthHolder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RegistrationServiceServer).DeleteDrugRegistrationAuthHolder(ctx, req.(*DeleteDrugRegistrationAuthHolderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RegistrationService_ListDrugRegistrationAuthHolders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDrugRegistrationAuthHoldersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RegistrationServiceServer).ListDrugRegistrationAuthHolders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RegistrationService_ListDrugRegistrationAuthHolders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RegistrationServiceServer).ListDrugRegistrationAuthHolders(ctx, req.(*ListDrugRegistrationAuthHoldersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// RegistrationService_ServiceDesc is the grpc.ServiceDesc for RegistrationService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var RegistrationService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "moh.registry.v1.RegistrationService",
+	HandlerType: (*RegistrationServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateDrugRegistration",
+			Handler:    _RegistrationService_CreateDrugRegistration_Handler,
+		},
+		{
+			MethodName: "GetDrugRegistration",
+			Handler:    _RegistrationService_GetDrugRegistration_Handler,
+		},
+		{
+			MethodName: "UpdateDrugRegistration",
+			Handler:    _RegistrationService_UpdateDrugRegistration_Handler,
+		},
+		{
+			MethodName: "DeleteDrugRegistration",
+			Handler:    _RegistrationService_DeleteDrugRegistration_Handler,
+		},
+		{
+			MethodName: "ListDrugRegistrations",
+			Handler:    _RegistrationService_ListDrugRegistrations_Handler,
+		},
+		{
+			MethodName: "CreateDrugRegistrationSite",
+			Handler:    _RegistrationService_CreateDrugRegistrationSite_Handler,
+		},
+		{
+			MethodName: "GetDrugRegistrationSite",
+			Handler:    _RegistrationService_GetDrugRegistrationSite_Handler,
+		},
+		{
+			MethodName: "UpdateDrugRegistrationSite",
+			Handler:    _RegistrationService_UpdateDrugRegistrationSite_Handler,
+		},
+		{
+			MethodName: "DeleteDrugRegistrationSite",
+			Handler:    _RegistrationService_DeleteDrugRegistrationSite_Handler,
+		},
+		{
+			MethodName: "ListDrugRegistrationSites",
+			Handler:    _RegistrationService_ListDrugRegistrationSites_Handler,
+		},
+		{
+			MethodName: "CreateDrugRegistrationAuthHolder",
+			Handler:    _RegistrationService_CreateDrugRegistrationAuthHolder_Handler,
+		},
+		{
+			MethodName: "GetDrugRegistrationAuthHolder",
+			Handler:    _RegistrationService_GetDrugRegistrationAuthHolder_Handler,
+		},
+		{
+			MethodName: "UpdateDrugRegistrationAuthHolder",
+			Handler:    _RegistrationService_UpdateDrugRegistrationAuthHolder_Handler,
+		},
+		{
+			MethodName: "DeleteDrugRegistrationAuthHolder",
+			Handler:    _RegistrationService_DeleteDrugRegistrationAuthHolder_Handler,
+		},
+		{
+			MethodName: "ListDrugRegistrationAuthHolders",
+			Handler:    _RegistrationService_ListDrugRegistrationAuthHolders_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "moh/registry/v1/registration.proto",
+}
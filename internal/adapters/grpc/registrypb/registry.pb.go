@@ -0,0 +1,1155 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v5.29.3
+// source: moh/registry/v1/registry.proto
+
+package registrypb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type CreateAuthHolderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AuthHolder    *AuthHolder            `protobuf:"bytes,1,opt,name=auth_holder,json=authHolder,proto3" json:"auth_holder,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAuthHolderRequest) Reset() {
+	*x = CreateAuthHolderRequest{}
+	mi := &file_moh_registry_v1_registry_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAuthHolderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAuthHolderRequest) ProtoMessage() {}
+
+func (x *CreateAuthHolderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_moh_registry_v1_registry_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAuthHolderRequest.ProtoReflect.Descriptor instead.
+func (*CreateAuthHolderRequest) Descriptor() ([]byte, []int) {
+	return file_moh_registry_v1_registry_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CreateAuthHolderRequest) GetAuthHolder() *AuthHolder {
+	if x != nil {
+		return x.AuthHolder
+	}
+	return nil
+}
+
+type GetAuthHolderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAuthHolderRequest) Reset() {
+	*x = GetAuthHolderRequest{}
+	mi := &file_moh_registry_v1_registry_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAuthHolderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAuthHolderRequest) ProtoMessage() {}
+
+func (x *GetAuthHolderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_moh_registry_v1_registry_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAuthHolderRequest.ProtoReflect.Descriptor instead.
+func (*GetAuthHolderRequest) Descriptor() ([]byte, []int) {
+	return file_moh_registry_v1_registry_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetAuthHolderRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// UpdateAuthHolderRequest replaces every editable field of auth_holder.id.
+type UpdateAuthHolderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AuthHolder    *AuthHolder            `protobuf:"bytes,1,opt,name=auth_holder,json=authHolder,proto3" json:"auth_holder,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateAuthHolderRequest) Reset() {
+	*x = UpdateAuthHolderRequest{}
+	mi := &file_moh_registry_v1_registry_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateAuthHolderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAuthHolderRequest) ProtoMessage() {}
+
+func (x *UpdateAuthHolderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_moh_registry_v1_registry_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAuthHolderRequest.ProtoReflect.Descriptor instead.
+func (*UpdateAuthHolderRequest) Descriptor() ([]byte, []int) {
+	return file_moh_registry_v1_registry_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *UpdateAuthHolderRequest) GetAuthHolder() *AuthHolder {
+	if x != nil {
+		return x.AuthHolder
+	}
+	return nil
+}
+
+type DeleteAuthHolderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAuthHolderRequest) Reset() {
+	*x = DeleteAuthHolderRequest{}
+	mi := &file_moh_registry_v1_registry_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAuthHolderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAuthHolderRequest) ProtoMessage() {}
+
+func (x *DeleteAuthHolderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_moh_registry_v1_registry_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAuthHolderRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAuthHolderRequest) Descriptor() ([]byte, []int) {
+	return file_moh_registry_v1_registry_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *DeleteAuthHolderRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListAuthHoldersRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	PageSize           int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`   // 1..500, default 50
+	PageToken          string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"` // next_page_token of the previous page
+	OrderBy            string                 `protobuf:"bytes,3,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`       // whitelisted field, "-" prefix for descending
+	RegistrationNumber string                 `protobuf:"bytes,4,opt,name=registration_number,json=registrationNumber,proto3" json:"registration_number,omitempty"`
+	Q                  string                 `protobuf:"bytes,5,opt,name=q,proto3" json:"q,omitempty"` // substring of name
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *ListAuthHoldersRequest) Reset() {
+	*x = ListAuthHoldersRequest{}
+	mi := &file_moh_registry_v1_registry_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAuthHoldersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuthHoldersRequest) ProtoMessage() {}
+
+func (x *ListAuthHoldersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_moh_registry_v1_registry_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuthHoldersRequest.ProtoReflect.Descriptor instead.
+func (*ListAuthHoldersRequest) Descriptor() ([]byte, []int) {
+	return file_moh_registry_v1_registry_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListAuthHoldersRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListAuthHoldersRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListAuthHoldersRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+func (x *ListAuthHoldersRequest) GetRegistrationNumber() string {
+	if x != nil {
+		return x.RegistrationNumber
+	}
+	return ""
+}
+
+func (x *ListAuthHoldersRequest) GetQ() string {
+	if x != nil {
+		return x.Q
+	}
+	return ""
+}
+
+type ListAuthHoldersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*AuthHolder          `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	TotalSize     int64                  `protobuf:"varint,3,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAuthHoldersResponse) Reset() {
+	*x = ListAuthHoldersResponse{}
+	mi := &file_moh_registry_v1_registry_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAuthHoldersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuthHoldersResponse) ProtoMessage() {}
+
+func (x *ListAuthHoldersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_moh_registry_v1_registry_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuthHoldersResponse.ProtoReflect.Descriptor instead.
+func (*ListAuthHoldersResponse) Descriptor() ([]byte, []int) {
+	return file_moh_registry_v1_registry_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListAuthHoldersResponse) GetItems() []*AuthHolder {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ListAuthHoldersResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListAuthHoldersResponse) GetTotalSize() int64 {
+	if x != nil {
+		return x.TotalSize
+	}
+	return 0
+}
+
+type CreateMarketingAuthorizationRequest struct {
+	state                  protoimpl.MessageState  `protogen:"open.v1"`
+	MarketingAuthorization *MarketingAuthorization `protobuf:"bytes,1,opt,name=marketing_authorization,json=marketingAuthorization,proto3" json:"marketing_authorization,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *CreateMarketingAuthorizationRequest) Reset() {
+	*x = CreateMarketingAuthorizationRequest{}
+	mi := &file_moh_registry_v1_registry_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateMarketingAuthorizationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateMarketingAuthorizationRequest) ProtoMessage() {}
+
+func (x *CreateMarketingAuthorizationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_moh_registry_v1_registry_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateMarketingAuthorizationRequest.ProtoReflect.Descriptor instead.
+func (*CreateMarketingAuthorizationRequest) Descriptor() ([]byte, []int) {
+	return file_moh_registry_v1_registry_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CreateMarketingAuthorizationRequest) GetMarketingAuthorization() *MarketingAuthorization {
+	if x != nil {
+		return x.MarketingAuthorization
+	}
+	return nil
+}
+
+type GetMarketingAuthorizationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMarketingAuthorizationRequest) Reset() {
+	*x = GetMarketingAuthorizationRequest{}
+	mi := &file_moh_registry_v1_registry_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMarketingAuthorizationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMarketingAuthorizationRequest) ProtoMessage() {}
+
+func (x *GetMarketingAuthorizationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_moh_registry_v1_registry_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMarketingAuthorizationRequest.ProtoReflect.Descriptor instead.
+func (*GetMarketingAuthorizationRequest) Descriptor() ([]byte, []int) {
+	return file_moh_registry_v1_registry_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetMarketingAuthorizationRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// UpdateMarketingAuthorizationRequest replaces every editable field of marketing_authorization.id.
+type UpdateMarketingAuthorizationRequest struct {
+	state                  protoimpl.MessageState  `protogen:"open.v1"`
+	MarketingAuthorization *MarketingAuthorization `protobuf:"bytes,1,opt,name=marketing_authorization,json=marketingAuthorization,proto3" json:"marketing_authorization,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *UpdateMarketingAuthorizationRequest) Reset() {
+	*x = UpdateMarketingAuthorizationRequest{}
+	mi := &file_moh_registry_v1_registry_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateMarketingAuthorizationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateMarketingAuthorizationRequest) ProtoMessage() {}
+
+func (x *UpdateMarketingAuthorizationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_moh_registry_v1_registry_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateMarketingAuthorizationRequest.ProtoReflect.Descriptor instead.
+func (*UpdateMarketingAuthorizationRequest) Descriptor() ([]byte, []int) {
+	return file_moh_registry_v1_registry_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *UpdateMarketingAuthorizationRequest) GetMarketingAuthorization() *MarketingAuthorization {
+	if x != nil {
+		return x.MarketingAuthorization
+	}
+	return nil
+}
+
+type DeleteMarketingAuthorizationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteMarketingAuthorizationRequest) Reset() {
+	*x = DeleteMarketingAuthorizationRequest{}
+	mi := &file_moh_registry_v1_registry_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteMarketingAuthorizationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteMarketingAuthorizationRequest) ProtoMessage() {}
+
+func (x *DeleteMarketingAuthorizationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_moh_registry_v1_registry_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteMarketingAuthorizationRequest.ProtoReflect.Descriptor instead.
+func (*DeleteMarketingAuthorizationRequest) Descriptor() ([]byte, []int) {
+	return file_moh_registry_v1_registry_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *DeleteMarketingAuthorizationRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListMarketingAuthorizationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageSize      int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`   // 1..500, default 50
+	PageToken     string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"` // next_page_token of the previous page
+	OrderBy       string                 `protobuf:"bytes,3,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`       // whitelisted field, "-" prefix for descending
+	Country       string                 `protobuf:"bytes,4,opt,name=country,proto3" json:"country,omitempty"`
+	Q             string                 `protobuf:"bytes,5,opt,name=q,proto3" json:"q,omitempty"` // substring of name
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMarketingAuthorizationsRequest) Reset() {
+	*x = ListMarketingAuthorizationsRequest{}
+	mi := &file_moh_registry_v1_registry_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMarketingAuthorizationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMarketingAuthorizationsRequest) ProtoMessage() {}
+
+func (x *ListMarketingAuthorizationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_moh_registry_v1_registry_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMarketingAuthorizationsRequest.ProtoReflect.Descriptor instead.
+func (*ListMarketingAuthorizationsRequest) Descriptor() ([]byte, []int) {
+	return file_moh_registry_v1_registry_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListMarketingAuthorizationsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListMarketingAuthorizationsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListMarketingAuthorizationsRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+func (x *ListMarketingAuthorizationsRequest) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+func (x *ListMarketingAuthorizationsRequest) GetQ() string {
+	if x != nil {
+		return x.Q
+	}
+	return ""
+}
+
+type ListMarketingAuthorizationsResponse struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Items         []*MarketingAuthorization `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	NextPageToken string                    `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	TotalSize     int64                     `protobuf:"varint,3,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMarketingAuthorizationsResponse) Reset() {
+	*x = ListMarketingAuthorizationsResponse{}
+	mi := &file_moh_registry_v1_registry_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMarketingAuthorizationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMarketingAuthorizationsResponse) ProtoMessage() {}
+
+func (x *ListMarketingAuthorizationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_moh_registry_v1_registry_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMarketingAuthorizationsResponse.ProtoReflect.Descriptor instead.
+func (*ListMarketingAuthorizationsResponse) Descriptor() ([]byte, []int) {
+	return file_moh_registry_v1_registry_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ListMarketingAuthorizationsResponse) GetItems() []*MarketingAuthorization {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ListMarketingAuthorizationsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListMarketingAuthorizationsResponse) GetTotalSize() int64 {
+	if x != nil {
+		return x.TotalSize
+	}
+	return 0
+}
+
+type CreateManufacturingSiteRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	ManufacturingSite *ManufacturingSite     `protobuf:"bytes,1,opt,name=manufacturing_site,json=manufacturingSite,proto3" json:"manufacturing_site,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *CreateManufacturingSiteRequest) Reset() {
+	*x = CreateManufacturingSiteRequest{}
+	mi := &file_moh_registry_v1_registry_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateManufacturingSiteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateManufacturingSiteRequest) ProtoMessage() {}
+
+func (x *CreateManufacturingSiteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_moh_registry_v1_registry_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateManufacturingSiteRequest.ProtoReflect.Descriptor instead.
+func (*CreateManufacturingSiteRequest) Descriptor() ([]byte, []int) {
+	return file_moh_registry_v1_registry_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *CreateManufacturingSiteRequest) GetManufacturingSite() *ManufacturingSite {
+	if x != nil {
+		return x.ManufacturingSite
+	}
+	return nil
+}
+
+type GetManufacturingSiteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetManufacturingSiteRequest) Reset() {
+	*x = GetManufacturingSiteRequest{}
+	mi := &file_moh_registry_v1_registry_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetManufacturingSiteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetManufacturingSiteRequest) ProtoMessage() {}
+
+func (x *GetManufacturingSiteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_moh_registry_v1_registry_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetManufacturingSiteRequest.ProtoReflect.Descriptor instead.
+func (*GetManufacturingSiteRequest) Descriptor() ([]byte, []int) {
+	return file_moh_registry_v1_registry_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetManufacturingSiteRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// UpdateManufacturingSiteRequest replaces every editable field of manufacturing_site.id.
+type UpdateManufacturingSiteRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	ManufacturingSite *ManufacturingSite     `protobuf:"bytes,1,opt,name=manufacturing_site,json=manufacturingSite,proto3" json:"manufacturing_site,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *UpdateManufacturingSiteRequest) Reset() {
+	*x = UpdateManufacturingSiteRequest{}
+	mi := &file_moh_registry_v1_registry_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateManufacturingSiteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateManufacturingSiteRequest) ProtoMessage() {}
+
+func (x *UpdateManufacturingSiteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_moh_registry_v1_registry_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateManufacturingSiteRequest.ProtoReflect.Descriptor instead.
+func (*UpdateManufacturingSiteRequest) Descriptor() ([]byte, []int) {
+	return file_moh_registry_v1_registry_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *UpdateManufacturingSiteRequest) GetManufacturingSite() *ManufacturingSite {
+	if x != nil {
+		return x.ManufacturingSite
+	}
+	return nil
+}
+
+type DeleteManufacturingSiteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteManufacturingSiteRequest) Reset() {
+	*x = DeleteManufacturingSiteRequest{}
+	mi := &file_moh_registry_v1_registry_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteManufacturingSiteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteManufacturingSiteRequest) ProtoMessage() {}
+
+func (x *DeleteManufacturingSiteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_moh_registry_v1_registry_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteManufacturingSiteRequest.ProtoReflect.Descriptor instead.
+func (*DeleteManufacturingSiteRequest) Descriptor() ([]byte, []int) {
+	return file_moh_registry_v1_registry_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *DeleteManufacturingSiteRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListManufacturingSitesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageSize      int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`   // 1..500, default 50
+	PageToken     string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"` // next_page_token of the previous page
+	OrderBy       string                 `protobuf:"bytes,3,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`       // whitelisted field, "-" prefix for descending
+	Country       string                 `protobuf:"bytes,4,opt,name=country,proto3" json:"country,omitempty"`
+	Q             string                 `protobuf:"bytes,5,opt,name=q,proto3" json:"q,omitempty"` // substring of name
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListManufacturingSitesRequest) Reset() {
+	*x = ListManufacturingSitesRequest{}
+	mi := &file_moh_registry_v1_registry_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListManufacturingSitesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListManufacturingSitesRequest) ProtoMessage() {}
+
+func (x *ListManufacturingSitesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_moh_registry_v1_registry_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListManufacturingSitesRequest.ProtoReflect.Descriptor instead.
+func (*ListManufacturingSitesRequest) Descriptor() ([]byte, []int) {
+	return file_moh_registry_v1_registry_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ListManufacturingSitesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListManufacturingSitesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListManufacturingSitesRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+func (x *ListManufacturingSitesRequest) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+func (x *ListManufacturingSitesRequest) GetQ() string {
+	if x != nil {
+		return x.Q
+	}
+	return ""
+}
+
+type ListManufacturingSitesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*ManufacturingSite   `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	TotalSize     int64                  `protobuf:"varint,3,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListManufacturingSitesResponse) Reset() {
+	*x = ListManufacturingSitesResponse{}
+	mi := &file_moh_registry_v1_registry_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListManufacturingSitesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListManufacturingSitesResponse) ProtoMessage() {}
+
+func (x *ListManufacturingSitesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_moh_registry_v1_registry_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListManufacturingSitesResponse.ProtoReflect.Descriptor instead.
+func (*ListManufacturingSitesResponse) Descriptor() ([]byte, []int) {
+	return file_moh_registry_v1_registry_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ListManufacturingSitesResponse) GetItems() []*ManufacturingSite {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ListManufacturingSitesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListManufacturingSitesResponse) GetTotalSize() int64 {
+	if x != nil {
+		return x.TotalSize
+	}
+	return 0
+}
+
+var File_moh_registry_v1_registry_proto protoreflect.FileDescriptor
+
+const file_moh_registry_v1_registry_proto_rawDesc = "" +
+	"\n" +
+	"\x1emoh/registry/v1/registry.proto\x12\x0fmoh.registry.v1\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1cmoh/registry/v1/common.proto\"W\n" +
+	"\x17CreateAuthHolderRequest\x12<\n" +
+	"\vauth_holder\x18\x01 \x01(\v2\x1b.moh.registry.v1.AuthHolderR\n" +
+	"authHolder\"&\n" +
+	"\x14GetAuthHolderRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"W\n" +
+	"\x17UpdateAuthHolderRequest\x12<\n" +
+	"\vauth_holder\x18\x01 \x01(\v2\x1b.moh.registry.v1.AuthHolderR\n" +
+	"authHolder\")\n" +
+	"\x17DeleteAuthHolderRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\xae\x01\n" +
+	"\x16ListAuthHoldersRequest\x12\x1b\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\x12\x19\n" +
+	"\border_by\x18\x03 \x01(\tR\aorderBy\x12/\n" +
+	"\x13registration_number\x18\x04 \x01(\tR\x12registrationNumber\x12\f\n" +
+	"\x01q\x18\x05 \x01(\tR\x01q\"\x93\x01\n" +
+	"\x17ListAuthHoldersResponse\x121\n" +
+	"\x05items\x18\x01 \x03(\v2\x1b.moh.registry.v1.AuthHolderR\x05items\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1d\n" +
+	"\n" +
+	"total_size\x18\x03 \x01(\x03R\ttotalSize\"\x87\x01\n" +
+	"#CreateMarketingAuthorizationRequest\x12`\n" +
+	"\x17marketing_authorization\x18\x01 \x01(\v2'.moh.registry.v1.MarketingAuthorizationR\x16marketingAuthorization\"2\n" +
+	" GetMarketingAuthorizationRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x87\x01\n" +
+	"#UpdateMarketingAuthorizationRequest\x12`\n" +
+	"\x17marketing_authorization\x18\x01 \x01(\v2'.moh.registry.v1.MarketingAuthorizationR\x16marketingAuthorization\"5\n" +
+	"#DeleteMarketingAuthorizationRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\xa3\x01\n" +
+	"\"ListMarketingAuthorizationsRequest\x12\x1b\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\x12\x19\n" +
+	"\border_by\x18\x03 \x01(\tR\aorderBy\x12\x18\n" +
+	"\acountry\x18\x04 \x01(\tR\acountry\x12\f\n" +
+	"\x01q\x18\x05 \x01(\tR\x01q\"\xab\x01\n" +
+	"#ListMarketingAuthorizationsResponse\x12=\n" +
+	"\x05items\x18\x01 \x03(\v2'.moh.registry.v1.MarketingAuthorizationR\x05items\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1d\n" +
+	"\n" +
+	"total_size\x18\x03 \x01(\x03R\ttotalSize\"s\n" +
+	"\x1eCreateManufacturingSiteRequest\x12Q\n" +
+	"\x12manufacturing_site\x18\x01 \x01(\v2\".moh.registry.v1.ManufacturingSiteR\x11manufacturingSite\"-\n" +
+	"\x1bGetManufacturingSiteRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"s\n" +
+	"\x1eUpdateManufacturingSiteRequest\x12Q\n" +
+	"\x12manufacturing_site\x18\x01 \x01(\v2\".moh.registry.v1.ManufacturingSiteR\x11manufacturingSite\"0\n" +
+	"\x1eDeleteManufacturingSiteRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x9e\x01\n" +
+	"\x1dListManufacturingSitesRequest\x12\x1b\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\x12\x19\n" +
+	"\border_by\x18\x03 \x01(\tR\aorderBy\x12\x18\n" +
+	"\acountry\x18\x04 \x01(\tR\acountry\x12\f\n" +
+	"\x01q\x18\x05 \x01(\tR\x01q\"\xa1\x01\n" +
+	"\x1eListManufacturingSitesResponse\x128\n" +
+	"\x05items\x18\x01 \x03(\v2\".moh.registry.v1.ManufacturingSiteR\x05items\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1d\n" +
+	"\n" +
+	"total_size\x18\x03 \x01(\x03R\ttotalSize2\xf1\f\n" +
+	"\x0fRegistryService\x12Y\n" +
+	"\x10CreateAuthHolder\x12(.moh.registry.v1.CreateAuthHolderRequest\x1a\x1b.moh.registry.v1.AuthHolder\x12S\n" +
+	"\rGetAuthHolder\x12%.moh.registry.v1.GetAuthHolderRequest\x1a\x1b.moh.registry.v1.AuthHolder\x12Y\n" +
+	"\x10UpdateAuthHolder\x12(.moh.registry.v1.UpdateAuthHolderRequest\x1a\x1b.moh.registry.v1.AuthHolder\x12T\n" +
+	"\x10DeleteAuthHolder\x12(.moh.registry.v1.DeleteAuthHolderRequest\x1a\x16.google.protobuf.Empty\x12d\n" +
+	"\x0fListAuthHolders\x12'.moh.registry.v1.ListAuthHoldersRequest\x1a(.moh.registry.v1.ListAuthHoldersResponse\x12}\n" +
+	"\x1cCreateMarketingAuthorization\x124.moh.registry.v1.CreateMarketingAuthorizationRequest\x1a'.moh.registry.v1.MarketingAuthorization\x12w\n" +
+	"\x19GetMarketingAuthorization\x121.moh.registry.v1.GetMarketingAuthorizationRequest\x1a'.moh.registry.v1.MarketingAuthorization\x12}\n" +
+	"\x1cUpdateMarketingAuthorization\x124.moh.registry.v1.UpdateMarketingAuthorizationRequest\x1a'.moh.registry.v1.MarketingAuthorization\x12l\n" +
+	"\x1cDeleteMarketingAuthorization\x124.moh.registry.v1.DeleteMarketingAuthorizationRequest\x1a\x16.google.protobuf.Empty\x12\x88\x01\n" +
+	"\x1bListMarketingAuthorizations\x123.moh.registry.v1.ListMarketingAuthorizationsRequest\x1a4.moh.registry.v1.ListMarketingAuthorizationsResponse\x12n\n" +
+	"\x17CreateManufacturingSite\x12/.moh.registry.v1.CreateManufacturingSiteRequest\x1a\".moh.registry.v1.ManufacturingSite\x12h\n" +
+	"\x14GetManufacturingSite\x12,.moh.registry.v1.GetManufacturingSiteRequest\x1a\".moh.registry.v1.ManufacturingSite\x12n\n" +
+	"\x17UpdateManufacturingSite\x12/.moh.registry.v1.UpdateManufacturingSiteRequest\x1a\".moh.registry.v1.ManufacturingSite\x12b\n" +
+	"\x17DeleteManufacturingSite\x12/.moh.registry.v1.DeleteManufacturingSiteRequest\x1a\x16.google.protobuf.Empty\x12y\n" +
+	"\x16ListManufacturingSites\x12..moh.registry.v1.ListManufacturingSitesRequest\x1a/.moh.registry.v1.ListManufacturingSitesResponseB2Z0moh/internal/adapters/grpc/registrypb;registrypbb\x06proto3"
+
+var (
+	file_moh_registry_v1_registry_proto_rawDescOnce sync.Once
+	file_moh_registry_v1_registry_proto_rawDescData []byte
+)
+
+func file_moh_registry_v1_registry_proto_rawDescGZIP() []byte {
+	file_moh_registry_v1_registry_proto_rawDescOnce.Do(func() {
+		file_moh_registry_v1_registry_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_moh_registry_v1_registry_proto_rawDesc), len(file_moh_registry_v1_registry_proto_rawDesc)))
+	})
+	return file_moh_registry_v1_registry_proto_rawDescData
+}
+
+var file_moh_registry_v1_registry_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
+var file_moh_registry_v1_registry_proto_goTypes = []any{
+	(*CreateAuthHolderRequest)(nil),             // 0: moh.registry.v1.CreateAuthHolderRequest
+	(*GetAuthHolderRequest)(nil),                // 1: moh.registry.v1.GetAuthHolderRequest
+	(*UpdateAuthHolderRequest)(nil),             // 2: moh.registry.v1.UpdateAuthHolderRequest
+	(*DeleteAuthHolderRequest)(nil),             // 3: moh.registry.v1.DeleteAuthHolderRequest
+	(*ListAuthHoldersRequest)(nil),              // 4: moh.registry.v1.ListAuthHoldersRequest
+	(*ListAuthHoldersResponse)(nil),             // 5: moh.registry.v1.ListAuthHoldersResponse
+	(*CreateMarketingAuthorizationRequest)(nil), // 6: moh.registry.v1.CreateMarketingAuthorizationRequest
+	(*GetMarketingAuthorizationRequest)(nil),    // 7: moh.registry.v1.GetMarketingAuthorizationRequest
+	(*UpdateMarketingAuthorizationRequest)(nil), // 8: moh.registry.v1.UpdateMarketingAuthorizationRequest
+	(*DeleteMarketingAuthorizationRequest)(nil), // 9: moh.registry.v1.DeleteMarketingAuthorizationRequest
+	(*ListMarketingAuthorizationsRequest)(nil),  // 10: moh.registry.v1.ListMarketingAuthorizationsRequest
+	(*ListMarketingAuthorizationsResponse)(nil), // 11: moh.registry.v1.ListMarketingAuthorizationsResponse
+	(*CreateManufacturingSiteRequest)(nil),      // 12: moh.registry.v1.CreateManufacturingSiteRequest
+	(*GetManufacturingSiteRequest)(nil),         // 13: moh.registry.v1.GetManufacturingSiteRequest
+	(*UpdateManufacturingSiteRequest)(nil),      // 14: moh.registry.v1.UpdateManufacturingSiteRequest
+	(*DeleteManufacturingSiteRequest)(nil),      // 15: moh.registry.v1.DeleteManufacturingSiteRequest
+	(*ListManufacturingSitesRequest)(nil),       // 16: moh.registry.v1.ListManufacturingSitesRequest
+	(*ListManufacturingSitesResponse)(nil),      // 17: moh.registry.v1.ListManufacturingSitesResponse
+	(*AuthHolder)(nil),                          // 18: moh.registry.v1.AuthHolder
+	(*MarketingAuthorization)(nil),              // 19: moh.registry.v1.MarketingAuthorization
+	(*ManufacturingSite)(nil),                   // 20: moh.registry.v1.ManufacturingSite
+	(*emptypb.Empty)(nil),                       // 21: google.protobuf.Empty
+}
+var file_moh_registry_v1_registry_proto_depIdxs = []int32{
+	18, // 0: moh.registry.v1.CreateAuthHolderRequest.auth_holder:type_name -> moh.registry.v1.AuthHolder
+	18, // 1: moh.registry.v1.UpdateAuthHolderRequest.auth_holder:type_name -> moh.registry.v1.AuthHolder
+	18, // 2: moh.registry.v1.ListAuthHoldersResponse.items:type_name -> moh.registry.v1.AuthHolder
+	19, // 3: moh.registry.v1.CreateMarketingAuthorizationRequest.marketing_authorization:type_name -> moh.registry.v1.MarketingAuthorization
+	19, // 4: moh.registry.v1.UpdateMarketingAuthorizationRequest.marketing_authorization:type_name -> moh.registry.v1.MarketingAuthorization
+	19, // 5: moh.registry.v1.ListMarketingAuthorizationsResponse.items:type_name -> moh.registry.v1.MarketingAuthorization
+	20, // 6: moh.registry.v1.CreateManufacturingSiteRequest.manufacturing_site:type_name -> moh.registry.v1.ManufacturingSite
+	20, // 7: moh.registry.v1.UpdateManufacturingSiteRequest.manufacturing_site:type_name -> moh.registry.v1.ManufacturingSite
+	20, // 8: moh.registry.v1.ListManufacturingSitesResponse.items:type_name -> moh.registry.v1.ManufacturingSite
+	0,  // 9: moh.registry.v1.RegistryService.CreateAuthHolder:input_type -> moh.registry.v1.CreateAuthHolderRequest
+	1,  // 10: moh.registry.v1.RegistryService.GetAuthHolder:input_type -> moh.registry.v1.GetAuthHolderRequest
+	2,  // 11: moh.registry.v1.RegistryService.UpdateAuthHolder:input_type -> moh.registry.v1.UpdateAuthHolderRequest
+	3,  // 12: moh.registry.v1.RegistryService.DeleteAuthHolder:input_type -> moh.registry.v1.DeleteAuthHolderRequest
+	4,  // 13: moh.registry.v1.RegistryService.ListAuthHolders:input_type -> moh.registry.v1.ListAuthHoldersRequest
+	6,  // 14: moh.registry.v1.RegistryService.CreateMarketingAuthorization:input_type -> moh.registry.v1.CreateMarketingAuthorizationRequest
+	7,  // 15: moh.registry.v1.RegistryService.GetMarketingAuthorization:input_type -> moh.registry.v1.GetMarketingAuthorizationRequest
+	8,  // 16: moh.registry.v1.RegistryService.UpdateMarketingAuthorization:input_type -> moh.registry.v1.UpdateMarketingAuthorizationRequest
+	9,  // 17: moh.registry.v1.RegistryService.DeleteMarketingAuthorization:input_type -> moh.registry.v1.DeleteMarketingAuthorizationRequest
+	10, // 18: moh.registry.v1.RegistryService.ListMarketingAuthorizations:input_type -> moh.registry.v1.ListMarketingAuthorizationsRequest
+	12, // 19: moh.registry.v1.RegistryService.CreateManufacturingSite:input_type -> moh.registry.v1.CreateManufacturingSiteRequest
+	13, // 20: moh.registry.v1.RegistryService.GetManufacturingSite:input_type -> moh.registry.v1.GetManufacturingSiteRequest
+	14, // 21: moh.registry.v1.RegistryService.UpdateManufacturingSite:input_type -> moh.registry.v1.UpdateManufacturingSiteRequest
+	15, // 22: moh.registry.v1.RegistryService.DeleteManufacturingSite:input_type -> moh.registry.v1.DeleteManufacturingSiteRequest
+	16, // 23: moh.registry.v1.RegistryService.ListManufacturingSites:input_type -> moh.registry.v1.ListManufacturingSitesRequest
+	18, // 24: moh.registry.v1.RegistryService.CreateAuthHolder:output_type -> moh.registry.v1.AuthHolder
+	18, // 25: moh.registry.v1.RegistryService.GetAuthHolder:output_type -> moh.registry.v1.AuthHolder
+	18, // 26: moh.registry.v1.RegistryService.UpdateAuthHolder:output_type -> moh.registry.v1.AuthHolder
+	21, // 27: moh.registry.v1.RegistryService.DeleteAuthHolder:output_type -> google.protobuf.Empty
+	5,  // 28: moh.registry.v1.RegistryService.ListAuthHolders:output_type -> moh.registry.v1.ListAuthHoldersResponse
+	19, // 29: moh.registry.v1.RegistryService.CreateMarketingAuthorization:output_type -> moh.registry.v1.MarketingAuthorization
+	19, // 30: moh.registry.v1.RegistryService.GetMarketingAuthorization:output_type -> moh.registry.v1.MarketingAuthorization
+	19, // 31: moh.registry.v1.RegistryService.UpdateMarketingAuthorization:output_type -> moh.registry.v1.MarketingAuthorization
+	21, // 32: moh.registry.v1.RegistryService.DeleteMarketingAuthorization:output_type -> google.protobuf.Empty
+	11, // 33: moh.registry.v1.RegistryService.ListMarketingAuthorizations:output_type -> moh.registry.v1.ListMarketingAuthorizationsResponse
+	20, // 34: moh.registry.v1.RegistryService.CreateManufacturingSite:output_type -> moh.registry.v1.ManufacturingSite
+	20, // 35: moh.registry.v1.RegistryService.GetManufacturingSite:output_type -> moh.registry.v1.ManufacturingSite
+	20, // 36: moh.registry.v1.RegistryService.UpdateManufacturingSite:output_type -> moh.registry.v1.ManufacturingSite
+	21, // 37: moh.registry.v1.RegistryService.DeleteManufacturingSite:output_type -> google.protobuf.Empty
+	17, // 38: moh.registry.v1.RegistryService.ListManufacturingSites:output_type -> moh.registry.v1.ListManufacturingSitesResponse
+	24, // [24:39] is the sub-list for method output_type
+	9,  // [9:24] is the sub-list for method input_type
+	9,  // [9:9] is the sub-list for extension type_name
+	9,  // [9:9] is the sub-list for extension extendee
+	0,  // [0:9] is the sub-list for field type_name
+}
+
+func init() { file_moh_registry_v1_registry_proto_init() }
+func file_moh_registry_v1_registry_proto_init() {
+	if File_moh_registry_v1_registry_proto != nil {
+		return
+	}
+	file_moh_registry_v1_common_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_moh_registry_v1_registry_proto_rawDesc), len(file_moh_registry_v1_registry_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   18,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_moh_registry_v1_registry_proto_goTypes,
+		DependencyIndexes: file_moh_registry_v1_registry_proto_depIdxs,
+		MessageInfos:      file_moh_registry_v1_registry_proto_msgTypes,
+	}.Build()
+	File_moh_registry_v1_registry_proto = out.File
+	file_moh_registry_v1_registry_proto_goTypes = nil
+	file_moh_registry_v1_registry_proto_depIdxs = nil
+}
@@ -0,0 +1,658 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.29.3
+// source: moh/registry/v1/registry.proto
+
+package registrypb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	RegistryService_CreateAuthHolder_FullMethodName             = "/moh.registry.v1.RegistryService/CreateAuthHolder"
+	RegistryService_GetAuthHolder_FullMethodName                = "/moh.registry.v1.RegistryService/GetAuthHolder"
+	RegistryService_UpdateAuthHolder_FullMethodName             = "/moh.registry.v1.RegistryService/UpdateAuthHolder"
+	RegistryService_DeleteAuthHolder_FullMethodName             = "/moh.registry.v1.RegistryService/DeleteAuthHolder"
+	RegistryService_ListAuthHolders_FullMethodName              = "/moh.registry.v1.RegistryService/ListAuthHolders"
+	RegistryService_CreateMarketingAuthorization_FullMethodName = "/moh.registry.v1.RegistryService/CreateMarketingAuthorization"
+	RegistryService_GetMarketingAuthorization_FullMethodName    = "/moh.registry.v1.RegistryService/GetMarketingAuthorization"
+	RegistryService_UpdateMarketingAuthorization_FullMethodName = "/moh.registry.v1.RegistryService/UpdateMarketingAuthorization"
+	RegistryService_DeleteMarketingAuthorization_FullMethodName = "/moh.registry.v1.RegistryService/DeleteMarketingAuthorization"
+	RegistryService_ListMarketingAuthorizations_FullMethodName  = "/moh.registry.v1.RegistryService/ListMarketingAuthorizations"
+	RegistryService_CreateManufacturingSite_FullMethodName      = "/moh.registry.v1.RegistryService/CreateManufacturingSite"
+	RegistryService_GetManufacturingSite_FullMethodName         = "/moh.registry.v1.RegistryService/GetManufacturingSite"
+	RegistryService_UpdateManufacturingSite_FullMethodName      = "/moh.registry.v1.RegistryService/UpdateManufacturingSite"
+	RegistryService_DeleteManufacturingSite_FullMethodName      = "/moh.registry.v1.RegistryService/DeleteManufacturingSite"
+	RegistryService_ListManufacturingSites_FullMethodName       = "/moh.registry.v1.RegistryService/ListManufacturingSites"
+)
+
+// RegistryServiceClient is the client API for RegistryService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// RegistryService manages authorization holders, marketing authorizations and manufacturing sites.
+type RegistryServiceClient interface {
+	CreateAuthHolder(ctx context.Context, in *CreateAuthHolderRequest, opts ...grpc.CallOption) (*AuthHolder, error)
+	GetAuthHolder(ctx context.Context, in *GetAuthHolderRequest, opts ...grpc.CallOption) (*AuthHolder, error)
+	UpdateAuthHolder(ctx context.Context, in *UpdateAuthHolderRequest, opts ...grpc.CallOption) (*AuthHolder, error)
+	DeleteAuthHolder(ctx context.Context, in *DeleteAuthHolderRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ListAuthHolders(ctx context.Context, in *ListAuthHoldersRequest, opts ...grpc.CallOption) (*ListAuthHoldersResponse, error)
+	CreateMarketingAuthorization(ctx context.Context, in *CreateMarketingAuthorizationRequest, opts ...grpc.CallOption) (*MarketingAuthorization, error)
+	GetMarketingAuthorization(ctx context.Context, in *GetMarketingAuthorizationRequest, opts ...grpc.CallOption) (*MarketingAuthorization, error)
+	UpdateMarketingAuthorization(ctx context.Context, in *UpdateMarketingAuthorizationRequest, opts ...grpc.CallOption) (*MarketingAuthorization, error)
+	DeleteMarketingAuthorization(ctx context.Context, in *DeleteMarketingAuthorizationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ListMarketingAuthorizations(ctx context.Context, in *ListMarketingAuthorizationsRequest, opts ...grpc.CallOption) (*ListMarketingAuthorizationsResponse, error)
+	CreateManufacturingSite(ctx context.Context, in *CreateManufacturingSiteRequest, opts ...grpc.CallOption) (*ManufacturingSite, error)
+	GetManufacturingSite(ctx context.Context, in *GetManufacturingSiteRequest, opts ...grpc.CallOption) (*ManufacturingSite, error)
+	UpdateManufacturingSite(ctx context.Context, in *UpdateManufacturingSiteRequest, opts ...grpc.CallOption) (*ManufacturingSite, error)
+	DeleteManufacturingSite(ctx context.Context, in *DeleteManufacturingSiteRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ListManufacturingSites(ctx context.Context, in *ListManufacturingSitesRequest, opts ...grpc.CallOption) (*ListManufacturingSitesResponse, error)
+}
+
+type registryServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewRegistryServiceClient(cc grpc.ClientConnInterface) RegistryServiceClient {
+	return &registryServiceClient{cc}
+}
+
+func (c *registryServiceClient) CreateAuthHolder(ctx context.Context, in *CreateAuthHolderRequest, opts ...grpc.CallOption) (*AuthHolder, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AuthHolder)
+	err := c.cc.Invoke(ctx, RegistryService_CreateAuthHolder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *registryServiceClient) GetAuthHolder(ctx context.Context, in *GetAuthHolderRequest, opts ...grpc.CallOption) (*AuthHolder, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AuthHolder)
+	err := c.cc.Invoke(ctx, RegistryService_GetAuthHolder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *registryServiceClient) UpdateAuthHolder(ctx context.Context, in *UpdateAuthHolderRequest, opts ...grpc.CallOption) (*AuthHolder, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AuthHolder)
+	err := c.cc.Invoke(ctx, RegistryService_UpdateAuthHolder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *registryServiceClient) DeleteAuthHolder(ctx context.Context, in *DeleteAuthHolderRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, RegistryService_DeleteAuthHolder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *registryServiceClient) ListAuthHolders(ctx context.Context, in *ListAuthHoldersRequest, opts ...grpc.CallOption) (*ListAuthHoldersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAuthHoldersResponse)
+	err := c.cc.Invoke(ctx, RegistryService_ListAuthHolders_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *registryServiceClient) CreateMarketingAuthorization(ctx context.Context, in *CreateMarketingAuthorizationRequest, opts ...grpc.CallOption) (*MarketingAuthorization, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MarketingAuthorization)
+	err := c.cc.Invoke(ctx, RegistryService_CreateMarketingAuthorization_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *registryServiceClient) GetMarketingAuthorization(ctx context.Context, in *GetMarketingAuthorizationRequest, opts ...grpc.CallOption) (*MarketingAuthorization, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MarketingAuthorization)
+	err := c.cc.Invoke(ctx, RegistryService_GetMarketingAuthorization_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *registryServiceClient) UpdateMarketingAuthorization(ctx context.Context, in *UpdateMarketingAuthorizationRequest, opts ...grpc.CallOption) (*MarketingAuthorization, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MarketingAuthorization)
+	err := c.cc.Invoke(ctx, RegistryService_UpdateMarketingAuthorization_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *registryServiceClient) DeleteMarketingAuthorization(ctx context.Context, in *DeleteMarketingAuthorizationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, RegistryService_DeleteMarketingAuthorization_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *registryServiceClient) ListMarketingAuthorizations(ctx context.Context, in *ListMarketingAuthorizationsRequest, opts ...grpc.CallOption) (*ListMarketingAuthorizationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMarketingAuthorizationsResponse)
+	err := c.cc.Invoke(ctx, RegistryService_ListMarketingAuthorizations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *registryServiceClient) CreateManufacturingSite(ctx context.Context, in *CreateManufacturingSiteRequest, opts ...grpc.CallOption) (*ManufacturingSite, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ManufacturingSite)
+	err := c.cc.Invoke(ctx, RegistryService_CreateManufacturingSite_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *registryServiceClient) GetManufacturingSite(ctx context.Context, in *GetManufacturingSiteRequest, opts ...grpc.CallOption) (*ManufacturingSite, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ManufacturingSite)
+	err := c.cc.Invoke(ctx, RegistryService_GetManufacturingSite_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *registryServiceClient) UpdateManufacturingSite(ctx context.Context, in *UpdateManufacturingSiteRequest, opts ...grpc.CallOption) (*ManufacturingSite, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ManufacturingSite)
+	err := c.cc.Invoke(ctx, RegistryService_UpdateManufacturingSite_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *registryServiceClient) DeleteManufacturingSite(ctx context.Context, in *DeleteManufacturingSiteRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, RegistryService_DeleteManufacturingSite_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *registryServiceClient) ListManufacturingSites(ctx context.Context, in *ListManufacturingSitesRequest, opts ...grpc.CallOption) (*ListManufacturingSitesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListManufacturingSitesResponse)
+	err := c.cc.Invoke(ctx, RegistryService_ListManufacturingSites_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RegistryServiceServer is the server API for RegistryService service.
+// All implementations must embed UnimplementedRegistryServiceServer
+// for forward compatibility.
+//
+// RegistryService manages authorization holders, marketing authorizations and manufacturing sites.
+type RegistryServiceServer interface {
+	CreateAuthHolder(context.Context, *CreateAuthHolderRequest) (*AuthHolder, error)
+	GetAuthHolder(context.Context, *GetAuthHolderRequest) (*AuthHolder, error)
+	UpdateAuthHolder(context.Context, *UpdateAuthHolderRequest) (*AuthHolder, error)
+	DeleteAuthHolder(context.Context, *DeleteAuthHolderRequest) (*emptypb.Empty, error)
+	ListAuthHolders(context.Context, *ListAuthHoldersRequest) (*ListAuthHoldersResponse, error)
+	CreateMarketingAuthorization(context.Context, *CreateMarketingAuthorizationRequest) (*MarketingAuthorization, error)
+	GetMarketingAuthorization(context.Context, *GetMarketingAuthorizationRequest) (*MarketingAuthorization, error)
+	UpdateMarketingAuthorization(context.Context, *UpdateMarketingAuthorizationRequest) (*MarketingAuthorization, error)
+	DeleteMarketingAuthorization(context.Context, *DeleteMarketingAuthorizationRequest) (*emptypb.Empty, error)
+	ListMarketingAuthorizations(context.Context, *ListMarketingAuthorizationsRequest) (*ListMarketingAuthorizationsResponse, error)
+	CreateManufacturingSite(context.Context, *CreateManufacturingSiteRequest) (*ManufacturingSite, error)
+	GetManufacturingSite(context.Context, *GetManufacturingSiteRequest) (*ManufacturingSite, error)
+	UpdateManufacturingSite(context.Context, *UpdateManufacturingSiteRequest) (*ManufacturingSite, error)
+	DeleteManufacturingSite(context.Context, *DeleteManufacturingSiteRequest) (*emptypb.Empty, error)
+	ListManufacturingSites(context.Context, *ListManufacturingSitesRequest) (*ListManufacturingSitesResponse, error)
+	mustEmbedUnimplementedRegistryServiceServer()
+}
+
+// UnimplementedRegistryServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedRegistryServiceServer struct{}
+
+func (UnimplementedRegistryServiceServer) CreateAuthHolder(context.Context, *CreateAuthHolderRequest) (*AuthHolder, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAuthHolder not implemented")
+}
+func (UnimplementedRegistryServiceServer) GetAuthHolder(context.Context, *GetAuthHolderRequest) (*AuthHolder, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAuthHolder not implemented")
+}
+func (UnimplementedRegistryServiceServer) UpdateAuthHolder(context.Context, *UpdateAuthHolderRequest) (*AuthHolder, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAuthHolder not implemented")
+}
+func (UnimplementedRegistryServiceServer) DeleteAuthHolder(context.Context, *DeleteAuthHolderRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAuthHolder not implemented")
+}
+func (UnimplementedRegistryServiceServer) ListAuthHolders(context.Context, *ListAuthHoldersRequest) (*ListAuthHoldersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAuthHolders not implemented")
+}
+func (UnimplementedRegistryServiceServer) CreateMarketingAuthorization(context.Context, *CreateMarketingAuthorizationRequest) (*MarketingAuthorization, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateMarketingAuthorization not implemented")
+}
+func (UnimplementedRegistryServiceServer) GetMarketingAuthorization(context.Context, *GetMarketingAuthorizationRequest) (*MarketingAuthorization, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMarketingAuthorization not implemented")
+}
+func (UnimplementedRegistryServiceServer) UpdateMarketingAuthorization(context.Context, *UpdateMarketingAuthorizationRequest) (*MarketingAuthorization, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateMarketingAuthorization not implemented")
+}
+func (UnimplementedRegistryServiceServer) DeleteMarketingAuthorization(context.Context, *DeleteMarketingAuthorizationRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteMarketingAuthorization not implemented")
+}
+func (UnimplementedRegistryServiceServer) ListMarketingAuthorizations(context.Context, *ListMarketingAuthorizationsRequest) (*ListMarketingAuthorizationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMarketingAuthorizations not implemented")
+}
+func (UnimplementedRegistryServiceServer) CreateManufacturingSite(context.Context, *CreateManufacturingSiteRequest) (*ManufacturingSite, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateManufacturingSite not implemented")
+}
+func (UnimplementedRegistryServiceServer) GetManufacturingSite(context.Context, *GetManufacturingSiteRequest) (*ManufacturingSite, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetManufacturingSite not implemented")
+}
+func (UnimplementedRegistryServiceServer) UpdateManufacturingSite(context.Context, *UpdateManufacturingSiteRequest) (*ManufacturingSite, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateManufacturingSite not implemented")
+}
+func (UnimplementedRegistryServiceServer) DeleteManufacturingSite(context.Context, *DeleteManufacturingSiteRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteManufacturingSite not implemented")
+}
+func (UnimplementedRegistryServiceServer) ListManufacturingSites(context.Context, *ListManufacturingSitesRequest) (*ListManufacturingSitesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListManufacturingSites not implemented")
+}
+func (UnimplementedRegistryServiceServer) mustEmbedUnimplementedRegistryServiceServer() {}
+func (UnimplementedRegistryServiceServer) testEmbeddedByValue()                         {}
+
+// UnsafeRegistryServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to RegistryServiceServer will
+// result in compilation errors.
+type UnsafeRegistryServiceServer interface {
+	mustEmbedUnimplementedRegistryServiceServer()
+}
+
+func RegisterRegistryServiceServer(s grpc.ServiceRegistrar, srv RegistryServiceServer) {
+	// If the following call pancis, it indicates UnimplementedRegistryServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&RegistryService_ServiceDesc, srv)
+}
+
+func _RegistryService_CreateAuthHolder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAuthHolderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RegistryServiceServer).CreateAuthHolder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RegistryService_CreateAuthHolder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RegistryServiceServer).CreateAuthHolder(ctx, req.(*CreateAuthHolderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RegistryService_GetAuthHolder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAuthHolderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RegistryServiceServer).GetAuthHolder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RegistryService_GetAuthHolder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RegistryServiceServer).GetAuthHolder(ctx, req.(*GetAuthHolderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RegistryService_UpdateAuthHolder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateAuthHolderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RegistryServiceServer).UpdateAuthHolder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RegistryService_UpdateAuthHolder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RegistryServiceServer).UpdateAuthHolder(ctx, req.(*UpdateAuthHolderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RegistryService_DeleteAuthHolder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAuthHolderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RegistryServiceServer).DeleteAuthHolder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RegistryService_DeleteAuthHolder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RegistryServiceServer).DeleteAuthHolder(ctx, req.(*DeleteAuthHolderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RegistryService_ListAuthHolders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAuthHoldersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RegistryServiceServer).ListAuthHolders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RegistryService_ListAuthHolders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RegistryServiceServer).ListAuthHolders(ctx, req.(*ListAuthHoldersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RegistryService_CreateMarketingAuthorization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateMarketingAuthorizationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RegistryServiceServer).CreateMarketingAuthorization(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RegistryService_CreateMarketingAuthorization_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RegistryServiceServer).CreateMarketingAuthorization(ctx, req.(*CreateMarketingAuthorizationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RegistryService_GetMarketingAuthorization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMarketingAuthorizationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RegistryServiceServer).GetMarketingAuthorization(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RegistryService_GetMarketingAuthorization_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RegistryServiceServer).GetMarketingAuthorization(ctx, req.(*GetMarketingAuthorizationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RegistryService_UpdateMarketingAuthorization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateMarketingAuthorizationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RegistryServiceServer).UpdateMarketingAuthorization(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RegistryService_UpdateMarketingAuthorization_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RegistryServiceServer).UpdateMarketingAuthorization(ctx, req.(*UpdateMarketingAuthorizationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RegistryService_DeleteMarketingAuthorization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteMarketingAuthorizationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RegistryServiceServer).DeleteMarketingAuthorization(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RegistryService_DeleteMarketingAuthorization_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RegistryServiceServer).DeleteMarketingAuthorization(ctx, req.(*DeleteMarketingAuthorizationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RegistryService_ListMarketingAuthorizations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMarketingAuthorizationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RegistryServiceServer).ListMarketingAuthorizations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RegistryService_ListMarketingAuthorizations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RegistryServiceServer).ListMarketingAuthorizations(ctx, req.(*ListMarketingAuthorizationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RegistryService_CreateManufacturingSite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateManufacturingSiteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RegistryServiceServer).CreateManufacturingSite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RegistryService_CreateManufacturingSite_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RegistryServiceServer).CreateManufacturingSite(ctx, req.(*CreateManufacturingSiteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RegistryService_GetManufacturingSite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetManufacturingSiteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RegistryServiceServer).GetManufacturingSite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RegistryService_GetManufacturingSite_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RegistryServiceServer).GetManufacturingSite(ctx, req.(*GetManufacturingSiteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RegistryService_UpdateManufacturingSite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateManufacturingSiteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RegistryServiceServer).UpdateManufacturingSite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RegistryService_UpdateManufacturingSite_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RegistryServiceServer).UpdateManufacturingSite(ctx, req.(*UpdateManufacturingSiteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RegistryService_DeleteManufacturingSite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteManufacturingSiteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RegistryServiceServer).DeleteManufacturingSite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RegistryService_DeleteManufacturingSite_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RegistryServiceServer).DeleteManufacturingSite(ctx, req.(*DeleteManufacturingSiteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RegistryService_ListManufacturingSites_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListManufacturingSitesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RegistryServiceServer).ListManufacturingSites(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RegistryService_ListManufacturingSites_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RegistryServiceServer).ListManufacturingSites(ctx, req.(*ListManufacturingSitesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// RegistryService_ServiceDesc is the grpc.ServiceDesc for RegistryService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var RegistryService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "moh.registry.v1.RegistryService",
+	HandlerType: (*RegistryServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateAuthHolder",
+			Handler:    _RegistryService_CreateAuthHolder_Handler,
+		},
+		{
+			MethodName: "GetAuthHolder",
+			Handler:    _RegistryService_GetAuthHolder_Handler,
+		},
+		{
+			MethodName: "UpdateAuthHolder",
+			Handler:    _RegistryService_UpdateAuthHolder_Handler,
+		},
+		{
+			MethodName: "DeleteAuthHolder",
+			Handler:    _RegistryService_DeleteAuthHolder_Handler,
+		},
+		{
+			MethodName: "ListAuthHolders",
+			Handler:    _RegistryService_ListAuthHolders_Handler,
+		},
+		{
+			MethodName: "CreateMarketingAuthorization",
+			Handler:    _RegistryService_CreateMarketingAuthorization_Handler,
+		},
+		{
+			MethodName: "GetMarketingAuthorization",
+			Handler:    _RegistryService_GetMarketingAuthorization_Handler,
+		},
+		{
+			MethodName: "UpdateMarketingAuthorization",
+			Handler:    _RegistryService_UpdateMarketingAuthorization_Handler,
+		},
+		{
+			MethodName: "DeleteMarketingAuthorization",
+			Handler:    _RegistryService_DeleteMarketingAuthorization_Handler,
+		},
+		{
+			MethodName: "ListMarketingAuthorizations",
+			Handler:    _RegistryService_ListMarketingAuthorizations_Handler,
+		},
+		{
+			MethodName: "CreateManufacturingSite",
+			Handler:    _RegistryService_CreateManufacturingSite_Handler,
+		},
+		{
+			MethodName: "GetManufacturingSite",
+			Handler:    _RegistryService_GetManufacturingSite_Handler,
+		},
+		{
+			MethodName: "UpdateManufacturingSite",
+			Handler:    _RegistryService_UpdateManufacturingSite_Handler,
+		},
+		{
+			MethodName: "DeleteManufacturingSite",
+			Handler:    _RegistryService_DeleteManufacturingSite_Handler,
+		},
+		{
+			MethodName: "ListManufacturingSites",
+			Handler:    _RegistryService_ListManufacturingSites_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "moh/registry/v1/registry.proto",
+}
@@ -0,0 +1,228 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"moh/internal/services"
+	"moh/models"
+	"moh/shared"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Generic create/get/update/delete/list plumbing shared by every service.
+// Each RPC converts its message to the model, calls the same internal/services
+// function the HTTP handlers use, and converts the result back.
+
+type (
+	addFunc[T any]     func(ctx context.Context, db services.DBTX, in T) (T, error)
+	getFunc[T any]     func(ctx context.Context, db services.DBTX, id string) (T, error)
+	updateFunc[T any]  func(ctx context.Context, db services.DBTX, id string, in T) (T, error)
+	deleteFunc         func(ctx context.Context, db services.DBTX, id string) error
+	listFunc[F, T any] func(ctx context.Context, db services.DBTX, f F, p models.ListParams) (models.Page[T], error)
+)
+
+// validatable is satisfied by pointers to the models.*Filter structs.
+type validatable[F any] interface {
+	*F
+	Validate() error
+}
+
+// statusError maps a service error to a gRPC status by its shared.Kind, the
+// way shared/responed maps it to an HTTP status. Internal errors are logged
+// and reported without their cause.
+func statusError(err error) error {
+	switch {
+	case errors.Is(err, context.Canceled):
+		return status.Error(codes.Canceled, err.Error())
+	case errors.Is(err, context.DeadlineExceeded):
+		return status.Error(codes.DeadlineExceeded, err.Error())
+	}
+	e := shared.As(err)
+	switch e.Kind {
+	case shared.KindValidation:
+		return validationStatus(err, e.Fields)
+	case shared.KindBadRequest, shared.KindForeignKey:
+		return status.Error(codes.InvalidArgument, err.Error())
+	case shared.KindNotFound:
+		return status.Error(codes.NotFound, err.Error())
+	case shared.KindConflict:
+		if e.Code == "already_exists" {
+			return status.Error(codes.AlreadyExists, err.Error())
+		}
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case shared.KindUnauthorized:
+		return status.Error(codes.Unauthenticated, err.Error())
+	case shared.KindForbidden:
+		return status.Error(codes.PermissionDenied, err.Error())
+	default:
+		log.Printf("internal error: %v", err)
+		return status.Error(codes.Internal, e.Detail)
+	}
+}
+
+// validationStatus is InvalidArgument with a BadRequest detail listing
+// every failed field rule.
+func validationStatus(err error, fields []shared.FieldError) error {
+	st := status.New(codes.InvalidArgument, err.Error())
+	br := &errdetails.BadRequest{FieldViolations: make([]*errdetails.BadRequest_FieldViolation, len(fields))}
+	for i, f := range fields {
+		br.FieldViolations[i] = &errdetails.BadRequest_FieldViolation{
+			Field:       f.Field,
+			Description: f.Message,
+			Reason:      strings.ToUpper(f.Rule),
+		}
+	}
+	if withDetails, derr := st.WithDetails(br); derr == nil {
+		st = withDetails
+	}
+	return st.Err()
+}
+
+func checkID(id string) error {
+	if _, err := uuid.Parse(id); err != nil {
+		return status.Error(codes.InvalidArgument, "id must be a valid UUID")
+	}
+	return nil
+}
+
+func create[T, P any](ctx context.Context, db *pgxpool.Pool, in P, from func(P) (T, error), fn addFunc[T], to func(T) P) (P, error) {
+	var zero P
+	m, err := from(in)
+	if err != nil {
+		return zero, status.Error(codes.InvalidArgument, err.Error())
+	}
+	out, err := fn(ctx, db, m)
+	if err != nil {
+		return zero, statusError(err)
+	}
+	return to(out), nil
+}
+
+func get[T, P any](ctx context.Context, db *pgxpool.Pool, id string, fn getFunc[T], to func(T) P) (P, error) {
+	var zero P
+	if err := checkID(id); err != nil {
+		return zero, err
+	}
+	out, err := fn(ctx, db, id)
+	if err != nil {
+		return zero, statusError(err)
+	}
+	return to(out), nil
+}
+
+// update replaces the row named by id with in, mirroring HTTP PUT.
+func update[T, P any](ctx context.Context, db *pgxpool.Pool, id string, in P, from func(P) (T, error), fn updateFunc[T], to func(T) P) (P, error) {
+	var zero P
+	if err := checkID(id); err != nil {
+		return zero, err
+	}
+	m, err := from(in)
+	if err != nil {
+		return zero, status.Error(codes.InvalidArgument, err.Error())
+	}
+	out, err := fn(ctx, db, id, m)
+	if err != nil {
+		return zero, statusError(err)
+	}
+	return to(out), nil
+}
+
+func remove(ctx context.Context, db *pgxpool.Pool, id string, fn deleteFunc) (*emptypb.Empty, error) {
+	if err := checkID(id); err != nil {
+		return nil, err
+	}
+	if err := fn(ctx, db, id); err != nil {
+		return nil, statusError(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// list validates the paging options and filter, then runs list.
+func list[F any, PF validatable[F], T, P any](ctx context.Context, db *pgxpool.Pool, f F, pageSize int32, pageToken, orderBy string, fn listFunc[F, T], to func(T) P) ([]P, string, int64, error) {
+	p := models.ListParams{Limit: int(pageSize), Cursor: pageToken, Sort: orderBy}
+	for _, v := range []interface{ Validate() error }{&p, PF(&f)} {
+		if err := v.Validate(); err != nil {
+			return nil, "", 0, statusError(models.QueryError(err))
+		}
+	}
+	page, err := fn(ctx, db, f, p)
+	if err != nil {
+		return nil, "", 0, statusError(err)
+	}
+	items := make([]P, len(page.Items))
+	for i, it := range page.Items {
+		items[i] = to(it)
+	}
+	return items, page.NextCursor, page.Total, nil
+}
+
+// ===== Field conversions =====
+
+const dateLayout = "2006-01-02"
+
+func timestamp(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+	return timestamppb.New(*t)
+}
+
+func formatDate(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format(dateLayout)
+}
+
+// parseDecimal reads an optional decimal field; empty is nil.
+func parseDecimal(field, s string) (*models.Decimal, error) {
+	if s == "" {
+		return nil, nil
+	}
+	d, err := models.ParseDecimal(s)
+	if err != nil {
+		return nil, fmt.Errorf("%s must be a decimal number", field)
+	}
+	return &d, nil
+}
+
+// parseAmount reads a decimal field whose empty value is zero.
+func parseAmount(field, s string) (models.Decimal, error) {
+	d, err := parseDecimal(field, s)
+	if err != nil || d == nil {
+		return models.Decimal{}, err
+	}
+	return *d, nil
+}
+
+// formatDecimal writes an optional decimal field.
+func formatDecimal(d *models.Decimal) string {
+	if d == nil {
+		return ""
+	}
+	return d.String()
+}
+
+// parseDate reads a YYYY-MM-DD field; empty means the zero time.
+func parseDate(field, s string) (time.Time, error) {
+	if s == "" {
+		return time.Time{}, nil
+	}
+	t, err := time.Parse(dateLayout, s)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%s must be a YYYY-MM-DD date", field)
+	}
+	return t, nil
+}
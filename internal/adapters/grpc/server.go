@@ -0,0 +1,62 @@
+// Package grpc exposes the registry services over gRPC for ERP integrations.
+// The messages live in proto/moh/registry/v1; registrypb is generated from
+// them by running "buf generate" at the repository root.
+package grpc
+
+import (
+	"moh/internal/adapters/grpc/registrypb"
+	"moh/models"
+	mw "moh/shared/middlewares"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
+)
+
+// serviceNames are reported as SERVING by the health service.
+var serviceNames = []string{
+	registrypb.CatalogService_ServiceDesc.ServiceName,
+	registrypb.RegistryService_ServiceDesc.ServiceName,
+	registrypb.DrugService_ServiceDesc.ServiceName,
+	registrypb.RegistrationService_ServiceDesc.ServiceName,
+	registrypb.BatchService_ServiceDesc.ServiceName,
+}
+
+// Options are the policy settings of the registry services, as for the
+// HTTP API.
+type Options struct {
+	// BrandSimilarityThreshold is the look-alike/sound-alike score (0..1)
+	// from which CreateDrug refuses a brand name; 0 turns the check off.
+	BrandSimilarityThreshold float64
+	// PriceCeilingPolicy is what CreateBatch and UpdateBatch do with a
+	// price above the ceiling in force; empty means models.PriceCeilingReject.
+	PriceCeilingPolicy models.PriceCeilingPolicy
+}
+
+// NewServer builds a gRPC server with every registry service, the standard
+// health service and server reflection registered. Callers stop it with
+// GracefulStop and mark it NOT_SERVING first through the returned health server.
+func NewServer(db *pgxpool.Pool, keys *mw.KeySet, o Options, opts ...grpc.ServerOption) (*grpc.Server, *health.Server) {
+	opts = append(opts,
+		grpc.ChainUnaryInterceptor(UnaryAuth(keys)),
+		grpc.ChainStreamInterceptor(StreamAuth(keys)),
+	)
+	s := grpc.NewServer(opts...)
+
+	registrypb.RegisterCatalogServiceServer(s, &catalogServer{db: db})
+	registrypb.RegisterRegistryServiceServer(s, &registryServer{db: db})
+	registrypb.RegisterDrugServiceServer(s, &drugServer{db: db, threshold: o.BrandSimilarityThreshold})
+	registrypb.RegisterRegistrationServiceServer(s, &registrationServer{db: db})
+	registrypb.RegisterBatchServiceServer(s, &batchServer{db: db, policy: o.PriceCeilingPolicy})
+
+	hs := health.NewServer()
+	for _, name := range serviceNames {
+		hs.SetServingStatus(name, healthpb.HealthCheckResponse_SERVING)
+	}
+	healthpb.RegisterHealthServer(s, hs)
+	reflection.Register(s)
+
+	return s, hs
+}
@@ -0,0 +1,23 @@
+package handlers
+
+import (
+	"net/http"
+
+	"moh/internal/scheduler"
+	"moh/shared/responed"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ListJobsHandler serves GET /admin/jobs: every background job with its last
+// run and its next run on the instance answering.
+func ListJobsHandler(s *scheduler.Scheduler) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		jobs, err := s.Status(c.Request.Context())
+		if err != nil {
+			responed.Error(c, err)
+			return
+		}
+		c.JSON(http.StatusOK, gin.H{"items": jobs})
+	}
+}
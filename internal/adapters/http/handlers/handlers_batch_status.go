@@ -0,0 +1,55 @@
+package handlers
+
+import (
+	"errors"
+	"io"
+	"net/http"
+
+	"moh/internal/repository"
+	"moh/models"
+	"moh/shared"
+	mw "moh/shared/middlewares"
+	"moh/shared/responed"
+
+	"github.com/gin-gonic/gin"
+)
+
+// TransitionBatchHandler serves POST /batch/:id/<action>, moving the batch to
+// status to. The body ({"reason": ...}) may be empty
+// for moves that need no reason. Illegal moves answer 409.
+func TransitionBatchHandler(batches repository.Batches, to models.BatchStatus) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, ok := pathID(c)
+		if !ok {
+			return
+		}
+		var in models.BatchTransition
+		if err := decodeStrict(c, &in); err != nil && !errors.Is(err, io.EOF) {
+			responed.Error(c, shared.BadRequest("invalid_json", "invalid_json", err.Error()))
+			return
+		}
+		claims, _ := mw.ClaimsFrom(c)
+		out, err := batches.Transition(c.Request.Context(), id, to, in, claims.Subject)
+		if err != nil {
+			responed.Error(c, err)
+			return
+		}
+		c.JSON(http.StatusOK, out)
+	}
+}
+
+// ListBatchStatusHistoryHandler serves GET /batch/:id/history.
+func ListBatchStatusHistoryHandler(batches repository.Batches) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, ok := pathID(c)
+		if !ok {
+			return
+		}
+		items, err := batches.History(c.Request.Context(), id)
+		if err != nil {
+			responed.Error(c, err)
+			return
+		}
+		c.JSON(http.StatusOK, gin.H{"items": items})
+	}
+}
@@ -0,0 +1,36 @@
+package handlers
+
+import (
+	"net/http"
+
+	"moh/internal/repository"
+	"moh/models"
+	"moh/shared"
+	"moh/shared/responed"
+
+	"github.com/gin-gonic/gin"
+)
+
+// SimilarBrandsHandler serves GET /drug/similar-brands?name=&exclude_id=&limit=,
+// the look-alike/sound-alike check POST /drug runs, for reviewers to try
+// names before they are submitted.
+func SimilarBrandsHandler(brands repository.Brands, threshold float64) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var in models.BrandCheck
+		if err := c.ShouldBindQuery(&in); err != nil {
+			responed.Error(c, shared.BadRequest("invalid_query", "query_parse", err.Error()))
+			return
+		}
+		matches, err := brands.Similar(c.Request.Context(), in)
+		if err != nil {
+			responed.Error(c, err)
+			return
+		}
+		c.JSON(http.StatusOK, models.BrandCheckResult{
+			Names:     in.Names,
+			Threshold: threshold,
+			Exceeded:  len(models.TooSimilar(matches, threshold)) > 0,
+			Matches:   matches,
+		})
+	}
+}
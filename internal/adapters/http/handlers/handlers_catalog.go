@@ -4,11 +4,12 @@ import (
 	"encoding/json"
 	"net/http"
 
-	"moh/internal/services"
+	"moh/internal/repository"
 	"moh/models"
+	"moh/shared"
+	"moh/shared/responed"
 
 	"github.com/gin-gonic/gin"
-	"github.com/jackc/pgx/v5/pgxpool"
 )
 
 // helper decode with DisallowUnknownFields
@@ -18,198 +19,202 @@ func decodeStrict(c *gin.Context, dst any) error {
 	return dec.Decode(dst)
 }
 
-func AddDosageFormHandler(db *pgxpool.Pool) gin.HandlerFunc {
+func AddDosageFormHandler(dosageForms repository.DosageForms) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		var in models.DosageForm
 		if err := decodeStrict(c, &in); err != nil {
-			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid_json", "message": err.Error()})
+			responed.Error(c, shared.BadRequest("invalid_json", "invalid_json", err.Error()))
 			return
 		}
-		out, err := services.AddDosageForm(c.Request.Context(), db, in)
+		out, err := dosageForms.Add(c.Request.Context(), in)
 		if err != nil {
-			c.JSON(http.StatusBadRequest, gin.H{"error": "create_failed", "message": err.Error()})
+			responed.Error(c, err)
 			return
 		}
 		c.JSON(http.StatusCreated, out)
 	}
 }
 
-func AddStrengthUnitHandler(db *pgxpool.Pool) gin.HandlerFunc {
+func AddStrengthUnitHandler(strengthUnits repository.StrengthUnits) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		var in models.StrengthUnit
 		if err := decodeStrict(c, &in); err != nil {
-			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid_json", "message": err.Error()})
+			responed.Error(c, shared.BadRequest("invalid_json", "invalid_json", err.Error()))
 			return
 		}
-		out, err := services.AddStrengthUnit(c.Request.Context(), db, in)
+		out, err := strengthUnits.Add(c.Request.Context(), in)
 		if err != nil {
-			c.JSON(http.StatusBadRequest, gin.H{"error": "create_failed", "message": err.Error()})
+			responed.Error(c, err)
 			return
 		}
 		c.JSON(http.StatusCreated, out)
 	}
 }
 
-func AddRouteOfAdminHandler(db *pgxpool.Pool) gin.HandlerFunc {
+func AddRouteOfAdminHandler(routes repository.RoutesOfAdmin) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		var in models.RouteOfAdmin
 		if err := decodeStrict(c, &in); err != nil {
-			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid_json", "message": err.Error()})
+			responed.Error(c, shared.BadRequest("invalid_json", "invalid_json", err.Error()))
 			return
 		}
-		out, err := services.AddRouteOfAdmin(c.Request.Context(), db, in)
+		out, err := routes.Add(c.Request.Context(), in)
 		if err != nil {
-			c.JSON(http.StatusBadRequest, gin.H{"error": "create_failed", "message": err.Error()})
+			responed.Error(c, err)
 			return
 		}
 		c.JSON(http.StatusCreated, out)
 	}
 }
 
-func AddAPIHandler(db *pgxpool.Pool) gin.HandlerFunc {
+func AddAPIHandler(apis repository.APIs) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		var in models.API
 		if err := decodeStrict(c, &in); err != nil {
-			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid_json", "message": err.Error()})
+			responed.Error(c, shared.BadRequest("invalid_json", "invalid_json", err.Error()))
 			return
 		}
-		out, err := services.AddAPI(c.Request.Context(), db, in)
+		out, err := apis.Add(c.Request.Context(), in)
 		if err != nil {
-			c.JSON(http.StatusBadRequest, gin.H{"error": "create_failed", "message": err.Error()})
+			responed.Error(c, err)
 			return
 		}
 		c.JSON(http.StatusCreated, out)
 	}
 }
 
-func ListDosageFormsHandler(db *pgxpool.Pool) gin.HandlerFunc {
-	return func(c *gin.Context) {
-		items, err := services.ListDosageForms(c.Request.Context(), db)
-		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": "list_failed", "message": err.Error()})
-			return
-		}
-		c.JSON(http.StatusOK, items)
-	}
+func ListDosageFormsHandler(dosageForms repository.DosageForms) gin.HandlerFunc {
+	return listHandler(dosageForms.List)
 }
 
-func ListStrengthUnitsHandler(db *pgxpool.Pool) gin.HandlerFunc {
-	return func(c *gin.Context) {
-		items, err := services.ListStrengthUnits(c.Request.Context(), db)
-		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": "list_failed", "message": err.Error()})
-			return
-		}
-		c.JSON(http.StatusOK, items)
-	}
+func ListStrengthUnitsHandler(strengthUnits repository.StrengthUnits) gin.HandlerFunc {
+	return listHandler(strengthUnits.List)
 }
 
-func ListRoutesOfAdminHandler(db *pgxpool.Pool) gin.HandlerFunc {
+// ConvertStrengthHandler serves GET /strength/convert?value=&from=&to=,
+// converting a strength between two units of the same dimension.
+func ConvertStrengthHandler(units repository.Units) gin.HandlerFunc {
 	return func(c *gin.Context) {
-		items, err := services.ListRoutesOfAdmin(c.Request.Context(), db)
-		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": "list_failed", "message": err.Error()})
+		var in models.UnitConversion
+		if err := c.ShouldBindQuery(&in); err != nil {
+			responed.Error(c, shared.BadRequest("invalid_query", "query_parse", err.Error()))
 			return
 		}
-		c.JSON(http.StatusOK, items)
-	}
-}
-
-func ListAPIsHandler(db *pgxpool.Pool) gin.HandlerFunc {
-	return func(c *gin.Context) {
-		items, err := services.ListAPIs(c.Request.Context(), db)
+		out, err := units.Convert(c.Request.Context(), in)
 		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": "list_failed", "message": err.Error()})
+			responed.Error(c, err)
 			return
 		}
-		c.JSON(http.StatusOK, items)
+		c.JSON(http.StatusOK, out)
 	}
 }
 
-func ListManufacturingSitesHandler(db *pgxpool.Pool) gin.HandlerFunc {
-	return func(c *gin.Context) {
-		items, err := services.ListManufacturingSites(c.Request.Context(), db)
-		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": "list_failed", "message": err.Error()})
-			return
-		}
-		c.JSON(http.StatusOK, items)
-	}
+func ListRoutesOfAdminHandler(routes repository.RoutesOfAdmin) gin.HandlerFunc {
+	return listHandler(routes.List)
 }
 
-func ListAuthHoldersHandler(db *pgxpool.Pool) gin.HandlerFunc {
-	return func(c *gin.Context) {
-		items, err := services.ListAuthHolders(c.Request.Context(), db)
-		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": "list_failed", "message": err.Error()})
-			return
-		}
-		c.JSON(http.StatusOK, items)
-	}
+func ListAPIsHandler(apis repository.APIs) gin.HandlerFunc {
+	return listHandler(apis.List)
 }
 
-func ListMarketingAuthorizationsHandler(db *pgxpool.Pool) gin.HandlerFunc {
-	return func(c *gin.Context) {
-		items, err := services.ListMarketingAuthorizations(c.Request.Context(), db)
-		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": "list_failed", "message": err.Error()})
-			return
-		}
-		c.JSON(http.StatusOK, items)
-	}
+func ListManufacturingSitesHandler(manufacturingSites repository.ManufacturingSites) gin.HandlerFunc {
+	return listHandler(manufacturingSites.List)
 }
 
-func ListDrugsHandler(db *pgxpool.Pool) gin.HandlerFunc {
-	return func(c *gin.Context) {
-		items, err := services.ListDrugs(c.Request.Context(), db)
-		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": "list_failed", "message": err.Error()})
-			return
-		}
-		c.JSON(http.StatusOK, items)
-	}
+func ListAuthHoldersHandler(authHolders repository.AuthHolders) gin.HandlerFunc {
+	return listHandler(authHolders.List)
 }
 
-func ListBatchesHandler(db *pgxpool.Pool) gin.HandlerFunc {
-	return func(c *gin.Context) {
-		items, err := services.ListBatches(c.Request.Context(), db)
-		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": "list_failed", "message": err.Error()})
-			return
-		}
-		c.JSON(http.StatusOK, items)
-	}
+func ListMarketingAuthorizationsHandler(marketingAuthorizations repository.MarketingAuthorizations) gin.HandlerFunc {
+	return listHandler(marketingAuthorizations.List)
 }
 
-func ListDrugRegistrationsHandler(db *pgxpool.Pool) gin.HandlerFunc {
-	return func(c *gin.Context) {
-		items, err := services.ListDrugRegistrations(c.Request.Context(), db)
-		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": "list_failed", "message": err.Error()})
-			return
-		}
-		c.JSON(http.StatusOK, items)
-	}
+func ListDrugsHandler(drugs repository.Drugs) gin.HandlerFunc {
+	return listHandler(drugs.List)
 }
 
-func ListDrugRegistrationSitesHandler(db *pgxpool.Pool) gin.HandlerFunc {
-	return func(c *gin.Context) {
-		items, err := services.ListDrugRegistrationSites(c.Request.Context(), db)
-		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": "list_failed", "message": err.Error()})
-			return
-		}
-		c.JSON(http.StatusOK, items)
-	}
+func ListDrugIngredientsHandler(ingredients repository.DrugIngredients) gin.HandlerFunc {
+	return listHandler(ingredients.List)
 }
 
-func ListDrugRegistrationAuthHoldersHandler(db *pgxpool.Pool) gin.HandlerFunc {
-	return func(c *gin.Context) {
-		items, err := services.ListDrugRegistrationAuthHolders(c.Request.Context(), db)
-		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": "list_failed", "message": err.Error()})
-			return
-		}
-		c.JSON(http.StatusOK, items)
-	}
+func ListBatchesHandler(batches repository.Batches) gin.HandlerFunc {
+	return listHandler(batches.List)
+}
+
+func ListDrugRegistrationsHandler(registrations repository.Registrations) gin.HandlerFunc {
+	return listHandler(registrations.List)
+}
+
+func ListDrugRegistrationSitesHandler(registrationSites repository.RegistrationSites) gin.HandlerFunc {
+	return listHandler(registrationSites.List)
+}
+
+func ListDrugRegistrationAuthHoldersHandler(registrationAuthHolders repository.RegistrationAuthHolders) gin.HandlerFunc {
+	return listHandler(registrationAuthHolders.List)
+}
+
+// ===== Get / Update / Delete by id =====
+
+func GetDosageFormHandler(dosageForms repository.DosageForms) gin.HandlerFunc {
+	return getByIDHandler(dosageForms.Get)
+}
+
+func UpdateDosageFormHandler(dosageForms repository.DosageForms) gin.HandlerFunc {
+	return replaceHandler(dosageForms.Update)
+}
+
+func PatchDosageFormHandler(dosageForms repository.DosageForms) gin.HandlerFunc {
+	return patchHandler(dosageForms.Get, dosageForms.Update)
+}
+
+func DeleteDosageFormHandler(dosageForms repository.DosageForms) gin.HandlerFunc {
+	return deleteHandler(dosageForms.Delete)
+}
+
+func GetStrengthUnitHandler(strengthUnits repository.StrengthUnits) gin.HandlerFunc {
+	return getByIDHandler(strengthUnits.Get)
+}
+
+func UpdateStrengthUnitHandler(strengthUnits repository.StrengthUnits) gin.HandlerFunc {
+	return replaceHandler(strengthUnits.Update)
+}
+
+func PatchStrengthUnitHandler(strengthUnits repository.StrengthUnits) gin.HandlerFunc {
+	return patchHandler(strengthUnits.Get, strengthUnits.Update)
+}
+
+func DeleteStrengthUnitHandler(strengthUnits repository.StrengthUnits) gin.HandlerFunc {
+	return deleteHandler(strengthUnits.Delete)
+}
+
+func GetRouteOfAdminHandler(routes repository.RoutesOfAdmin) gin.HandlerFunc {
+	return getByIDHandler(routes.Get)
+}
+
+func UpdateRouteOfAdminHandler(routes repository.RoutesOfAdmin) gin.HandlerFunc {
+	return replaceHandler(routes.Update)
+}
+
+func PatchRouteOfAdminHandler(routes repository.RoutesOfAdmin) gin.HandlerFunc {
+	return patchHandler(routes.Get, routes.Update)
+}
+
+func DeleteRouteOfAdminHandler(routes repository.RoutesOfAdmin) gin.HandlerFunc {
+	return deleteHandler(routes.Delete)
+}
+
+func GetAPIHandler(apis repository.APIs) gin.HandlerFunc {
+	return getByIDHandler(apis.Get)
+}
+
+func UpdateAPIHandler(apis repository.APIs) gin.HandlerFunc {
+	return replaceHandler(apis.Update)
+}
+
+func PatchAPIHandler(apis repository.APIs) gin.HandlerFunc {
+	return patchHandler(apis.Get, apis.Update)
+}
+
+func DeleteAPIHandler(apis repository.APIs) gin.HandlerFunc {
+	return deleteHandler(apis.Delete)
 }
@@ -0,0 +1,111 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+
+	"moh/shared"
+	"moh/shared/responed"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// Generic get/update/patch/delete handlers shared by every /:id route.
+
+type getFunc[T any] func(ctx context.Context, id string) (T, error)
+type updateFunc[T any] func(ctx context.Context, id string, in T) (T, error)
+type deleteFunc func(ctx context.Context, id string) error
+
+// patchPreparer is implemented by rows whose fields derive from one another
+// and must be reconciled before a PATCH body is decoded over them.
+type patchPreparer interface{ PreparePatch() }
+
+// pathID returns the :id param, answering 400 if it is not a UUID.
+func pathID(c *gin.Context) (string, bool) {
+	id := c.Param("id")
+	if _, err := uuid.Parse(id); err != nil {
+		responed.Error(c, shared.BadRequest("invalid_id", "invalid_id"))
+		return "", false
+	}
+	return id, true
+}
+
+func getByIDHandler[T any](get getFunc[T]) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, ok := pathID(c)
+		if !ok {
+			return
+		}
+		out, err := get(c.Request.Context(), id)
+		if err != nil {
+			responed.Error(c, err)
+			return
+		}
+		c.JSON(http.StatusOK, out)
+	}
+}
+
+// replaceHandler serves PUT: the body is the complete new representation.
+func replaceHandler[T any](update updateFunc[T]) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, ok := pathID(c)
+		if !ok {
+			return
+		}
+		var in T
+		if err := decodeStrict(c, &in); err != nil {
+			responed.Error(c, shared.BadRequest("invalid_json", "invalid_json", err.Error()))
+			return
+		}
+		out, err := update(c.Request.Context(), id, in)
+		if err != nil {
+			responed.Error(c, err)
+			return
+		}
+		c.JSON(http.StatusOK, out)
+	}
+}
+
+// patchHandler serves PATCH: the body is decoded over the stored row,
+// so omitted fields keep their current values.
+func patchHandler[T any](get getFunc[T], update updateFunc[T]) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, ok := pathID(c)
+		if !ok {
+			return
+		}
+		in, err := get(c.Request.Context(), id)
+		if err != nil {
+			responed.Error(c, err)
+			return
+		}
+		if p, ok := any(&in).(patchPreparer); ok {
+			p.PreparePatch()
+		}
+		if err := decodeStrict(c, &in); err != nil {
+			responed.Error(c, shared.BadRequest("invalid_json", "invalid_json", err.Error()))
+			return
+		}
+		out, err := update(c.Request.Context(), id, in)
+		if err != nil {
+			responed.Error(c, err)
+			return
+		}
+		c.JSON(http.StatusOK, out)
+	}
+}
+
+func deleteHandler(del deleteFunc) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, ok := pathID(c)
+		if !ok {
+			return
+		}
+		if err := del(c.Request.Context(), id); err != nil {
+			responed.Error(c, err)
+			return
+		}
+		c.Status(http.StatusNoContent)
+	}
+}
@@ -1,91 +1,261 @@
 package handlers
 
 import (
+	"context"
 	"net/http"
+	"strconv"
 
-	"moh/internal/services"
+	"moh/internal/repository"
 	"moh/models"
+	"moh/shared"
+	"moh/shared/responed"
 
 	"github.com/gin-gonic/gin"
-	"github.com/jackc/pgx/v5/pgxpool"
 )
 
-func AddDrugHandler(db *pgxpool.Pool) gin.HandlerFunc {
+// AddDrugHandler serves POST /drug. A brand name that looks or sounds like
+// a registered one at least threshold is refused with 409 similar_brand
+// unless the request has ?override_lasa=true; threshold 0 skips the check.
+func AddDrugHandler(drugs repository.Drugs, brands repository.Brands, threshold float64) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		var in models.Drug
 		if err := decodeStrict(c, &in); err != nil {
-			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid_json", "message": err.Error()})
+			responed.Error(c, shared.BadRequest("invalid_json", "invalid_json", err.Error()))
 			return
 		}
-		out, err := services.AddDrug(c.Request.Context(), db, in)
+		override := false
+		if v, ok := c.GetQuery("override_lasa"); ok {
+			b, err := strconv.ParseBool(v)
+			if err != nil {
+				responed.Error(c, shared.BadRequest("invalid_query", "query_parse", "override_lasa: "+err.Error()))
+				return
+			}
+			override = b
+		}
+		if err := brands.Check(c.Request.Context(), in, threshold, override); err != nil {
+			responed.Error(c, err)
+			return
+		}
+		out, err := drugs.Add(c.Request.Context(), in)
+		if err != nil {
+			responed.Error(c, err)
+			return
+		}
+		c.JSON(http.StatusCreated, out)
+	}
+}
+
+// AddDrugIngredientHandler serves POST /drug/ingredient, adding an active
+// ingredient to a drug such as the second API of a combination.
+func AddDrugIngredientHandler(ingredients repository.DrugIngredients) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var in models.DrugIngredient
+		if err := decodeStrict(c, &in); err != nil {
+			responed.Error(c, shared.BadRequest("invalid_json", "invalid_json", err.Error()))
+			return
+		}
+		out, err := ingredients.Add(c.Request.Context(), in)
 		if err != nil {
-			c.JSON(http.StatusBadRequest, gin.H{"error": "create_failed", "message": err.Error()})
+			responed.Error(c, err)
 			return
 		}
 		c.JSON(http.StatusCreated, out)
 	}
 }
 
-func AddBatchHandler(db *pgxpool.Pool) gin.HandlerFunc {
+// AddBatchHandler serves POST /batch. Under PriceCeilingReject a price above
+// the ceiling in force on the batch's mfg_date is refused with 409
+// price_above_ceiling; under PriceCeilingFlag the batch is stored and listed
+// by GET /drug-price/violations. PUT and PATCH /batch/:id do the same.
+func AddBatchHandler(batches repository.Batches, policy models.PriceCeilingPolicy) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		var in models.Batch
 		if err := decodeStrict(c, &in); err != nil {
-			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid_json", "message": err.Error()})
+			responed.Error(c, shared.BadRequest("invalid_json", "invalid_json", err.Error()))
 			return
 		}
-		out, err := services.AddBatch(c.Request.Context(), db, in)
+		out, err := batches.Add(c.Request.Context(), in, policy)
 		if err != nil {
-			c.JSON(http.StatusBadRequest, gin.H{"error": "create_failed", "message": err.Error()})
+			responed.Error(c, err)
 			return
 		}
 		c.JSON(http.StatusCreated, out)
 	}
 }
 
-func AddDrugRegistrationHandler(db *pgxpool.Pool) gin.HandlerFunc {
+func AddDrugRegistrationHandler(registrations repository.Registrations) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		var in models.DrugRegistration
 		if err := decodeStrict(c, &in); err != nil {
-			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid_json", "message": err.Error()})
+			responed.Error(c, shared.BadRequest("invalid_json", "invalid_json", err.Error()))
+			return
+		}
+		out, err := registrations.Add(c.Request.Context(), in)
+		if err != nil {
+			responed.Error(c, err)
+			return
+		}
+		c.JSON(http.StatusCreated, out)
+	}
+}
+
+// AddDrugRegistrationBundleHandler creates a registration with its site and
+// auth holder links in one transaction.
+func AddDrugRegistrationBundleHandler(registrations repository.Registrations) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var in models.DrugRegistrationBundle
+		if err := decodeStrict(c, &in); err != nil {
+			responed.Error(c, shared.BadRequest("invalid_json", "invalid_json", err.Error()))
 			return
 		}
-		out, err := services.AddDrugRegistration(c.Request.Context(), db, in)
+		out, err := registrations.CreateBundle(c.Request.Context(), in)
 		if err != nil {
-			c.JSON(http.StatusBadRequest, gin.H{"error": "create_failed", "message": err.Error()})
+			responed.Error(c, err)
 			return
 		}
 		c.JSON(http.StatusCreated, out)
 	}
 }
 
-func AddDrugRegistrationSiteHandler(db *pgxpool.Pool) gin.HandlerFunc {
+func AddDrugRegistrationSiteHandler(registrationSites repository.RegistrationSites) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		var in models.DrugRegistrationSite
 		if err := decodeStrict(c, &in); err != nil {
-			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid_json", "message": err.Error()})
+			responed.Error(c, shared.BadRequest("invalid_json", "invalid_json", err.Error()))
 			return
 		}
-		out, err := services.AddDrugRegistrationSite(c.Request.Context(), db, in)
+		out, err := registrationSites.Add(c.Request.Context(), in)
 		if err != nil {
-			c.JSON(http.StatusBadRequest, gin.H{"error": "create_failed", "message": err.Error()})
+			responed.Error(c, err)
 			return
 		}
 		c.JSON(http.StatusCreated, out)
 	}
 }
 
-func AddDrugRegistrationAuthHolderHandler(db *pgxpool.Pool) gin.HandlerFunc {
+func AddDrugRegistrationAuthHolderHandler(registrationAuthHolders repository.RegistrationAuthHolders) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		var in models.DrugRegistrationAuthHolder
 		if err := decodeStrict(c, &in); err != nil {
-			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid_json", "message": err.Error()})
+			responed.Error(c, shared.BadRequest("invalid_json", "invalid_json", err.Error()))
 			return
 		}
-		out, err := services.AddDrugRegistrationAuthHolder(c.Request.Context(), db, in)
+		out, err := registrationAuthHolders.Add(c.Request.Context(), in)
 		if err != nil {
-			c.JSON(http.StatusBadRequest, gin.H{"error": "create_failed", "message": err.Error()})
+			responed.Error(c, err)
 			return
 		}
 		c.JSON(http.StatusCreated, out)
 	}
 }
+
+// ===== Get / Update / Delete by id =====
+
+func GetDrugHandler(drugs repository.Drugs) gin.HandlerFunc {
+	return getByIDHandler(drugs.Get)
+}
+
+func UpdateDrugHandler(drugs repository.Drugs) gin.HandlerFunc {
+	return replaceHandler(drugs.Update)
+}
+
+func PatchDrugHandler(drugs repository.Drugs) gin.HandlerFunc {
+	return patchHandler(drugs.Get, drugs.Update)
+}
+
+func DeleteDrugHandler(drugs repository.Drugs) gin.HandlerFunc {
+	return deleteHandler(drugs.Delete)
+}
+
+// DrugEquivalentsHandler serves GET /drug/:id/equivalents: the marketed
+// drugs a pharmacist could substitute for this one.
+func DrugEquivalentsHandler(equivalents repository.Equivalents) gin.HandlerFunc {
+	return getByIDHandler(equivalents.Find)
+}
+
+func GetDrugIngredientHandler(ingredients repository.DrugIngredients) gin.HandlerFunc {
+	return getByIDHandler(ingredients.Get)
+}
+
+func UpdateDrugIngredientHandler(ingredients repository.DrugIngredients) gin.HandlerFunc {
+	return replaceHandler(ingredients.Update)
+}
+
+func PatchDrugIngredientHandler(ingredients repository.DrugIngredients) gin.HandlerFunc {
+	return patchHandler(ingredients.Get, ingredients.Update)
+}
+
+func DeleteDrugIngredientHandler(ingredients repository.DrugIngredients) gin.HandlerFunc {
+	return deleteHandler(ingredients.Delete)
+}
+
+func GetBatchHandler(batches repository.Batches) gin.HandlerFunc {
+	return getByIDHandler(batches.Get)
+}
+
+func UpdateBatchHandler(batches repository.Batches, policy models.PriceCeilingPolicy) gin.HandlerFunc {
+	return replaceHandler(updateBatch(batches, policy))
+}
+
+func PatchBatchHandler(batches repository.Batches, policy models.PriceCeilingPolicy) gin.HandlerFunc {
+	return patchHandler(batches.Get, updateBatch(batches, policy))
+}
+
+// updateBatch binds the price ceiling policy to batches.Update.
+func updateBatch(batches repository.Batches, policy models.PriceCeilingPolicy) func(context.Context, string, models.Batch) (models.Batch, error) {
+	return func(ctx context.Context, id string, in models.Batch) (models.Batch, error) {
+		return batches.Update(ctx, id, in, policy)
+	}
+}
+
+func DeleteBatchHandler(batches repository.Batches) gin.HandlerFunc {
+	return deleteHandler(batches.Delete)
+}
+
+func GetDrugRegistrationHandler(registrations repository.Registrations) gin.HandlerFunc {
+	return getByIDHandler(registrations.Get)
+}
+
+func UpdateDrugRegistrationHandler(registrations repository.Registrations) gin.HandlerFunc {
+	return replaceHandler(registrations.Update)
+}
+
+func PatchDrugRegistrationHandler(registrations repository.Registrations) gin.HandlerFunc {
+	return patchHandler(registrations.Get, registrations.Update)
+}
+
+func DeleteDrugRegistrationHandler(registrations repository.Registrations) gin.HandlerFunc {
+	return deleteHandler(registrations.Delete)
+}
+
+func GetDrugRegistrationSiteHandler(registrationSites repository.RegistrationSites) gin.HandlerFunc {
+	return getByIDHandler(registrationSites.Get)
+}
+
+func UpdateDrugRegistrationSiteHandler(registrationSites repository.RegistrationSites) gin.HandlerFunc {
+	return replaceHandler(registrationSites.Update)
+}
+
+func PatchDrugRegistrationSiteHandler(registrationSites repository.RegistrationSites) gin.HandlerFunc {
+	return patchHandler(registrationSites.Get, registrationSites.Update)
+}
+
+func DeleteDrugRegistrationSiteHandler(registrationSites repository.RegistrationSites) gin.HandlerFunc {
+	return deleteHandler(registrationSites.Delete)
+}
+
+func GetDrugRegistrationAuthHolderHandler(registrationAuthHolders repository.RegistrationAuthHolders) gin.HandlerFunc {
+	return getByIDHandler(registrationAuthHolders.Get)
+}
+
+func UpdateDrugRegistrationAuthHolderHandler(registrationAuthHolders repository.RegistrationAuthHolders) gin.HandlerFunc {
+	return replaceHandler(registrationAuthHolders.Update)
+}
+
+func PatchDrugRegistrationAuthHolderHandler(registrationAuthHolders repository.RegistrationAuthHolders) gin.HandlerFunc {
+	return patchHandler(registrationAuthHolders.Get, registrationAuthHolders.Update)
+}
+
+func DeleteDrugRegistrationAuthHolderHandler(registrationAuthHolders repository.RegistrationAuthHolders) gin.HandlerFunc {
+	return deleteHandler(registrationAuthHolders.Delete)
+}
@@ -0,0 +1,50 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+
+	"moh/models"
+	"moh/shared"
+	"moh/shared/responed"
+
+	"github.com/gin-gonic/gin"
+)
+
+// validatable is satisfied by pointers to the models.*Filter structs.
+type validatable[F any] interface {
+	*F
+	Validate() error
+}
+
+type listFunc[F, T any] func(ctx context.Context, f F, p models.ListParams) (models.Page[T], error)
+
+// listHandler binds ?limit&cursor&sort plus the typed filter F from the query
+// string and answers with a models.Page envelope.
+func listHandler[F any, PF validatable[F], T any](list listFunc[F, T]) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var p models.ListParams
+		var f F
+		if err := c.ShouldBindQuery(&p); err != nil {
+			responed.Error(c, shared.BadRequest("invalid_query", "query_parse", err.Error()))
+			return
+		}
+		if err := c.ShouldBindQuery(&f); err != nil {
+			responed.Error(c, shared.BadRequest("invalid_query", "query_parse", err.Error()))
+			return
+		}
+		for _, v := range []interface{ Validate() error }{&p, PF(&f)} {
+			if err := v.Validate(); err != nil {
+				responed.Error(c, models.QueryError(err))
+				return
+			}
+		}
+
+		page, err := list(c.Request.Context(), f, p)
+		if err != nil {
+			responed.Error(c, err)
+			return
+		}
+		c.JSON(http.StatusOK, page)
+	}
+}
@@ -0,0 +1,64 @@
+package handlers
+
+import (
+	"net/http"
+
+	"moh/internal/repository"
+	"moh/models"
+	"moh/shared"
+	"moh/shared/responed"
+
+	"github.com/gin-gonic/gin"
+)
+
+// AddPresentationHandler serves POST /drug/presentation: a pack of a drug
+// under its own GTIN.
+func AddPresentationHandler(presentations repository.Presentations) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var in models.Presentation
+		if err := decodeStrict(c, &in); err != nil {
+			responed.Error(c, shared.BadRequest("invalid_json", "invalid_json", err.Error()))
+			return
+		}
+		out, err := presentations.Add(c.Request.Context(), in)
+		if err != nil {
+			responed.Error(c, err)
+			return
+		}
+		c.JSON(http.StatusCreated, out)
+	}
+}
+
+func GetPresentationHandler(presentations repository.Presentations) gin.HandlerFunc {
+	return getByIDHandler(presentations.Get)
+}
+
+func UpdatePresentationHandler(presentations repository.Presentations) gin.HandlerFunc {
+	return replaceHandler(presentations.Update)
+}
+
+func PatchPresentationHandler(presentations repository.Presentations) gin.HandlerFunc {
+	return patchHandler(presentations.Get, presentations.Update)
+}
+
+func DeletePresentationHandler(presentations repository.Presentations) gin.HandlerFunc {
+	return deleteHandler(presentations.Delete)
+}
+
+func ListPresentationsHandler(presentations repository.Presentations) gin.HandlerFunc {
+	return listHandler(presentations.List)
+}
+
+// LookupGTINHandler serves GET /gtin/:gtin, what a scanned barcode is: the
+// presentation, its drug and the registration in force. GTIN-8, -12 and
+// -13 are accepted as well as GTIN-14.
+func LookupGTINHandler(presentations repository.Presentations) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		out, err := presentations.LookupGTIN(c.Request.Context(), c.Param("gtin"))
+		if err != nil {
+			responed.Error(c, err)
+			return
+		}
+		c.JSON(http.StatusOK, out)
+	}
+}
@@ -0,0 +1,59 @@
+package handlers
+
+import (
+	"net/http"
+
+	"moh/internal/repository"
+	"moh/models"
+	"moh/shared"
+	"moh/shared/responed"
+
+	"github.com/gin-gonic/gin"
+)
+
+// AddDrugPriceHandler serves POST /drug-price: a new ceiling price for a
+// drug (or one of its packs) from effective_from on.
+func AddDrugPriceHandler(prices repository.Prices) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var in models.DrugPrice
+		if err := decodeStrict(c, &in); err != nil {
+			responed.Error(c, shared.BadRequest("invalid_json", "invalid_json", err.Error()))
+			return
+		}
+		out, err := prices.Add(c.Request.Context(), in)
+		if err != nil {
+			responed.Error(c, err)
+			return
+		}
+		c.JSON(http.StatusCreated, out)
+	}
+}
+
+func GetDrugPriceHandler(prices repository.Prices) gin.HandlerFunc {
+	return getByIDHandler(prices.Get)
+}
+
+func UpdateDrugPriceHandler(prices repository.Prices) gin.HandlerFunc {
+	return replaceHandler(prices.Update)
+}
+
+func PatchDrugPriceHandler(prices repository.Prices) gin.HandlerFunc {
+	return patchHandler(prices.Get, prices.Update)
+}
+
+func DeleteDrugPriceHandler(prices repository.Prices) gin.HandlerFunc {
+	return deleteHandler(prices.Delete)
+}
+
+// ListDrugPricesHandler serves GET /drug-price, the price history: with
+// ?drug_id= and the default sort, a drug's ceilings in the order they took
+// effect.
+func ListDrugPricesHandler(prices repository.Prices) gin.HandlerFunc {
+	return listHandler(prices.List)
+}
+
+// ListPriceViolationsHandler serves GET /drug-price/violations: batches
+// priced above the ceiling in force on their mfg_date.
+func ListPriceViolationsHandler(prices repository.Prices) gin.HandlerFunc {
+	return listHandler(prices.Violations)
+}
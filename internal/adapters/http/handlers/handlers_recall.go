@@ -0,0 +1,83 @@
+package handlers
+
+import (
+	"net/http"
+
+	"moh/internal/repository"
+	"moh/models"
+	"moh/shared"
+	mw "moh/shared/middlewares"
+	"moh/shared/responed"
+
+	"github.com/gin-gonic/gin"
+)
+
+// OpenRecallHandler serves POST /recall. Every listed batch is moved to
+// recalled; one batch that cannot be recalled fails the whole request.
+func OpenRecallHandler(recalls repository.Recalls) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var in models.Recall
+		if err := decodeStrict(c, &in); err != nil {
+			responed.Error(c, shared.BadRequest("invalid_json", "invalid_json", err.Error()))
+			return
+		}
+		claims, _ := mw.ClaimsFrom(c)
+		out, err := recalls.Open(c.Request.Context(), in, claims.Subject)
+		if err != nil {
+			responed.Error(c, err)
+			return
+		}
+		c.JSON(http.StatusCreated, out)
+	}
+}
+
+// ExtendRecallHandler serves POST /recall/:id/extend.
+func ExtendRecallHandler(recalls repository.Recalls) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, ok := pathID(c)
+		if !ok {
+			return
+		}
+		var in models.RecallExtension
+		if err := decodeStrict(c, &in); err != nil {
+			responed.Error(c, shared.BadRequest("invalid_json", "invalid_json", err.Error()))
+			return
+		}
+		claims, _ := mw.ClaimsFrom(c)
+		out, err := recalls.Extend(c.Request.Context(), id, in, claims.Subject)
+		if err != nil {
+			responed.Error(c, err)
+			return
+		}
+		c.JSON(http.StatusOK, out)
+	}
+}
+
+// CloseRecallHandler serves POST /recall/:id/close.
+func CloseRecallHandler(recalls repository.Recalls) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, ok := pathID(c)
+		if !ok {
+			return
+		}
+		var in models.RecallClosure
+		if err := decodeStrict(c, &in); err != nil {
+			responed.Error(c, shared.BadRequest("invalid_json", "invalid_json", err.Error()))
+			return
+		}
+		out, err := recalls.Close(c.Request.Context(), id, in)
+		if err != nil {
+			responed.Error(c, err)
+			return
+		}
+		c.JSON(http.StatusOK, out)
+	}
+}
+
+func GetRecallHandler(recalls repository.Recalls) gin.HandlerFunc {
+	return getByIDHandler(recalls.Get)
+}
+
+func ListRecallsHandler(recalls repository.Recalls) gin.HandlerFunc {
+	return listHandler(recalls.List)
+}
@@ -5,55 +5,106 @@ import (
 	"net/http"
 
 	"github.com/gin-gonic/gin"
-	"github.com/jackc/pgx/v5/pgxpool"
-	"moh/internal/services"
+	"moh/internal/repository"
 	"moh/models"
+	"moh/shared"
+	"moh/shared/responed"
 )
 
-func AddAuthHolderHandler(db *pgxpool.Pool) gin.HandlerFunc {
+func AddAuthHolderHandler(authHolders repository.AuthHolders) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		var in models.AuthHolder
 		if err := decodeStrict(c, &in); err != nil {
-			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid_json", "message": err.Error()})
+			responed.Error(c, shared.BadRequest("invalid_json", "invalid_json", err.Error()))
 			return
 		}
-		out, err := services.AddAuthHolder(c.Request.Context(), db, in)
+		out, err := authHolders.Add(c.Request.Context(), in)
 		if err != nil {
-			c.JSON(http.StatusBadRequest, gin.H{"error": "create_failed", "message": err.Error()})
+			responed.Error(c, err)
 			return
 		}
 		c.JSON(http.StatusCreated, out)
 	}
 }
 
-func AddMarketingAuthorizationHandler(db *pgxpool.Pool) gin.HandlerFunc {
+func AddMarketingAuthorizationHandler(marketingAuthorizations repository.MarketingAuthorizations) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		var in models.MarketingAuthorization
 		if err := decodeStrict(c, &in); err != nil {
-			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid_json", "message": err.Error()})
+			responed.Error(c, shared.BadRequest("invalid_json", "invalid_json", err.Error()))
 			return
 		}
-		out, err := services.AddMarketingAuthorization(c.Request.Context(), db, in)
+		out, err := marketingAuthorizations.Add(c.Request.Context(), in)
 		if err != nil {
-			c.JSON(http.StatusBadRequest, gin.H{"error": "create_failed", "message": err.Error()})
+			responed.Error(c, err)
 			return
 		}
 		c.JSON(http.StatusCreated, out)
 	}
 }
 
-func AddManufacturingSiteHandler(db *pgxpool.Pool) gin.HandlerFunc {
+func AddManufacturingSiteHandler(manufacturingSites repository.ManufacturingSites) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		var in models.ManufacturingSite
 		if err := decodeStrict(c, &in); err != nil {
-			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid_json", "message": err.Error()})
+			responed.Error(c, shared.BadRequest("invalid_json", "invalid_json", err.Error()))
 			return
 		}
-		out, err := services.AddManufacturingSite(c.Request.Context(), db, in)
+		out, err := manufacturingSites.Add(c.Request.Context(), in)
 		if err != nil {
-			c.JSON(http.StatusBadRequest, gin.H{"error": "create_failed", "message": err.Error()})
+			responed.Error(c, err)
 			return
 		}
 		c.JSON(http.StatusCreated, out)
 	}
 }
+
+// ===== Get / Update / Delete by id =====
+
+func GetAuthHolderHandler(authHolders repository.AuthHolders) gin.HandlerFunc {
+	return getByIDHandler(authHolders.Get)
+}
+
+func UpdateAuthHolderHandler(authHolders repository.AuthHolders) gin.HandlerFunc {
+	return replaceHandler(authHolders.Update)
+}
+
+func PatchAuthHolderHandler(authHolders repository.AuthHolders) gin.HandlerFunc {
+	return patchHandler(authHolders.Get, authHolders.Update)
+}
+
+func DeleteAuthHolderHandler(authHolders repository.AuthHolders) gin.HandlerFunc {
+	return deleteHandler(authHolders.Delete)
+}
+
+func GetMarketingAuthorizationHandler(marketingAuthorizations repository.MarketingAuthorizations) gin.HandlerFunc {
+	return getByIDHandler(marketingAuthorizations.Get)
+}
+
+func UpdateMarketingAuthorizationHandler(marketingAuthorizations repository.MarketingAuthorizations) gin.HandlerFunc {
+	return replaceHandler(marketingAuthorizations.Update)
+}
+
+func PatchMarketingAuthorizationHandler(marketingAuthorizations repository.MarketingAuthorizations) gin.HandlerFunc {
+	return patchHandler(marketingAuthorizations.Get, marketingAuthorizations.Update)
+}
+
+func DeleteMarketingAuthorizationHandler(marketingAuthorizations repository.MarketingAuthorizations) gin.HandlerFunc {
+	return deleteHandler(marketingAuthorizations.Delete)
+}
+
+func GetManufacturingSiteHandler(manufacturingSites repository.ManufacturingSites) gin.HandlerFunc {
+	return getByIDHandler(manufacturingSites.Get)
+}
+
+func UpdateManufacturingSiteHandler(manufacturingSites repository.ManufacturingSites) gin.HandlerFunc {
+	return replaceHandler(manufacturingSites.Update)
+}
+
+func PatchManufacturingSiteHandler(manufacturingSites repository.ManufacturingSites) gin.HandlerFunc {
+	return patchHandler(manufacturingSites.Get, manufacturingSites.Update)
+}
+
+func DeleteManufacturingSiteHandler(manufacturingSites repository.ManufacturingSites) gin.HandlerFunc {
+	return deleteHandler(manufacturingSites.Delete)
+}
@@ -0,0 +1,43 @@
+package handlers
+
+import (
+	"net/http"
+	"path"
+
+	"moh/internal/repository"
+	"moh/models"
+	"moh/shared"
+	"moh/shared/responed"
+
+	"github.com/gin-gonic/gin"
+)
+
+// searchResources maps a hit type to the item route it links to.
+var searchResources = map[models.SearchType]string{
+	models.SearchDrug:         "drug",
+	models.SearchAPI:          "inn",
+	models.SearchAuthHolder:   "auth-holder",
+	models.SearchRegistration: "drug-registration",
+}
+
+// SearchHandler serves GET /search?q=&type=&limit=. Each hit links to its
+// entity next to /search, e.g. /manufacturer/drug/:id.
+func SearchHandler(search repository.Searcher) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var p models.SearchParams
+		if err := c.ShouldBindQuery(&p); err != nil {
+			responed.Error(c, shared.BadRequest("invalid_query", "query_parse", err.Error()))
+			return
+		}
+		out, err := search.Search(c.Request.Context(), p)
+		if err != nil {
+			responed.Error(c, err)
+			return
+		}
+		base := path.Dir(c.Request.URL.Path)
+		for i, h := range out.Hits {
+			out.Hits[i].Href = path.Join(base, searchResources[h.Type], h.ID)
+		}
+		c.JSON(http.StatusOK, out)
+	}
+}
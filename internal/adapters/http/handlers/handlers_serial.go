@@ -0,0 +1,83 @@
+package handlers
+
+import (
+	"net/http"
+
+	"moh/internal/repository"
+	"moh/models"
+	"moh/shared"
+	mw "moh/shared/middlewares"
+	"moh/shared/responed"
+
+	"github.com/gin-gonic/gin"
+)
+
+// CommissionSerialsHandler serves POST /batch/:id/serials, the bulk
+// upload of a batch's serial numbers. One serial already registered under
+// the batch's GTIN fails the whole upload.
+func CommissionSerialsHandler(serials repository.Serials) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, ok := pathID(c)
+		if !ok {
+			return
+		}
+		var in models.SerialUpload
+		if err := decodeStrict(c, &in); err != nil {
+			responed.Error(c, shared.BadRequest("invalid_json", "invalid_json", err.Error()))
+			return
+		}
+		claims, _ := mw.ClaimsFrom(c)
+		out, err := serials.Commission(c.Request.Context(), id, in, claims.Subject)
+		if err != nil {
+			responed.Error(c, err)
+			return
+		}
+		c.JSON(http.StatusCreated, out)
+	}
+}
+
+// RecordSerialEventHandler serves POST /serial/event. An event out of
+// sequence is still recorded, with its anomaly, and answered 201.
+func RecordSerialEventHandler(serials repository.Serials) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var in models.SerialEvent
+		if err := decodeStrict(c, &in); err != nil {
+			responed.Error(c, shared.BadRequest("invalid_json", "invalid_json", err.Error()))
+			return
+		}
+		claims, _ := mw.ClaimsFrom(c)
+		out, err := serials.Record(c.Request.Context(), in, claims.Subject)
+		if err != nil {
+			responed.Error(c, err)
+			return
+		}
+		c.JSON(http.StatusCreated, out)
+	}
+}
+
+// SerialCustodyHandler serves GET /serial/custody?gtin=&serial=.
+func SerialCustodyHandler(serials repository.Serials) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var key models.SerialKey
+		if err := c.ShouldBindQuery(&key); err != nil {
+			responed.Error(c, shared.BadRequest("invalid_query", "query_parse", err.Error()))
+			return
+		}
+		out, err := serials.Custody(c.Request.Context(), key)
+		if err != nil {
+			responed.Error(c, err)
+			return
+		}
+		c.JSON(http.StatusOK, out)
+	}
+}
+
+func ListSerialsHandler(serials repository.Serials) gin.HandlerFunc {
+	return listHandler(serials.List)
+}
+
+// ListSerialClonesHandler serves GET /serial/clones, the serials dispensed
+// more than once.
+func ListSerialClonesHandler(serials repository.Serials) gin.HandlerFunc {
+	return listHandler(serials.Clones)
+}
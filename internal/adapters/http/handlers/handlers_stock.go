@@ -0,0 +1,52 @@
+package handlers
+
+import (
+	"net/http"
+
+	"moh/internal/repository"
+	"moh/models"
+	"moh/shared"
+	mw "moh/shared/middlewares"
+	"moh/shared/responed"
+
+	"github.com/gin-gonic/gin"
+)
+
+// RecordStockMovementHandler serves POST /batch/:id/stock. A movement the
+// balance cannot cover is a 409 and leaves the ledger untouched.
+func RecordStockMovementHandler(batches repository.Batches) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, ok := pathID(c)
+		if !ok {
+			return
+		}
+		var in models.StockMovement
+		if err := decodeStrict(c, &in); err != nil {
+			responed.Error(c, shared.BadRequest("invalid_json", "invalid_json", err.Error()))
+			return
+		}
+		claims, _ := mw.ClaimsFrom(c)
+		out, err := batches.Move(c.Request.Context(), id, in, claims.Subject)
+		if err != nil {
+			responed.Error(c, err)
+			return
+		}
+		c.JSON(http.StatusCreated, out)
+	}
+}
+
+// StockLedgerHandler serves GET /batch/:id/stock.
+func StockLedgerHandler(batches repository.Batches) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, ok := pathID(c)
+		if !ok {
+			return
+		}
+		out, err := batches.Stock(c.Request.Context(), id)
+		if err != nil {
+			responed.Error(c, err)
+			return
+		}
+		c.JSON(http.StatusOK, out)
+	}
+}
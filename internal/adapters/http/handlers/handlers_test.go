@@ -0,0 +1,1453 @@
+package handlers_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"moh/internal/adapters/http/router"
+	"moh/internal/repository"
+	"moh/models"
+	"moh/shared"
+	"moh/shared/config"
+	mw "moh/shared/middlewares"
+	"moh/shared/responed"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/google/uuid"
+)
+
+var testSecret = []byte("handlers-test-secret-0123456789abcdef")
+
+// testAPI is the manufacturer router on an empty in-memory store.
+type testAPI struct {
+	t *testing.T
+	h http.Handler
+}
+
+func newTestAPI(t *testing.T) *testAPI {
+	t.Helper()
+	return newStoreAPI(t, repository.NewMemory())
+}
+
+// newStoreAPI is the manufacturer router on store.
+func newStoreAPI(t *testing.T, store *repository.Store) *testAPI {
+	t.Helper()
+	return newOptionsAPI(t, store, router.Options{BrandSimilarityThreshold: config.Defaults().Safety.BrandSimilarityThreshold})
+}
+
+// newOptionsAPI is the manufacturer router on store with opts.
+func newOptionsAPI(t *testing.T, store *repository.Store, opts router.Options) *testAPI {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+	keys, err := mw.NewKeySet(mw.JWTConfig{Keys: []mw.JWTKey{{Alg: "HS256", Secret: testSecret}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := gin.New()
+	router.ManufacturerRouter(r, store, keys, opts)
+	return &testAPI{t: t, h: r}
+}
+
+// newGlobalAPI is newTestAPI behind the middleware cmd/http mounts on every
+// route.
+func newGlobalAPI(t *testing.T) *testAPI {
+	t.Helper()
+	a := newTestAPI(t)
+	r := gin.New()
+	r.Use(mw.Global(nil)...)
+	r.Any("/*path", gin.WrapH(a.h))
+	a.h = r
+	return a
+}
+
+func token(t *testing.T, role mw.Role) string {
+	t.Helper()
+	s, err := jwt.NewWithClaims(jwt.SigningMethodHS256, &mw.Claims{
+		Roles: []mw.Role{role},
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   "test-" + string(role),
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+		},
+	}).SignedString(testSecret)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+// do sends body as JSON on behalf of role; an empty role sends no token.
+func (a *testAPI) do(role mw.Role, method, path string, body any) *httptest.ResponseRecorder {
+	a.t.Helper()
+	return a.doLang("", role, method, path, body)
+}
+
+// doLang is do with an Accept-Language header, unless lang is empty.
+func (a *testAPI) doLang(lang string, role mw.Role, method, path string, body any) *httptest.ResponseRecorder {
+	a.t.Helper()
+	var buf bytes.Buffer
+	if body != nil {
+		if err := json.NewEncoder(&buf).Encode(body); err != nil {
+			a.t.Fatal(err)
+		}
+	}
+	req := httptest.NewRequest(method, "/manufacturer"+path, &buf)
+	req.Header.Set("Content-Type", "application/json")
+	if role != "" {
+		req.Header.Set("Authorization", "Bearer "+token(a.t, role))
+	}
+	if lang != "" {
+		req.Header.Set("Accept-Language", lang)
+	}
+	rec := httptest.NewRecorder()
+	a.h.ServeHTTP(rec, req)
+	return rec
+}
+
+// must sends a request as registry_admin and decodes the response into out,
+// failing unless it has status want.
+func (a *testAPI) must(want int, method, path string, body, out any) {
+	a.t.Helper()
+	rec := a.do(mw.RoleRegistryAdmin, method, path, body)
+	expect(a.t, rec, want, "")
+	if out != nil {
+		if err := json.Unmarshal(rec.Body.Bytes(), out); err != nil {
+			a.t.Fatalf("%s %s: decode: %v", method, path, err)
+		}
+	}
+}
+
+// expect checks the status and, if code is not empty, that the body is a
+// problem with that code.
+func expect(t *testing.T, rec *httptest.ResponseRecorder, status int, code string) {
+	t.Helper()
+	if rec.Code != status {
+		t.Fatalf("status = %d, want %d; body: %s", rec.Code, status, rec.Body)
+	}
+	if code == "" {
+		return
+	}
+	if ct := rec.Header().Get("Content-Type"); ct != responed.ContentType {
+		t.Fatalf("content type = %q, want %q", ct, responed.ContentType)
+	}
+	if p := problem(t, rec); p.Code != code || p.Status != status {
+		t.Fatalf("problem = %+v, want code %q and status %d", p, code, status)
+	}
+}
+
+func problem(t *testing.T, rec *httptest.ResponseRecorder) responed.Problem {
+	t.Helper()
+	var p responed.Problem
+	if err := json.Unmarshal(rec.Body.Bytes(), &p); err != nil {
+		t.Fatal(err)
+	}
+	return p
+}
+
+// seedDrug creates a drug with its catalog entries and returns its id.
+func (a *testAPI) seedDrug(brand string) string {
+	a.t.Helper()
+	var (
+		form  models.DosageForm
+		route models.RouteOfAdmin
+		unit  models.StrengthUnit
+		api   models.API
+	)
+	a.must(http.StatusCreated, "POST", "/dosage", models.DosageForm{Code: "TAB-" + brand, Name: "Tablet " + brand}, &form)
+	a.must(http.StatusCreated, "POST", "/route", models.RouteOfAdmin{Code: "PO-" + brand, Name: "Oral " + brand}, &route)
+	a.must(http.StatusCreated, "POST", "/strength", models.StrengthUnit{Code: "MG-" + brand, Name: "Milligram " + brand}, &unit)
+	a.must(http.StatusCreated, "POST", "/inn", models.API{Name: "Paracetamol " + brand}, &api)
+	var drug models.Drug
+	a.must(http.StatusCreated, "POST", "/drug", models.Drug{
+		BrandName:      brand,
+		DosageFormID:   form.ID,
+		RouteID:        route.ID,
+		StrengthUnitID: unit.ID,
+		Dose:           models.NewDecimal(500, 0),
+		APIID:          api.ID,
+	}, &drug)
+	return drug.ID
+}
+
+func (a *testAPI) seedBatch(drugID, number string) models.Batch {
+	a.t.Helper()
+	mfg := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	var b models.Batch
+	a.must(http.StatusCreated, "POST", "/batch", models.Batch{
+		DrugID:      drugID,
+		BatchNumber: number,
+		MfgDate:     mfg,
+		ExpireDate:  mfg.AddDate(2, 0, 0),
+		QtyInBatch:  1000,
+	}, &b)
+	return b
+}
+
+func TestAuth(t *testing.T) {
+	a := newTestAPI(t)
+
+	expect(t, a.do("", "GET", "/dosage", nil), http.StatusUnauthorized, "unauthorized")
+	expect(t, a.do(mw.RoleReadOnly, "GET", "/dosage", nil), http.StatusOK, "")
+	expect(t, a.do(mw.RoleReadOnly, "POST", "/dosage", models.DosageForm{Code: "TAB", Name: "Tablet"}), http.StatusForbidden, "forbidden")
+	expect(t, a.do(mw.RoleManufacturer, "POST", "/dosage", models.DosageForm{Code: "TAB", Name: "Tablet"}), http.StatusForbidden, "forbidden")
+
+	req := httptest.NewRequest("GET", "/manufacturer/ping", nil)
+	rec := httptest.NewRecorder()
+	a.h.ServeHTTP(rec, req)
+	expect(t, rec, http.StatusOK, "")
+}
+
+func TestCatalogCRUD(t *testing.T) {
+	a := newTestAPI(t)
+
+	var form models.DosageForm
+	a.must(http.StatusCreated, "POST", "/dosage", models.DosageForm{Code: " tab ", Name: " Tablet "}, &form)
+	if form.ID == "" || form.Code != "TAB" || form.Name != "Tablet" {
+		t.Fatalf("created %+v, want a normalised row with an id", form)
+	}
+
+	rec := a.do(mw.RoleRegistryAdmin, "POST", "/dosage", models.DosageForm{Code: "tab", Name: "Other"})
+	expect(t, rec, http.StatusConflict, "already_exists")
+	if p := problem(t, rec); p.Detail != "code already exists" {
+		t.Fatalf("duplicate detail = %q", p.Detail)
+	}
+	expect(t, a.do(mw.RoleRegistryAdmin, "POST", "/dosage", models.DosageForm{Code: "CAP"}), http.StatusUnprocessableEntity, "validation_failed")
+	expect(t, a.do(mw.RoleRegistryAdmin, "POST", "/dosage", map[string]any{"code": "CAP", "name": "Capsule", "colour": "red"}), http.StatusBadRequest, "invalid_json")
+
+	var got models.DosageForm
+	a.must(http.StatusOK, "GET", "/dosage/"+form.ID, nil, &got)
+	if got.Code != "TAB" {
+		t.Fatalf("get = %+v", got)
+	}
+
+	a.must(http.StatusOK, "PATCH", "/dosage/"+form.ID, map[string]any{"name": "Film-coated tablet"}, &got)
+	if got.Code != "TAB" || got.Name != "Film-coated tablet" {
+		t.Fatalf("patch = %+v", got)
+	}
+	a.must(http.StatusOK, "PUT", "/dosage/"+form.ID, models.DosageForm{Code: "FCT", Name: "Film-coated tablet"}, &got)
+	if got.Code != "FCT" || got.CreatedAt == nil || !got.CreatedAt.Equal(*form.CreatedAt) {
+		t.Fatalf("put = %+v, want code FCT and the original created_at", got)
+	}
+
+	expect(t, a.do(mw.RoleReadOnly, "GET", "/dosage/not-a-uuid", nil), http.StatusBadRequest, "invalid_id")
+	expect(t, a.do(mw.RoleReadOnly, "GET", "/dosage/"+uuid.NewString(), nil), http.StatusNotFound, "not_found")
+	expect(t, a.do(mw.RoleRegistryAdmin, "PUT", "/dosage/"+uuid.NewString(), models.DosageForm{Code: "X", Name: "X"}), http.StatusNotFound, "not_found")
+
+	a.must(http.StatusNoContent, "DELETE", "/dosage/"+form.ID, nil, nil)
+	expect(t, a.do(mw.RoleRegistryAdmin, "DELETE", "/dosage/"+form.ID, nil), http.StatusNotFound, "not_found")
+}
+
+func TestValidationReportsEveryField(t *testing.T) {
+	a := newTestAPI(t)
+	mfg := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	rec := a.do(mw.RoleManufacturer, "POST", "/batch", models.Batch{
+		DrugID:     "not-a-uuid",
+		MfgDate:    mfg,
+		ExpireDate: mfg.AddDate(0, 0, -1),
+		QtyInBatch: -5,
+	})
+	expect(t, rec, http.StatusUnprocessableEntity, "validation_failed")
+	got := map[string]shared.FieldError{}
+	for _, fe := range problem(t, rec).Errors {
+		got[fe.Field] = fe
+	}
+	want := []shared.FieldError{
+		{Field: "drug_id", Rule: "uuid4", Message: "drug_id must be a valid UUID"},
+		{Field: "batch_number", Rule: "required", Message: "batch_number is required"},
+		{Field: "qty_in_batch", Rule: "gte", Param: "0", Message: "qty_in_batch must be greater than or equal to 0"},
+		{Field: "expire_date", Rule: "gt_mfg", Param: "mfg_date", Message: "expire_date must be after mfg_date"},
+	}
+	for _, w := range want {
+		if !reflect.DeepEqual(got[w.Field], w) {
+			t.Errorf("%s: got %+v, want %+v", w.Field, got[w.Field], w)
+		}
+	}
+	if len(got) != len(want) {
+		t.Errorf("errors = %+v, want %d fields", got, len(want))
+	}
+
+	// Updates report the same way, and so do bundle links by position.
+	var form models.DosageForm
+	a.must(http.StatusCreated, "POST", "/dosage", models.DosageForm{Code: "TAB", Name: "Tablet"}, &form)
+	rec = a.do(mw.RoleRegistryAdmin, "PUT", "/dosage/"+form.ID, models.DosageForm{Code: strings.Repeat("X", 33)})
+	expect(t, rec, http.StatusUnprocessableEntity, "validation_failed")
+	if errs := problem(t, rec).Errors; len(errs) != 2 || errs[0].Field != "code" || errs[0].Rule != "max" || errs[1].Field != "names" || errs[1].Rule != "default_locale" {
+		t.Fatalf("errors = %+v", errs)
+	}
+	rec = a.do(mw.RoleRegistryAdmin, "POST", "/drug-registration/bundle", models.DrugRegistrationBundle{
+		Registration: models.DrugRegistration{DrugID: uuid.NewString(), MAID: uuid.NewString(), Status: models.RegistrationActive},
+		Sites:        []models.DrugRegistrationSite{{SiteID: uuid.NewString()}, {DrugRegistrationID: uuid.NewString(), SiteID: uuid.NewString()}},
+	})
+	expect(t, rec, http.StatusUnprocessableEntity, "validation_failed")
+	if p := problem(t, rec); p.Field != "sites[1].drug_registration_id" || p.Errors[0].Rule != "excluded" {
+		t.Fatalf("problem = %+v", p)
+	}
+}
+
+func TestForeignKeys(t *testing.T) {
+	a := newTestAPI(t)
+	drugID := a.seedDrug("Panadol")
+
+	var drug models.Drug
+	a.must(http.StatusOK, "GET", "/drug/"+drugID, nil, &drug)
+
+	// A drug pointing at a missing dosage form is rejected.
+	bad := drug
+	bad.ID, bad.BrandName, bad.BrandNames, bad.DosageFormID = "", "Other", nil, uuid.NewString()
+	rec := a.do(mw.RoleManufacturer, "POST", "/drug", bad)
+	expect(t, rec, http.StatusUnprocessableEntity, "invalid_reference")
+	if p := problem(t, rec); p.Field != "dosage_form_id" || p.Detail != "invalid foreign key: dosage_form_id" {
+		t.Fatalf("problem = %+v", p)
+	}
+
+	// The dosage form of a drug cannot be deleted while the drug exists.
+	rec = a.do(mw.RoleRegistryAdmin, "DELETE", "/dosage/"+drug.DosageFormID, nil)
+	expect(t, rec, http.StatusConflict, "in_use")
+	if p := problem(t, rec); p.Detail != "still referenced by other records: referenced by drugs" {
+		t.Fatalf("detail = %q", p.Detail)
+	}
+
+	a.must(http.StatusNoContent, "DELETE", "/drug/"+drugID, nil, nil)
+	a.must(http.StatusNoContent, "DELETE", "/dosage/"+drug.DosageFormID, nil, nil)
+}
+
+func TestListPagination(t *testing.T) {
+	a := newTestAPI(t)
+	for _, code := range []string{"E", "C", "A", "D", "B"} {
+		a.must(http.StatusCreated, "POST", "/route", models.RouteOfAdmin{Code: code, Name: "Route " + code}, nil)
+	}
+
+	var codes []string
+	q := url.Values{"sort": {"code"}, "limit": {"2"}}
+	for pages := 0; ; pages++ {
+		if pages > 3 {
+			t.Fatal("cursor never ran out")
+		}
+		var page models.Page[models.RouteOfAdmin]
+		a.must(http.StatusOK, "GET", "/route?"+q.Encode(), nil, &page)
+		if page.Total != 5 || page.Limit != 2 {
+			t.Fatalf("page total=%d limit=%d", page.Total, page.Limit)
+		}
+		for _, r := range page.Items {
+			codes = append(codes, r.Code)
+		}
+		if page.NextCursor == "" {
+			break
+		}
+		q.Set("cursor", page.NextCursor)
+	}
+	if got := len(codes); got != 5 || codes[0] != "A" || codes[4] != "E" {
+		t.Fatalf("codes = %v, want A..E", codes)
+	}
+
+	var page models.Page[models.RouteOfAdmin]
+	a.must(http.StatusOK, "GET", "/route?sort=-code&limit=1", nil, &page)
+	expect(t, a.do(mw.RoleReadOnly, "GET", "/route?sort=code&cursor="+page.NextCursor, nil), http.StatusBadRequest, "invalid_query")
+	expect(t, a.do(mw.RoleReadOnly, "GET", "/route?sort=colour", nil), http.StatusBadRequest, "invalid_query")
+	expect(t, a.do(mw.RoleReadOnly, "GET", "/route?cursor=garbage", nil), http.StatusBadRequest, "invalid_query")
+
+	a.must(http.StatusOK, "GET", "/route?q=route+c", nil, &page)
+	if page.Total != 1 || page.Items[0].Code != "C" {
+		t.Fatalf("filtered page = %+v", page)
+	}
+}
+
+func TestBatchTransitions(t *testing.T) {
+	a := newTestAPI(t)
+	b := a.seedBatch(a.seedDrug("Panadol"), "B-001")
+	if b.Status != models.BatchPlanned {
+		t.Fatalf("new batch status = %s", b.Status)
+	}
+
+	rec := a.do(mw.RoleManufacturer, "POST", "/batch", models.Batch{DrugID: b.DrugID, BatchNumber: "B-001", MfgDate: b.MfgDate, ExpireDate: b.ExpireDate})
+	expect(t, rec, http.StatusConflict, "already_exists")
+	if p := problem(t, rec); p.Detail != "batch already exists" {
+		t.Fatalf("detail = %q", p.Detail)
+	}
+
+	// Status changes only through the transition endpoints.
+	b.Status = models.BatchReleased
+	expect(t, a.do(mw.RoleManufacturer, "PUT", "/batch/"+b.ID, b), http.StatusConflict, "illegal_transition")
+
+	expect(t, a.do(mw.RoleManufacturer, "POST", "/batch/"+b.ID+"/mark-sold-out", nil), http.StatusConflict, "illegal_transition")
+	expect(t, a.do(mw.RoleManufacturer, "POST", "/batch/"+b.ID+"/hold", map[string]string{}), http.StatusUnprocessableEntity, "validation_failed")
+	expect(t, a.do(mw.RoleManufacturer, "POST", "/batch/"+b.ID+"/expire", nil), http.StatusForbidden, "forbidden")
+
+	var out models.Batch
+	rec = a.do(mw.RoleManufacturer, "POST", "/batch/"+b.ID+"/release", nil)
+	expect(t, rec, http.StatusOK, "")
+	if err := json.Unmarshal(rec.Body.Bytes(), &out); err != nil || out.Status != models.BatchReleased {
+		t.Fatalf("release = %s", rec.Body)
+	}
+	expect(t, a.do(mw.RoleInspector, "POST", "/batch/"+b.ID+"/hold", models.BatchTransition{Reason: "deviation"}), http.StatusOK, "")
+
+	var history struct {
+		Items []models.BatchStatusChange `json:"items"`
+	}
+	a.must(http.StatusOK, "GET", "/batch/"+b.ID+"/history", nil, &history)
+	if len(history.Items) != 2 {
+		t.Fatalf("history = %+v", history.Items)
+	}
+	last := history.Items[1]
+	if last.FromStatus != models.BatchReleased || last.ToStatus != models.BatchOnHold || last.Reason != "deviation" || last.ChangedBy != "test-inspector" {
+		t.Fatalf("last change = %+v", last)
+	}
+	expect(t, a.do(mw.RoleReadOnly, "GET", "/batch/"+uuid.NewString()+"/history", nil), http.StatusNotFound, "not_found")
+
+	// A batch whose stock is not on the ledger is marked sold out by hand,
+	// with nothing left, and so cannot be released again.
+	other := a.seedBatch(b.DrugID, "B-002")
+	a.must(http.StatusOK, "POST", "/batch/"+other.ID+"/release", nil, nil)
+	a.must(http.StatusOK, "POST", "/batch/"+other.ID+"/mark-sold-out", nil, &out)
+	if out.Status != models.BatchSoldOut || out.QtyRemaining != 0 {
+		t.Fatalf("mark-sold-out = %+v", out)
+	}
+	expect(t, a.do(mw.RoleManufacturer, "POST", "/batch/"+other.ID+"/release", nil), http.StatusConflict, "illegal_transition")
+	expect(t, a.do(mw.RoleManufacturer, "POST", "/batch/"+uuid.NewString()+"/mark-sold-out", nil), http.StatusNotFound, "not_found")
+}
+
+func TestRegistrationBundleRollsBack(t *testing.T) {
+	a := newTestAPI(t)
+	drugID := a.seedDrug("Panadol")
+	var ma models.MarketingAuthorization
+	a.must(http.StatusCreated, "POST", "/marketing-authorization", models.MarketingAuthorization{Name: "MA", Country: "jo"}, &ma)
+	var site models.ManufacturingSite
+	a.must(http.StatusCreated, "POST", "/manufacturing-site", models.ManufacturingSite{Name: "Plant", Country: "jo"}, &site)
+
+	bundle := models.DrugRegistrationBundle{
+		Registration: models.DrugRegistration{DrugID: drugID, MAID: ma.ID, RegistrationNumber: "REG-1", Status: models.RegistrationActive},
+		Sites:        []models.DrugRegistrationSite{{SiteID: site.ID}, {SiteID: uuid.NewString()}},
+	}
+	rec := a.do(mw.RoleRegistryAdmin, "POST", "/drug-registration/bundle", bundle)
+	expect(t, rec, http.StatusUnprocessableEntity, "invalid_reference")
+	if p := problem(t, rec); p.Field != "sites[1].site_id" || p.Detail != "sites[1]: invalid foreign key: site_id" {
+		t.Fatalf("problem = %+v", p)
+	}
+	var regs models.Page[models.DrugRegistration]
+	a.must(http.StatusOK, "GET", "/registration", nil, &regs)
+	if regs.Total != 0 {
+		t.Fatalf("registrations after failed bundle = %+v", regs.Items)
+	}
+	var links models.Page[models.DrugRegistrationSite]
+	a.must(http.StatusOK, "GET", "/registration/site", nil, &links)
+	if links.Total != 0 {
+		t.Fatalf("site links after failed bundle = %+v", links.Items)
+	}
+
+	bundle.Sites = bundle.Sites[:1]
+	var out models.DrugRegistrationBundle
+	a.must(http.StatusCreated, "POST", "/drug-registration/bundle", bundle, &out)
+	if out.Registration.ID == "" || len(out.Sites) != 1 || out.Sites[0].DrugRegistrationID != out.Registration.ID {
+		t.Fatalf("bundle = %+v", out)
+	}
+	expect(t, a.do(mw.RoleRegistryAdmin, "DELETE", "/drug-registration/"+out.Registration.ID, nil), http.StatusConflict, "in_use")
+}
+
+func TestRecall(t *testing.T) {
+	a := newTestAPI(t)
+	drugID := a.seedDrug("Panadol")
+	planned := a.seedBatch(drugID, "B-001")
+	released := a.seedBatch(drugID, "B-002")
+	a.must(http.StatusOK, "POST", "/batch/"+released.ID+"/release", nil, nil)
+
+	recall := models.Recall{
+		Class:       models.RecallClassII,
+		Level:       models.RecallRetail,
+		ReasonCodes: []string{"contamination"},
+		Reason:      "particulates",
+		InitiatedBy: models.RecallByRegulator,
+		BatchIDs:    []string{released.ID, planned.ID},
+	}
+	expect(t, a.do(mw.RoleManufacturer, "POST", "/recall", recall), http.StatusForbidden, "forbidden")
+	// A planned batch cannot be recalled, and nothing changes.
+	expect(t, a.do(mw.RoleInspector, "POST", "/recall", recall), http.StatusConflict, "illegal_transition")
+	var b models.Batch
+	a.must(http.StatusOK, "GET", "/batch/"+released.ID, nil, &b)
+	if b.Status != models.BatchReleased {
+		t.Fatalf("status after failed recall = %s", b.Status)
+	}
+
+	recall.BatchIDs = []string{released.ID}
+	var rec models.Recall
+	a.must(http.StatusCreated, "POST", "/recall", recall, &rec)
+	if rec.Status != models.RecallOpen || rec.RecallNumber == "" {
+		t.Fatalf("recall = %+v", rec)
+	}
+	a.must(http.StatusOK, "GET", "/batch/"+released.ID, nil, &b)
+	if b.Status != models.BatchRecalled {
+		t.Fatalf("batch status = %s, want recalled", b.Status)
+	}
+	expect(t, a.do(mw.RoleRegistryAdmin, "DELETE", "/batch/"+released.ID, nil), http.StatusConflict, "in_use")
+
+	a.must(http.StatusOK, "POST", "/recall/"+rec.ID+"/close", models.RecallClosure{Note: "all stock returned"}, &rec)
+	if rec.Status != models.RecallClosed {
+		t.Fatalf("closed recall = %+v", rec)
+	}
+	expect(t, a.do(mw.RoleInspector, "POST", "/recall/"+rec.ID+"/close", models.RecallClosure{Note: "again"}), http.StatusConflict, "illegal_transition")
+
+	var page models.Page[models.Recall]
+	a.must(http.StatusOK, "GET", "/recall?batch_id="+released.ID, nil, &page)
+	if page.Total != 1 {
+		t.Fatalf("recalls for batch = %+v", page)
+	}
+}
+
+// failingDosageForms fails every call the way a lost database connection would.
+type failingDosageForms struct{ repository.DosageForms }
+
+func (failingDosageForms) Add(context.Context, models.DosageForm) (models.DosageForm, error) {
+	return models.DosageForm{}, errors.New(`FATAL: password authentication failed for user "moh" (SQLSTATE 28P01)`)
+}
+
+func TestInternalErrorsDoNotLeak(t *testing.T) {
+	store := repository.NewMemory()
+	store.DosageForms = failingDosageForms{store.DosageForms}
+	a := newStoreAPI(t, store)
+
+	rec := a.do(mw.RoleRegistryAdmin, "POST", "/dosage", models.DosageForm{Code: "TAB", Name: "Tablet"})
+	expect(t, rec, http.StatusInternalServerError, "internal")
+	if p := problem(t, rec); p.Detail != "internal error" || strings.Contains(rec.Body.String(), "password") {
+		t.Fatalf("body = %s", rec.Body)
+	}
+}
+
+func TestLocalizedErrors(t *testing.T) {
+	a := newTestAPI(t)
+	mfg := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	drugID := a.seedDrug("Lang")
+
+	// Every rule, custom ones included, is reported in Arabic.
+	rec := a.doLang("ar-JO,ar;q=0.9,en;q=0.5", mw.RoleManufacturer, "POST", "/batch", models.Batch{
+		DrugID:      "not-a-uuid",
+		BatchNumber: " ",
+		MfgDate:     mfg,
+		ExpireDate:  mfg.AddDate(0, 0, -1),
+		QtyInBatch:  -5,
+	})
+	expect(t, rec, http.StatusUnprocessableEntity, "validation_failed")
+	if cl := rec.Header().Get("Content-Language"); cl != "ar" {
+		t.Errorf("Content-Language = %q, want ar", cl)
+	}
+	got := map[string]string{}
+	for _, fe := range problem(t, rec).Errors {
+		got[fe.Field] = fe.Message
+	}
+	want := map[string]string{
+		"drug_id":      "يجب أن يكون drug_id معرّف UUID صالحًا",
+		"batch_number": "يجب ألا يكون batch_number فارغًا",
+		"qty_in_batch": "يجب أن يكون qty_in_batch أكبر من أو يساوي 0",
+		"expire_date":  "يجب أن يكون expire_date بعد mfg_date",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("messages = %v, want %v", got, want)
+	}
+
+	// Service errors are localized too, and the rule data stays as it is.
+	a.must(http.StatusCreated, "POST", "/batch", models.Batch{DrugID: drugID, BatchNumber: "L1", MfgDate: mfg, ExpireDate: mfg.AddDate(1, 0, 0), QtyInBatch: 1, Status: models.BatchPlanned}, nil)
+	rec = a.doLang("ar", mw.RoleManufacturer, "POST", "/batch", models.Batch{DrugID: drugID, BatchNumber: "L1", MfgDate: mfg, ExpireDate: mfg.AddDate(1, 0, 0), QtyInBatch: 1, Status: models.BatchPlanned})
+	expect(t, rec, http.StatusConflict, "already_exists")
+	if p := problem(t, rec); p.Detail != "الدفعة موجودة مسبقًا" {
+		t.Errorf("conflict detail = %q", p.Detail)
+	}
+	rec = a.doLang("ar", mw.RoleRegistryAdmin, "GET", "/dosage/"+uuid.NewString(), nil)
+	expect(t, rec, http.StatusNotFound, "not_found")
+	if p := problem(t, rec); p.Detail != "غير موجود" {
+		t.Errorf("not found detail = %q", p.Detail)
+	}
+	rec = a.doLang("ar", "", "GET", "/dosage", nil)
+	expect(t, rec, http.StatusUnauthorized, "unauthorized")
+	if p := problem(t, rec); p.Detail != "ترويسة التفويض مفقودة" {
+		t.Errorf("unauthorized detail = %q", p.Detail)
+	}
+
+	// English is preferred by q-value here, and the default otherwise.
+	for _, lang := range []string{"ar;q=0.2, en", "fr-FR", ""} {
+		rec = a.doLang(lang, mw.RoleRegistryAdmin, "GET", "/dosage/"+uuid.NewString(), nil)
+		if p := problem(t, rec); p.Detail != "not found" || rec.Header().Get("Content-Language") != "en" {
+			t.Errorf("Accept-Language %q: detail %q, Content-Language %q", lang, p.Detail, rec.Header().Get("Content-Language"))
+		}
+	}
+}
+
+func TestLocalizedNames(t *testing.T) {
+	a := newTestAPI(t)
+	for code, names := range map[string]models.LocalizedName{
+		"TAB": {"en": "Tablet", "ar": "قرص"},
+		"SYR": {"en": "Syrup", "ar": "شراب"},
+		"CRM": {"en": "Cream"},
+	} {
+		a.must(http.StatusCreated, "POST", "/dosage", map[string]any{"code": code, "names": names}, nil)
+	}
+
+	var page models.Page[models.DosageForm]
+	a.must(http.StatusOK, "GET", "/dosage?sort=name", nil, &page)
+	if got := []string{page.Items[0].Name, page.Items[1].Name, page.Items[2].Name}; !reflect.DeepEqual(got, []string{"Cream", "Syrup", "Tablet"}) {
+		t.Fatalf("english names = %v", got)
+	}
+	// Arabic sorts by Arabic collation; names without a translation fall back.
+	a.must(http.StatusOK, "GET", "/dosage?sort=name&lang=ar&limit=2", nil, &page)
+	if got := []string{page.Items[0].Name, page.Items[1].Name}; !reflect.DeepEqual(got, []string{"Cream", "شراب"}) {
+		t.Fatalf("arabic names = %v", got)
+	}
+	if page.Items[1].Names["en"] != "Syrup" {
+		t.Fatalf("names = %v, want every translation", page.Items[1].Names)
+	}
+	expect(t, a.do(mw.RoleReadOnly, "GET", "/dosage?sort=name&cursor="+page.NextCursor, nil), http.StatusBadRequest, "invalid_query")
+	expect(t, a.do(mw.RoleReadOnly, "GET", "/dosage?lang=de", nil), http.StatusBadRequest, "invalid_query")
+	a.must(http.StatusOK, "GET", "/dosage?q=قرص", nil, &page)
+	if page.Total != 1 || page.Items[0].Code != "TAB" {
+		t.Fatalf("search by arabic name = %+v", page)
+	}
+
+	rec := a.do(mw.RoleRegistryAdmin, "POST", "/dosage", map[string]any{"code": "GEL", "names": map[string]string{"ar": "هلام"}})
+	expect(t, rec, http.StatusUnprocessableEntity, "validation_failed")
+	if fe := problem(t, rec).Errors[0]; fe.Field != "names" || fe.Rule != "default_locale" {
+		t.Fatalf("missing default locale: %+v", fe)
+	}
+	rec = a.do(mw.RoleRegistryAdmin, "POST", "/dosage", map[string]any{"code": "GEL", "names": map[string]string{"en": "Gel", "de": "Gel"}})
+	expect(t, rec, http.StatusUnprocessableEntity, "validation_failed")
+	if fe := problem(t, rec).Errors[0]; fe.Field != "names[de]" || fe.Rule != "oneof" {
+		t.Fatalf("unknown locale: %+v", fe)
+	}
+
+	// A PATCH may set the default name through either field.
+	var form models.DosageForm
+	a.must(http.StatusCreated, "POST", "/dosage", models.DosageForm{Code: "GEL", Name: "Gel"}, &form)
+	a.must(http.StatusOK, "PATCH", "/dosage/"+form.ID, map[string]any{"names": map[string]string{"ar": "هلام"}}, &form)
+	if form.Name != "Gel" || form.Names["ar"] != "هلام" {
+		t.Fatalf("patch translation = %+v", form)
+	}
+	a.must(http.StatusOK, "PATCH", "/dosage/"+form.ID, map[string]any{"names": map[string]string{"en": "Oral gel"}}, &form)
+	if form.Name != "Oral gel" || form.Names["ar"] != "هلام" {
+		t.Fatalf("patch default translation = %+v", form)
+	}
+}
+
+func TestSearch(t *testing.T) {
+	a := newTestAPI(t)
+	drugID := a.seedDrug("Amoxil")
+	var holder models.AuthHolder
+	a.must(http.StatusCreated, "POST", "/auth-holder", models.AuthHolder{Name: "Hikma Pharmaceuticals", RegistrationNumber: "AH-2024-017"}, &holder)
+	var ma models.MarketingAuthorization
+	a.must(http.StatusCreated, "POST", "/marketing-authorization", models.MarketingAuthorization{Name: "MA", Country: "jo"}, &ma)
+	var reg models.DrugRegistration
+	a.must(http.StatusCreated, "POST", "/drug-registration", models.DrugRegistration{DrugID: drugID, MAID: ma.ID, RegistrationNumber: "REG-2024-0042", Status: models.RegistrationActive}, &reg)
+
+	search := func(query string) models.SearchResult {
+		t.Helper()
+		var out models.SearchResult
+		a.must(http.StatusOK, "GET", "/search?"+query, nil, &out)
+		return out
+	}
+
+	// A word prefix matches the brand and the INN; the drug ranks first on ties.
+	res := search("q=amox")
+	if len(res.Hits) != 2 || res.Hits[0].Type != models.SearchDrug || res.Hits[1].Type != models.SearchAPI {
+		t.Fatalf("hits = %+v", res.Hits)
+	}
+	if h := res.Hits[0]; h.ID != drugID || h.Field != "brand_name" || h.Highlight != "<mark>Amoxil</mark>" || h.Href != "/manufacturer/drug/"+drugID || h.Score <= 1 {
+		t.Fatalf("drug hit = %+v", h)
+	}
+	if h := res.Hits[1]; h.Highlight != "Paracetamol <mark>Amoxil</mark>" || h.Href != "/manufacturer/inn/"+h.ID {
+		t.Fatalf("api hit = %+v", h)
+	}
+
+	// A misspelling still finds the brand, ranked below an exact word.
+	res = search("q=amoxcil&type=drug")
+	if len(res.Hits) != 1 || res.Hits[0].ID != drugID || res.Hits[0].Score >= 1 || res.Hits[0].Highlight != "<mark>Amoxil</mark>" {
+		t.Fatalf("fuzzy hits = %+v", res.Hits)
+	}
+
+	res = search("q=hikma+pharma")
+	if len(res.Hits) != 1 || res.Hits[0].ID != holder.ID || res.Hits[0].Field != "name" || res.Hits[0].Highlight != "<mark>Hikma</mark> <mark>Pharmaceuticals</mark>" {
+		t.Fatalf("holder hits = %+v", res.Hits)
+	}
+	res = search("q=AH-2024-017")
+	if len(res.Hits) == 0 || res.Hits[0].ID != holder.ID || res.Hits[0].Field != "registration_number" || res.Hits[0].Title != "Hikma Pharmaceuticals" {
+		t.Fatalf("holder number hits = %+v", res.Hits)
+	}
+	res = search("q=2024-0042&type=registration")
+	if len(res.Hits) != 1 || res.Hits[0].ID != reg.ID || res.Hits[0].Href != "/manufacturer/drug-registration/"+reg.ID {
+		t.Fatalf("registration hits = %+v", res.Hits)
+	}
+
+	if res = search("q=zzzz"); res.Hits == nil || len(res.Hits) != 0 {
+		t.Fatalf("no-match hits = %#v", res.Hits)
+	}
+	expect(t, a.do(mw.RoleReadOnly, "GET", "/search", nil), http.StatusBadRequest, "invalid_query")
+	expect(t, a.do(mw.RoleReadOnly, "GET", "/search?q=amox&type=batch", nil), http.StatusBadRequest, "invalid_query")
+	expect(t, a.do("", "GET", "/search?q=amox", nil), http.StatusUnauthorized, "")
+}
+
+func TestSimilarBrands(t *testing.T) {
+	a := newTestAPI(t)
+	amoxilID := a.seedDrug("Amoxil")
+	a.seedDrug("Panadol")
+
+	var res models.BrandCheckResult
+	a.must(http.StatusOK, "GET", "/drug/similar-brands?name=Amoxyl", nil, &res)
+	if !res.Exceeded || len(res.Matches) != 2 || res.Matches[0].DrugID != amoxilID || res.Matches[0].Score < 0.9 || res.Matches[0].SharedPrefix < 4 {
+		t.Fatalf("result = %+v", res)
+	}
+	// Transliterated Arabic sounds the same.
+	a.must(http.StatusOK, "GET", "/drug/similar-brands?name="+url.QueryEscape("أموكسيل")+"&limit=1", nil, &res)
+	if !res.Exceeded || len(res.Matches) != 1 || res.Matches[0].BrandName != "Amoxil" || res.Matches[0].PhoneticSimilarity != 1 {
+		t.Fatalf("arabic result = %+v", res)
+	}
+	a.must(http.StatusOK, "GET", "/drug/similar-brands?name=Amoxyl&exclude_id="+amoxilID, nil, &res)
+	if res.Exceeded || len(res.Matches) != 1 || res.Matches[0].BrandName != "Panadol" {
+		t.Fatalf("excluded result = %+v", res)
+	}
+	expect(t, a.do(mw.RoleReadOnly, "GET", "/drug/similar-brands", nil), http.StatusBadRequest, "invalid_query")
+
+	var drug models.Drug
+	a.must(http.StatusOK, "GET", "/drug/"+amoxilID, nil, &drug)
+	drug.ID, drug.BrandName, drug.BrandNames = "", "Amoxyl", nil
+	rec := a.do(mw.RoleManufacturer, "POST", "/drug", drug)
+	expect(t, rec, http.StatusConflict, "similar_brand")
+	if p := problem(t, rec); !strings.Contains(p.Detail, "Amoxil (0.92)") {
+		t.Fatalf("detail = %q", p.Detail)
+	}
+	expect(t, a.do(mw.RoleManufacturer, "POST", "/drug?override_lasa=maybe", drug), http.StatusBadRequest, "invalid_query")
+	expect(t, a.do(mw.RoleManufacturer, "POST", "/drug?override_lasa=true", drug), http.StatusCreated, "")
+
+	drug.BrandName = "Zithromax"
+	expect(t, a.do(mw.RoleManufacturer, "POST", "/drug", drug), http.StatusCreated, "")
+}
+
+func TestDrugIngredients(t *testing.T) {
+	a := newTestAPI(t)
+	drugID := a.seedDrug("Augmentin")
+	var drug models.Drug
+	a.must(http.StatusOK, "GET", "/drug/"+drugID, nil, &drug)
+
+	// Every drug starts with the ingredient of its own API and dose.
+	var page models.Page[models.DrugIngredient]
+	a.must(http.StatusOK, "GET", "/drug/ingredient?drug_id="+drugID, nil, &page)
+	if page.Total != 1 || page.Items[0].APIID != drug.APIID || page.Items[0].Strength.Cmp(models.NewDecimal(500, 0)) != 0 || page.Items[0].Basis != "" {
+		t.Fatalf("ingredients = %+v", page)
+	}
+	first := page.Items[0]
+
+	var clav models.API
+	var ml models.StrengthUnit
+	a.must(http.StatusCreated, "POST", "/inn", models.API{Name: "Clavulanic acid"}, &clav)
+	a.must(http.StatusCreated, "POST", "/strength", models.StrengthUnit{Code: "ML", Name: "Millilitre"}, &ml)
+	per := models.NewDecimal(5, 0)
+	second := models.DrugIngredient{
+		DrugID: drugID, APIID: clav.ID, Strength: models.MustParseDecimal("31.25"), StrengthUnitID: drug.StrengthUnitID,
+		PerQuantity: &per, PerUnitID: ml.ID, Basis: models.BasisSalt, Salt: "potassium clavulanate",
+	}
+	var got models.DrugIngredient
+	rec := a.do(mw.RoleManufacturer, "POST", "/drug/ingredient", second)
+	expect(t, rec, http.StatusCreated, "")
+	if err := json.Unmarshal(rec.Body.Bytes(), &got); err != nil || got.PerQuantity == nil || got.PerQuantity.Cmp(per) != 0 || got.PerUnitID != ml.ID || got.Salt != "potassium clavulanate" {
+		t.Fatalf("ingredient = %+v (%v)", got, err)
+	}
+	expect(t, a.do(mw.RoleManufacturer, "POST", "/drug/ingredient", second), http.StatusConflict, "already_exists")
+
+	// A denominator needs both halves, and a salt basis names the salt.
+	bad := models.DrugIngredient{DrugID: drugID, APIID: uuid.NewString(), Strength: models.NewDecimal(1, 0), StrengthUnitID: ml.ID, PerQuantity: &per, Basis: models.BasisSalt}
+	rec = a.do(mw.RoleManufacturer, "POST", "/drug/ingredient", bad)
+	expect(t, rec, http.StatusUnprocessableEntity, "validation_failed")
+	fields := map[string]string{}
+	for _, fe := range problem(t, rec).Errors {
+		fields[fe.Field] = fe.Message
+	}
+	if fields["per_unit_id"] != "per_unit_id is required when per_quantity is present" ||
+		fields["salt"] != "salt is required when the strength basis is salt" || len(fields) != 2 {
+		t.Fatalf("errors = %+v", fields)
+	}
+	bad.PerQuantity, bad.Basis = nil, "ester"
+	rec = a.do(mw.RoleManufacturer, "POST", "/drug/ingredient", bad)
+	expect(t, rec, http.StatusUnprocessableEntity, "validation_failed")
+	if p := problem(t, rec); p.Field != "basis" || p.Errors[0].Rule != "oneof" {
+		t.Fatalf("problem = %+v", p)
+	}
+
+	// Drugs are found by any of their ingredients, and the clavulanate
+	// cannot be deleted from the catalog while the drug uses it.
+	var drugs models.Page[models.Drug]
+	a.must(http.StatusOK, "GET", "/drug?api_id="+clav.ID, nil, &drugs)
+	if drugs.Total != 1 || drugs.Items[0].ID != drugID {
+		t.Fatalf("drugs = %+v", drugs)
+	}
+	rec = a.do(mw.RoleRegistryAdmin, "DELETE", "/inn/"+clav.ID, nil)
+	expect(t, rec, http.StatusConflict, "in_use")
+	if p := problem(t, rec); p.Detail != "still referenced by other records: referenced by drug_ingredients" {
+		t.Fatalf("detail = %q", p.Detail)
+	}
+
+	// Editing the drug's dose carries over to its first ingredient; the API
+	// of another ingredient cannot become the drug's own.
+	drug.Dose = models.NewDecimal(875, 0)
+	a.must(http.StatusOK, "PUT", "/drug/"+drugID, drug, nil)
+	a.must(http.StatusOK, "GET", "/drug/ingredient/"+first.ID, nil, &got)
+	if got.Strength.Cmp(drug.Dose) != 0 || got.APIID != drug.APIID {
+		t.Fatalf("first ingredient = %+v", got)
+	}
+	drug.APIID = clav.ID
+	rec = a.do(mw.RoleManufacturer, "PUT", "/drug/"+drugID, drug)
+	expect(t, rec, http.StatusConflict, "already_exists")
+	if p := problem(t, rec); p.Detail != "the drug already has this ingredient" {
+		t.Fatalf("detail = %q", p.Detail)
+	}
+
+	rec = a.do(mw.RoleManufacturer, "PATCH", "/drug/ingredient/"+first.ID, map[string]any{"basis": "base"})
+	expect(t, rec, http.StatusOK, "")
+
+	// Deleting the drug takes its ingredients with it.
+	a.must(http.StatusNoContent, "DELETE", "/drug/"+drugID, nil, nil)
+	a.must(http.StatusOK, "GET", "/drug/ingredient?api_id="+clav.ID, nil, &page)
+	if page.Total != 0 {
+		t.Fatalf("ingredients after delete = %+v", page)
+	}
+	a.must(http.StatusNoContent, "DELETE", "/inn/"+clav.ID, nil, nil)
+}
+
+func TestDecimalsAndUnits(t *testing.T) {
+	a := newTestAPI(t)
+
+	factor := func(s string) *models.Decimal { d := models.MustParseDecimal(s); return &d }
+	var mg, mcg, ml, other models.StrengthUnit
+	a.must(http.StatusCreated, "POST", "/strength", models.StrengthUnit{Code: "MG", Name: "Milligram", Dimension: models.DimensionMass, Factor: factor("0.001")}, &mg)
+	a.must(http.StatusCreated, "POST", "/strength", models.StrengthUnit{Code: "MCG", Name: "Microgram", Dimension: models.DimensionMass, Factor: factor("0.000001")}, &mcg)
+	a.must(http.StatusCreated, "POST", "/strength", models.StrengthUnit{Code: "ML", Name: "Millilitre", Dimension: models.DimensionVolume, Factor: factor("1")}, &ml)
+	a.must(http.StatusCreated, "POST", "/strength", models.StrengthUnit{Code: "DROP", Name: "Drop"}, &other)
+	if mg.Dimension != models.DimensionMass || mg.Factor == nil || mg.Factor.String() != "0.001" {
+		t.Fatalf("unit = %+v", mg)
+	}
+	rec := a.do(mw.RoleRegistryAdmin, "POST", "/strength", models.StrengthUnit{Code: "X", Name: "X", Dimension: models.DimensionMass})
+	expect(t, rec, http.StatusUnprocessableEntity, "validation_failed")
+	if p := problem(t, rec); p.Field != "factor" {
+		t.Fatalf("problem = %+v", p)
+	}
+
+	var conv models.UnitConversion
+	a.must(http.StatusOK, "GET", "/strength/convert?value=0.25&from="+mg.ID+"&to="+mcg.ID, nil, &conv)
+	if conv.Result.String() != "250" || conv.FromCode != "MG" || conv.ToCode != "MCG" {
+		t.Fatalf("conversion = %+v", conv)
+	}
+	a.must(http.StatusOK, "GET", "/strength/convert?value=1&from="+mcg.ID+"&to="+mg.ID, nil, &conv)
+	if conv.Result.String() != "0.001" {
+		t.Fatalf("conversion = %+v", conv)
+	}
+	rec = a.do(mw.RoleReadOnly, "GET", "/strength/convert?value=5&from="+mg.ID+"&to="+ml.ID, nil)
+	expect(t, rec, http.StatusBadRequest, "invalid_query")
+	if p := problem(t, rec); p.Detail != "invalid query: cannot convert MG (mass) to ML (volume)" {
+		t.Fatalf("detail = %q", p.Detail)
+	}
+	expect(t, a.do(mw.RoleReadOnly, "GET", "/strength/convert?value=5&from="+other.ID+"&to="+mg.ID, nil), http.StatusBadRequest, "invalid_query")
+	expect(t, a.do(mw.RoleReadOnly, "GET", "/strength/convert?value=5&from="+uuid.NewString()+"&to="+mg.ID, nil), http.StatusBadRequest, "invalid_query")
+
+	// Prices keep the digits they were given and need a currency.
+	drugID := a.seedDrug("Exacta")
+	mfg := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	in := models.Batch{DrugID: drugID, BatchNumber: "P-1", MfgDate: mfg, ExpireDate: mfg.AddDate(2, 0, 0), QtyInBatch: 10, Price: models.MustParseDecimal("0.30"), Currency: "jod"}
+	var b models.Batch
+	a.must(http.StatusCreated, "POST", "/batch", in, &b)
+	if !strings.Contains(a.do(mw.RoleReadOnly, "GET", "/batch/"+b.ID, nil).Body.String(), `"price":0.30,"currency":"JOD"`) {
+		t.Fatalf("batch = %+v", b)
+	}
+	in.BatchNumber, in.Price, in.Currency = "P-2", models.MustParseDecimal("1.2345"), ""
+	rec = a.do(mw.RoleManufacturer, "POST", "/batch", in)
+	expect(t, rec, http.StatusUnprocessableEntity, "validation_failed")
+	fields := map[string]string{}
+	for _, fe := range problem(t, rec).Errors {
+		fields[fe.Field] = fe.Rule
+	}
+	if fields["price"] != "max_scale" || fields["currency"] != "required_with" {
+		t.Fatalf("errors = %+v", fields)
+	}
+}
+
+// TestDecimalsThroughMiddleware sends decimals through the sanitizing
+// middleware, which must not read them as float64 on the way.
+func TestDecimalsThroughMiddleware(t *testing.T) {
+	a := newGlobalAPI(t)
+
+	drugID := a.seedDrug("Exacta")
+	mfg := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	var b models.Batch
+	a.must(http.StatusCreated, "POST", "/batch", models.Batch{
+		DrugID: drugID, BatchNumber: "P-1", MfgDate: mfg, ExpireDate: mfg.AddDate(2, 0, 0), QtyInBatch: 10,
+		Price: models.MustParseDecimal("12.50"), Currency: "JOD",
+	}, &b)
+	if body := a.do(mw.RoleReadOnly, "GET", "/batch/"+b.ID, nil).Body.String(); !strings.Contains(body, `"price":12.50,`) {
+		t.Fatalf("batch = %s", body)
+	}
+
+	var drug models.Drug
+	a.must(http.StatusOK, "PATCH", "/drug/"+drugID, map[string]any{"dose": models.MustParseDecimal("0.12345678901234567891")}, &drug)
+	if body := a.do(mw.RoleReadOnly, "GET", "/drug/"+drugID, nil).Body.String(); !strings.Contains(body, `"dose":0.12345678901234567891,`) {
+		t.Fatalf("drug = %s", body)
+	}
+}
+
+func TestDrugEquivalents(t *testing.T) {
+	a := newTestAPI(t)
+
+	factor := func(s string) *models.Decimal { d := models.MustParseDecimal(s); return &d }
+	var tab, fct, syr models.DosageForm
+	a.must(http.StatusCreated, "POST", "/dosage", models.DosageForm{Code: "TAB", Name: "Tablet", Group: " oral-solid "}, &tab)
+	a.must(http.StatusCreated, "POST", "/dosage", models.DosageForm{Code: "FCT", Name: "Film-coated tablet", Group: "ORAL-SOLID"}, &fct)
+	a.must(http.StatusCreated, "POST", "/dosage", models.DosageForm{Code: "SYR", Name: "Syrup"}, &syr)
+	if tab.Group != "ORAL-SOLID" {
+		t.Fatalf("form = %+v", tab)
+	}
+	var oral, iv models.RouteOfAdmin
+	a.must(http.StatusCreated, "POST", "/route", models.RouteOfAdmin{Code: "PO", Name: "Oral"}, &oral)
+	a.must(http.StatusCreated, "POST", "/route", models.RouteOfAdmin{Code: "IV", Name: "Intravenous"}, &iv)
+	var mg, g models.StrengthUnit
+	a.must(http.StatusCreated, "POST", "/strength", models.StrengthUnit{Code: "MG", Name: "Milligram", Dimension: models.DimensionMass, Factor: factor("0.001")}, &mg)
+	a.must(http.StatusCreated, "POST", "/strength", models.StrengthUnit{Code: "G", Name: "Gram", Dimension: models.DimensionMass, Factor: factor("1")}, &g)
+	var amox, clav models.API
+	a.must(http.StatusCreated, "POST", "/inn", models.API{Name: "Amoxicillin"}, &amox)
+	a.must(http.StatusCreated, "POST", "/inn", models.API{Name: "Clavulanic acid"}, &clav)
+	var ma models.MarketingAuthorization
+	a.must(http.StatusCreated, "POST", "/marketing-authorization", models.MarketingAuthorization{Name: "MA", Country: "jo"}, &ma)
+
+	today := time.Now().UTC()
+	// market adds a drug, registers it with status and releases a batch
+	// unless release is false.
+	market := func(brand string, form models.DosageForm, route models.RouteOfAdmin, unit models.StrengthUnit, dose string, status models.RegistrationStatus, release bool) string {
+		t.Helper()
+		var d models.Drug
+		a.must(http.StatusCreated, "POST", "/drug?override_lasa=true", models.Drug{
+			BrandName: brand, DosageFormID: form.ID, RouteID: route.ID, StrengthUnitID: unit.ID, Dose: models.MustParseDecimal(dose), APIID: amox.ID,
+		}, &d)
+		if status != "" {
+			a.must(http.StatusCreated, "POST", "/drug-registration", models.DrugRegistration{
+				DrugID: d.ID, MAID: ma.ID, RegistrationNumber: "REG-" + brand, Status: status,
+				ValidFrom: today.AddDate(-1, 0, 0), ValidTo: today.AddDate(1, 0, 0),
+			}, nil)
+		}
+		b := a.seedBatch(d.ID, "B-"+brand)
+		if release {
+			a.must(http.StatusOK, "POST", "/batch/"+b.ID+"/release", nil, nil)
+		}
+		return d.ID
+	}
+	ref := market("Amoxil", tab, oral, mg, "500", "", false)
+	moxa := market("Moxa", fct, oral, g, "0.5", models.RegistrationActive, true)
+	amoxa := market("Amoxa", tab, oral, mg, "500.0", models.RegistrationActive, true)
+	market("Unreleased", tab, oral, mg, "500", models.RegistrationActive, false)
+	market("Suspended", tab, oral, mg, "500", models.RegistrationSuspended, true)
+	market("Half", tab, oral, mg, "250", models.RegistrationActive, true)
+	market("Injection", tab, iv, mg, "500", models.RegistrationActive, true)
+	market("Syrup", syr, oral, mg, "500", models.RegistrationActive, true)
+	combo := market("Augmentin", tab, oral, mg, "500", models.RegistrationActive, true)
+	a.must(http.StatusCreated, "POST", "/drug/ingredient", models.DrugIngredient{
+		DrugID: combo, APIID: clav.ID, Strength: models.NewDecimal(125, 0), StrengthUnitID: mg.ID, Basis: models.BasisBase,
+	}, nil)
+
+	var got models.DrugEquivalents
+	a.must(http.StatusOK, "GET", "/drug/"+ref+"/equivalents", nil, &got)
+	if got.DrugID != ref || len(got.Items) != 2 || got.Items[0].ID != amoxa || got.Items[1].ID != moxa {
+		t.Fatalf("equivalents = %+v", got)
+	}
+	if e := got.Items[1]; e.RegistrationNumber != "REG-Moxa" || e.ReleasedBatches != 1 || e.Dose.String() != "0.5" {
+		t.Fatalf("equivalent = %+v", e)
+	}
+
+	// The relation is symmetric for marketed drugs; the reference itself is
+	// not on the market.
+	a.must(http.StatusOK, "GET", "/drug/"+moxa+"/equivalents", nil, &got)
+	if len(got.Items) != 1 || got.Items[0].ID != amoxa {
+		t.Fatalf("equivalents = %+v", got)
+	}
+	expect(t, a.do(mw.RoleReadOnly, "GET", "/drug/"+uuid.NewString()+"/equivalents", nil), http.StatusNotFound, "not_found")
+}
+
+func TestDrugPrices(t *testing.T) {
+	store := repository.NewMemory()
+	a := newStoreAPI(t, store)
+	drugID := a.seedDrug("Pricy")
+	day := func(s string) time.Time { d, _ := time.Parse(time.DateOnly, s); return d }
+	var bottle models.Presentation
+	a.must(http.StatusCreated, "POST", "/drug/presentation", models.Presentation{
+		DrugID: drugID, GTIN: "09780306406157", PackSize: models.NewDecimal(30, 0), ContainerType: models.ContainerBottle, Description: "30 tablets",
+	}, &bottle)
+
+	var first, cut, pack models.DrugPrice
+	a.must(http.StatusCreated, "POST", "/drug-price", models.DrugPrice{DrugID: drugID, Currency: "jod", MaxPrice: models.MustParseDecimal("5.000"), EffectiveFrom: day("2025-01-01"), Reference: "PC-2025/01"}, &first)
+	a.must(http.StatusCreated, "POST", "/drug-price", models.DrugPrice{DrugID: drugID, Currency: "JOD", MaxPrice: models.MustParseDecimal("4.5"), EffectiveFrom: day("2026-06-01")}, &cut)
+	a.must(http.StatusCreated, "POST", "/drug-price", models.DrugPrice{DrugID: drugID, PresentationID: bottle.ID, Currency: "JOD", MaxPrice: models.NewDecimal(10, 0), EffectiveFrom: day("2025-01-01")}, &pack)
+	if first.Currency != "JOD" || first.MaxPrice.String() != "5.000" {
+		t.Fatalf("price = %+v", first)
+	}
+	expect(t, a.do(mw.RoleRegistryAdmin, "POST", "/drug-price", models.DrugPrice{DrugID: drugID, Currency: "JOD", MaxPrice: models.NewDecimal(6, 0), EffectiveFrom: day("2025-01-01")}), http.StatusConflict, "already_exists")
+	expect(t, a.do(mw.RoleManufacturer, "POST", "/drug-price", first), http.StatusForbidden, "forbidden")
+	// A pack's ceiling names one of the drug's own presentations.
+	rec := a.do(mw.RoleRegistryAdmin, "POST", "/drug-price", models.DrugPrice{DrugID: a.seedDrug("Cheapo"), PresentationID: bottle.ID, Currency: "JOD", MaxPrice: models.NewDecimal(1, 0), EffectiveFrom: day("2025-01-01")})
+	expect(t, rec, http.StatusUnprocessableEntity, "invalid_reference")
+	if p := problem(t, rec); p.Field != "presentation_id" {
+		t.Fatalf("problem = %+v", p)
+	}
+	expect(t, a.do(mw.RoleRegistryAdmin, "DELETE", "/drug/presentation/"+bottle.ID, nil), http.StatusConflict, "in_use")
+	expect(t, a.do(mw.RoleRegistryAdmin, "DELETE", "/drug/"+drugID, nil), http.StatusConflict, "in_use")
+
+	// The history is every ceiling of the drug, in the order they took effect.
+	var history models.Page[models.DrugPrice]
+	a.must(http.StatusOK, "GET", "/drug-price?drug_id="+drugID+"&currency=jod", nil, &history)
+	if history.Total != 3 || history.Items[2].ID != cut.ID {
+		t.Fatalf("history = %+v", history)
+	}
+
+	batch := func(number, mfg, price, presentationID string) models.Batch {
+		return models.Batch{DrugID: drugID, BatchNumber: number, MfgDate: day(mfg), ExpireDate: day(mfg).AddDate(2, 0, 0), QtyInBatch: 10,
+			Price: models.MustParseDecimal(price), Currency: "JOD", PresentationID: presentationID}
+	}
+	rec = a.do(mw.RoleManufacturer, "POST", "/batch", batch("P-1", "2026-01-10", "5.5", ""))
+	expect(t, rec, http.StatusConflict, "price_above_ceiling")
+	if p := problem(t, rec); p.Detail != "price 5.5 JOD is above the ceiling of 5.000 JOD in force since 2025-01-01" {
+		t.Fatalf("detail = %q", p.Detail)
+	}
+	var p2 models.Batch
+	a.must(http.StatusCreated, "POST", "/batch", batch("P-2", "2026-01-10", "5", ""), &p2)
+	a.must(http.StatusCreated, "POST", "/batch", batch("P-3", "2026-07-01", "9", bottle.ID), nil)
+	expect(t, a.do(mw.RoleManufacturer, "POST", "/batch", batch("P-4", "2026-07-01", "4.75", "")), http.StatusConflict, "price_above_ceiling")
+
+	// Nor can an edit take a batch's price above its ceiling.
+	p2.Price = models.MustParseDecimal("5.01")
+	expect(t, a.do(mw.RoleManufacturer, "PUT", "/batch/"+p2.ID, p2), http.StatusConflict, "price_above_ceiling")
+	expect(t, a.do(mw.RoleManufacturer, "PATCH", "/batch/"+p2.ID, map[string]any{"price": "6"}), http.StatusConflict, "price_above_ceiling")
+	a.must(http.StatusOK, "PATCH", "/batch/"+p2.ID, map[string]any{"price": "4.9"}, nil)
+
+	// Flagging stores the batch and lists it as a violation instead.
+	flag := newOptionsAPI(t, store, router.Options{PriceCeilingPolicy: models.PriceCeilingFlag})
+	var flagged models.Batch
+	flag.must(http.StatusCreated, "POST", "/batch", batch("P-4", "2026-07-01", "4.75", ""), &flagged)
+	var violations models.Page[models.PriceViolation]
+	a.must(http.StatusOK, "GET", "/drug-price/violations?drug_id="+drugID, nil, &violations)
+	if violations.Total != 1 {
+		t.Fatalf("violations = %+v", violations)
+	}
+	if v := violations.Items[0]; v.BatchID != flagged.ID || v.PriceID != cut.ID || v.MaxPrice.String() != "4.5" || v.Price.String() != "4.75" {
+		t.Fatalf("violation = %+v", v)
+	}
+}
+
+func TestPresentations(t *testing.T) {
+	a := newTestAPI(t)
+	drugID := a.seedDrug("Packed")
+	otherID := a.seedDrug("Zinnat")
+
+	var blister, bottle models.Presentation
+	a.must(http.StatusCreated, "POST", "/drug/presentation", models.Presentation{
+		DrugID: drugID, GTIN: " 4006381333931", PackSize: models.NewDecimal(100, 0), ContainerType: "Blister",
+		Description: "10 blisters of 10", LocalCode: "12345-678-90",
+	}, &blister)
+	if blister.GTIN != "04006381333931" || blister.ContainerType != models.ContainerBlister {
+		t.Fatalf("presentation = %+v", blister)
+	}
+	a.must(http.StatusCreated, "POST", "/drug/presentation", models.Presentation{
+		DrugID: drugID, GTIN: "09780306406157", PackSize: models.NewDecimal(30, 0), ContainerType: models.ContainerBottle,
+	}, &bottle)
+
+	// The check digit is verified, and a GTIN names one pack however it is padded.
+	rec := a.do(mw.RoleManufacturer, "POST", "/drug/presentation", models.Presentation{
+		DrugID: drugID, GTIN: "4006381333932", PackSize: models.NewDecimal(10, 0), ContainerType: models.ContainerBox, LocalCode: "12 345",
+	})
+	expect(t, rec, http.StatusUnprocessableEntity, "validation_failed")
+	if errs := problem(t, rec).Errors; len(errs) != 2 || errs[0].Field != "gtin" || errs[0].Rule != "gtin" || errs[1].Field != "local_code" {
+		t.Fatalf("errors = %+v", errs)
+	}
+	expect(t, a.do(mw.RoleManufacturer, "POST", "/drug/presentation", models.Presentation{
+		DrugID: otherID, GTIN: "9780306406157", PackSize: models.NewDecimal(10, 0), ContainerType: models.ContainerBox,
+	}), http.StatusConflict, "already_exists")
+
+	var page models.Page[models.Presentation]
+	a.must(http.StatusOK, "GET", "/drug/presentation?drug_id="+drugID+"&gtin=4006381333931", nil, &page)
+	if page.Total != 1 || page.Items[0].ID != blister.ID {
+		t.Fatalf("page = %+v", page)
+	}
+
+	// A batch is packed in one of its own drug's presentations.
+	mfg := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	batch := models.Batch{DrugID: otherID, PresentationID: blister.ID, BatchNumber: "G-1", MfgDate: mfg, ExpireDate: mfg.AddDate(2, 0, 0), QtyInBatch: 10}
+	rec = a.do(mw.RoleManufacturer, "POST", "/batch", batch)
+	expect(t, rec, http.StatusUnprocessableEntity, "invalid_reference")
+	if p := problem(t, rec); p.Field != "presentation_id" {
+		t.Fatalf("problem = %+v", p)
+	}
+	batch.DrugID = drugID
+	var packed models.Batch
+	a.must(http.StatusCreated, "POST", "/batch", batch, &packed)
+	var batches models.Page[models.Batch]
+	a.must(http.StatusOK, "GET", "/batch?presentation_id="+blister.ID, nil, &batches)
+	if batches.Total != 1 || batches.Items[0].ID != packed.ID {
+		t.Fatalf("batches = %+v", batches)
+	}
+	moved := blister
+	moved.DrugID = otherID
+	expect(t, a.do(mw.RoleManufacturer, "PUT", "/drug/presentation/"+blister.ID, moved), http.StatusConflict, "in_use")
+	expect(t, a.do(mw.RoleRegistryAdmin, "DELETE", "/drug/presentation/"+blister.ID, nil), http.StatusConflict, "in_use")
+	a.must(http.StatusNoContent, "DELETE", "/drug/presentation/"+bottle.ID, nil, nil)
+
+	// A GTIN finds the pack, its drug and the registration in force.
+	var lookup models.GTINLookup
+	a.must(http.StatusOK, "GET", "/gtin/4006381333931", nil, &lookup)
+	if lookup.GTIN != blister.GTIN || lookup.Presentation.ID != blister.ID || lookup.Drug.ID != drugID || lookup.Registration != nil {
+		t.Fatalf("lookup = %+v", lookup)
+	}
+	var ma models.MarketingAuthorization
+	a.must(http.StatusCreated, "POST", "/marketing-authorization", models.MarketingAuthorization{Name: "MA", Country: "jo"}, &ma)
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+	var reg models.DrugRegistration
+	a.must(http.StatusCreated, "POST", "/drug-registration", models.DrugRegistration{
+		DrugID: drugID, MAID: ma.ID, RegistrationNumber: "REG-PACKED", Status: models.RegistrationActive,
+		ValidFrom: today.AddDate(-1, 0, 0), ValidTo: today.AddDate(1, 0, 0),
+	}, &reg)
+	a.must(http.StatusOK, "GET", "/gtin/04006381333931", nil, &lookup)
+	if lookup.Registration == nil || lookup.Registration.ID != reg.ID {
+		t.Fatalf("registration = %+v", lookup.Registration)
+	}
+	expect(t, a.do(mw.RoleReadOnly, "GET", "/gtin/9780306406157", nil), http.StatusNotFound, "not_found")
+	expect(t, a.do(mw.RoleReadOnly, "GET", "/gtin/4006381333932", nil), http.StatusBadRequest, "invalid_query")
+}
+
+func TestVerify(t *testing.T) {
+	a := newTestAPI(t)
+	drugID := a.seedDrug("Verity")
+	var pack models.Presentation
+	a.must(http.StatusCreated, "POST", "/drug/presentation", models.Presentation{
+		DrugID: drugID, GTIN: "04006381333931", PackSize: models.NewDecimal(20, 0), ContainerType: models.ContainerBlister, Description: "2 blisters of 10",
+	}, &pack)
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+	batch := func(number string, mfg, exp time.Time) models.Batch {
+		var b models.Batch
+		a.must(http.StatusCreated, "POST", "/batch", models.Batch{
+			DrugID: drugID, PresentationID: pack.ID, BatchNumber: number, MfgDate: mfg, ExpireDate: exp, QtyInBatch: 100,
+		}, &b)
+		return b
+	}
+	exp := today.AddDate(1, 0, 0)
+	good := batch("VB-1", today.AddDate(-1, 0, 0), exp)
+	yymmdd := exp.Format("060102")
+
+	verify := func(lang, payload string) models.Verification {
+		t.Helper()
+		rec := a.doLang(lang, "", "POST", "/verify", models.VerifyRequest{Payload: payload})
+		if rec.Code != http.StatusOK {
+			t.Fatalf("verify %q: status %d: %s", payload, rec.Code, rec.Body)
+		}
+		var v models.Verification
+		if err := json.Unmarshal(rec.Body.Bytes(), &v); err != nil {
+			t.Fatal(err)
+		}
+		return v
+	}
+	codes := func(v models.Verification) []string {
+		var out []string
+		for _, r := range v.Reasons {
+			out = append(out, r.Code)
+		}
+		return out
+	}
+
+	// As scanned, with the symbology identifier and a GS after the batch.
+	scanned := "]d2010400638133393117" + yymmdd + "10VB-1\x1d21SN0001"
+	if v := verify("", scanned); v.Result != models.VerifyUnknown || !slices.Equal(codes(v), []string{"verify_not_released"}) {
+		t.Fatalf("planned = %+v", v)
+	}
+	a.must(http.StatusOK, "POST", "/batch/"+good.ID+"/release", nil, nil)
+	v := verify("", scanned)
+	if v.Result != models.VerifyGenuine || v.BatchID != good.ID || v.Serial != "SN0001" || v.BrandName != "Verity" || v.Pack != "2 blisters of 10" {
+		t.Fatalf("genuine = %+v", v)
+	}
+	if v.Reasons[0].Message != "batch VB-1 of Verity is registered and released" {
+		t.Fatalf("reason = %+v", v.Reasons[0])
+	}
+	// The parenthesised form, with only the month of expiry.
+	if v := verify("", "(01)04006381333931(17)"+exp.Format("0601")+"00(10)VB-1"); v.Result != models.VerifyGenuine {
+		t.Fatalf("month expiry = %+v", v)
+	}
+
+	// Anything the registry cannot match is unknown, with every reason.
+	wrong := exp.AddDate(0, 2, 0)
+	v = verify("", "(01)04006381333931(17)"+wrong.Format("060102")+"(10)VB-1")
+	if v.Result != models.VerifyUnknown || !slices.Equal(codes(v), []string{"verify_expiry_mismatch"}) {
+		t.Fatalf("mismatch = %+v", v)
+	}
+	if want := "the printed expiry " + wrong.Format(time.DateOnly) + " does not match the registered expiry " + exp.Format(time.DateOnly); v.Reasons[0].Message != want {
+		t.Fatalf("reason = %q", v.Reasons[0].Message)
+	}
+	if v := verify("", "(01)09780306406157(10)VB-1"); v.Result != models.VerifyUnknown || !slices.Equal(codes(v), []string{"verify_unknown_gtin", "verify_no_expiry"}) {
+		t.Fatalf("unknown gtin = %+v", v)
+	}
+	if v := verify("", "(01)04006381333931(17)"+yymmdd+"(10)FAKE"); v.Result != models.VerifyUnknown || !slices.Equal(codes(v), []string{"verify_unknown_batch"}) {
+		t.Fatalf("unknown batch = %+v", v)
+	}
+
+	old := batch("VB-0", today.AddDate(-3, 0, 0), today.AddDate(0, 0, -1))
+	v = verify("", "(01)04006381333931(17)"+old.ExpireDate.Format("060102")+"(10)VB-0")
+	if v.Result != models.VerifyExpired || !slices.Equal(codes(v), []string{"verify_expired"}) {
+		t.Fatalf("expired = %+v", v)
+	}
+
+	var recall models.Recall
+	a.must(http.StatusCreated, "POST", "/recall", models.Recall{
+		Class: models.RecallClassII, Level: models.RecallRetail, ReasonCodes: []string{"counterfeit"},
+		InitiatedBy: models.RecallByRegulator, BatchIDs: []string{good.ID},
+	}, &recall)
+	v = verify("ar", scanned)
+	if v.Result != models.VerifyRecalled || v.RecallNumber != recall.RecallNumber || v.Reasons[0].Message != "تم سحب التشغيلة VB-1" {
+		t.Fatalf("recalled = %+v", v)
+	}
+
+	// Codes that are not GS1 element strings are refused.
+	expect(t, a.do("", "POST", "/verify", models.VerifyRequest{Payload: "(01)04006381333932(10)VB-1"}), http.StatusBadRequest, "invalid_payload")
+	expect(t, a.do("", "POST", "/verify", models.VerifyRequest{Payload: "0104006381333931\x1d9912"}), http.StatusBadRequest, "invalid_payload")
+	expect(t, a.do("", "POST", "/verify", models.VerifyRequest{Payload: "(01)04006381333931(17)281331"}), http.StatusBadRequest, "invalid_payload")
+	expect(t, a.do("", "POST", "/verify", models.VerifyRequest{}), http.StatusUnprocessableEntity, "validation_failed")
+}
+
+func TestSerials(t *testing.T) {
+	a := newTestAPI(t)
+	drugID := a.seedDrug("Tracer")
+	var pack models.Presentation
+	a.must(http.StatusCreated, "POST", "/drug/presentation", models.Presentation{
+		DrugID: drugID, GTIN: "4006381333931", PackSize: models.NewDecimal(30, 0), ContainerType: models.ContainerBottle,
+	}, &pack)
+	loose := a.seedBatch(drugID, "TR-0")
+	var b models.Batch
+	a.must(http.StatusCreated, "POST", "/batch", models.Batch{
+		DrugID: drugID, PresentationID: pack.ID, BatchNumber: "TR-1", MfgDate: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+		ExpireDate: time.Date(2028, 1, 1, 0, 0, 0, 0, time.UTC), QtyInBatch: 100,
+	}, &b)
+
+	upload := models.SerialUpload{Serials: []string{"SN1", "SN2", "SN3"}, Location: "Plant 1"}
+	expect(t, a.do(mw.RoleManufacturer, "POST", "/batch/"+loose.ID+"/serials", upload), http.StatusConflict, "no_presentation")
+	expect(t, a.do(mw.RoleSupplyChain, "POST", "/batch/"+b.ID+"/serials", upload), http.StatusForbidden, "forbidden")
+	expect(t, a.do(mw.RoleManufacturer, "POST", "/batch/"+b.ID+"/serials",
+		models.SerialUpload{Serials: []string{"SN1", "SN1"}, Location: "Plant 1"}), http.StatusUnprocessableEntity, "validation_failed")
+	expect(t, a.do(mw.RoleManufacturer, "POST", "/batch/"+b.ID+"/serials",
+		models.SerialUpload{Serials: []string{"SN 1"}, Location: "Plant 1"}), http.StatusUnprocessableEntity, "validation_failed")
+	rec := a.do(mw.RoleManufacturer, "POST", "/batch/"+b.ID+"/serials", upload)
+	expect(t, rec, http.StatusCreated, "")
+	var res models.SerialUploadResult
+	if err := json.Unmarshal(rec.Body.Bytes(), &res); err != nil {
+		t.Fatal(err)
+	}
+	if res.GTIN != "04006381333931" || res.Commissioned != 3 {
+		t.Fatalf("upload = %+v", res)
+	}
+	// Serials are unique per GTIN: a second upload naming one fails whole.
+	rec = a.do(mw.RoleManufacturer, "POST", "/batch/"+b.ID+"/serials", models.SerialUpload{Serials: []string{"SN4", "SN2"}, Location: "Plant 1"})
+	expect(t, rec, http.StatusConflict, "already_exists")
+	if p := problem(t, rec); p.Detail != "serial numbers already registered under GTIN 04006381333931: SN2" {
+		t.Fatalf("detail = %q", p.Detail)
+	}
+	var page models.Page[models.Serial]
+	a.must(http.StatusOK, "GET", "/serial?batch_id="+b.ID, nil, &page)
+	if len(page.Items) != 3 || page.Items[0].Serial != "SN1" || page.Items[0].Status != models.SerialCommissioned {
+		t.Fatalf("serials = %+v", page.Items)
+	}
+	expect(t, a.do(mw.RoleRegistryAdmin, "DELETE", "/batch/"+b.ID, nil), http.StatusConflict, "in_use")
+
+	event := func(role mw.Role, serial string, typ models.SerialEventType, location string) models.SerialEvent {
+		t.Helper()
+		rec := a.do(role, "POST", "/serial/event", models.SerialEvent{GTIN: "4006381333931", Serial: serial, Type: typ, Location: location})
+		expect(t, rec, http.StatusCreated, "")
+		var e models.SerialEvent
+		if err := json.Unmarshal(rec.Body.Bytes(), &e); err != nil {
+			t.Fatal(err)
+		}
+		return e
+	}
+	event(mw.RoleManufacturer, "SN1", models.EventShip, "Plant 1")
+	event(mw.RoleSupplyChain, "SN1", models.EventReceive, "Wholesaler A")
+	event(mw.RoleSupplyChain, "SN1", models.EventShip, "Wholesaler A")
+	event(mw.RoleSupplyChain, "SN1", models.EventReceive, "Pharmacy B")
+	if e := event(mw.RoleSupplyChain, "SN1", models.EventDispense, "Pharmacy B"); e.Anomaly != "" || e.Actor != "test-supply_chain" {
+		t.Fatalf("dispense = %+v", e)
+	}
+	// The same code dispensed elsewhere: recorded, flagged, status kept.
+	if e := event(mw.RoleSupplyChain, "SN1", models.EventDispense, "Pharmacy C"); e.Anomaly != "already_dispensed" {
+		t.Fatalf("clone = %+v", e)
+	}
+	if e := event(mw.RoleSupplyChain, "SN2", models.EventReceive, "Pharmacy C"); e.Anomaly != "out_of_sequence" {
+		t.Fatalf("receive unshipped = %+v", e)
+	}
+	expect(t, a.do(mw.RoleSupplyChain, "POST", "/serial/event",
+		models.SerialEvent{GTIN: "04006381333931", Serial: "SN3", Type: models.EventDecommission, Location: "Plant 1"}), http.StatusUnprocessableEntity, "validation_failed")
+	expect(t, a.do(mw.RoleSupplyChain, "POST", "/serial/event",
+		models.SerialEvent{GTIN: "04006381333931", Serial: "SN3", Type: models.EventCommission, Location: "Plant 1"}), http.StatusUnprocessableEntity, "validation_failed")
+	expect(t, a.do(mw.RoleSupplyChain, "POST", "/serial/event",
+		models.SerialEvent{GTIN: "04006381333931", Serial: "NOPE", Type: models.EventDispense, Location: "Pharmacy C"}), http.StatusNotFound, "not_found")
+	expect(t, a.do(mw.RoleReadOnly, "POST", "/serial/event",
+		models.SerialEvent{GTIN: "04006381333931", Serial: "SN3", Type: models.EventShip, Location: "Plant 1"}), http.StatusForbidden, "forbidden")
+
+	var custody models.SerialCustody
+	a.must(http.StatusOK, "GET", "/serial/custody?gtin=4006381333931&serial=SN1", nil, &custody)
+	var steps []string
+	for _, e := range custody.Events {
+		steps = append(steps, string(e.Type)+"@"+e.Location)
+	}
+	want := []string{"commission@Plant 1", "ship@Plant 1", "receive@Wholesaler A", "ship@Wholesaler A", "receive@Pharmacy B", "dispense@Pharmacy B", "dispense@Pharmacy C"}
+	if custody.Serial.Status != models.SerialDispensed || !slices.Equal(steps, want) {
+		t.Fatalf("custody = %s %v", custody.Serial.Status, steps)
+	}
+	if custody.Events[0].Actor != "test-manufacturer" {
+		t.Fatalf("commissioned by %q", custody.Events[0].Actor)
+	}
+	expect(t, a.do(mw.RoleReadOnly, "GET", "/serial/custody?gtin=04006381333931&serial=SN9", nil), http.StatusNotFound, "not_found")
+	expect(t, a.do(mw.RoleReadOnly, "GET", "/serial/custody?gtin=123&serial=SN1", nil), http.StatusBadRequest, "invalid_query")
+	expect(t, a.do(mw.RoleSupplyChain, "GET", "/serial/custody?gtin=04006381333931&serial=SN1", nil), http.StatusForbidden, "forbidden")
+
+	var clones models.Page[models.SerialClone]
+	a.must(http.StatusOK, "GET", "/serial/clones?gtin=04006381333931", nil, &clones)
+	if len(clones.Items) != 1 || clones.Items[0].Serial != "SN1" || clones.Items[0].Dispenses != 2 {
+		t.Fatalf("clones = %+v", clones.Items)
+	}
+}
+
+func TestStockLedger(t *testing.T) {
+	a := newTestAPI(t)
+	drugID := a.seedDrug("Ledgerol")
+	b := a.seedBatch(drugID, "LG-1")
+	if b.QtyRemaining != 1000 {
+		t.Fatalf("qty_remaining = %d", b.QtyRemaining)
+	}
+	move := func(typ models.StockMovementType, qty int64) *httptest.ResponseRecorder {
+		return a.do(mw.RoleManufacturer, "POST", "/batch/"+b.ID+"/stock", models.StockMovement{Type: typ, Quantity: qty, Reference: "DN-1"})
+	}
+	moved := func(typ models.StockMovementType, qty int64) models.StockMovement {
+		t.Helper()
+		rec := move(typ, qty)
+		expect(t, rec, http.StatusCreated, "")
+		var m models.StockMovement
+		if err := json.Unmarshal(rec.Body.Bytes(), &m); err != nil {
+			t.Fatal(err)
+		}
+		return m
+	}
+
+	// Units leave only a released batch; destruction is the exception.
+	rec := move(models.MovementDistribution, 10)
+	expect(t, rec, http.StatusConflict, "not_released")
+	if p := problem(t, rec); p.Detail != "batch LG-1 is planned; only released batches can be released to market or distributed" {
+		t.Fatalf("detail = %q", p.Detail)
+	}
+	if m := moved(models.MovementDestruction, 100); m.BalanceAfter != 900 || m.CreatedBy != "test-manufacturer" {
+		t.Fatalf("destruction = %+v", m)
+	}
+	a.must(http.StatusOK, "POST", "/batch/"+b.ID+"/release", nil, nil)
+	moved(models.MovementRelease, 500)
+	// Destroyed units are not on the market, so they cannot come back.
+	rec = move(models.MovementReturn, 501)
+	expect(t, rec, http.StatusConflict, "invalid_return")
+	if p := problem(t, rec); p.Detail != "a return of 501 units exceeds the 500 issued from batch LG-1" {
+		t.Fatalf("detail = %q", p.Detail)
+	}
+	if m := moved(models.MovementReturn, 50); m.BalanceAfter != 450 {
+		t.Fatalf("return = %+v", m)
+	}
+	rec = move(models.MovementDistribution, 451)
+	expect(t, rec, http.StatusConflict, "insufficient_stock")
+	if p := problem(t, rec); p.Detail != "batch LG-1 has 450 units left, fewer than the 451 requested" {
+		t.Fatalf("detail = %q", p.Detail)
+	}
+	expect(t, move(models.MovementDistribution, 0), http.StatusUnprocessableEntity, "validation_failed")
+	expect(t, move("theft", 1), http.StatusUnprocessableEntity, "validation_failed")
+	expect(t, a.do(mw.RoleReadOnly, "POST", "/batch/"+b.ID+"/stock", models.StockMovement{Type: models.MovementRelease, Quantity: 1}), http.StatusForbidden, "forbidden")
+	// A batch on the ledger is sold out by its movements, not by hand.
+	rec = a.do(mw.RoleManufacturer, "POST", "/batch/"+b.ID+"/mark-sold-out", nil)
+	expect(t, rec, http.StatusConflict, "illegal_transition")
+	if p := problem(t, rec); p.Detail != "illegal status transition: a batch with stock movements is sold out by the movement that uses up its last units" {
+		t.Fatalf("detail = %q", p.Detail)
+	}
+
+	// Once stock has moved, only the ledger changes the batch's quantities.
+	var got models.Batch
+	a.must(http.StatusOK, "GET", "/batch/"+b.ID, nil, &got)
+	got.QtyInBatch = 1100
+	rec = a.do(mw.RoleRegistryAdmin, "PUT", "/batch/"+b.ID, got)
+	expect(t, rec, http.StatusConflict, "stock_moved")
+	if p := problem(t, rec); p.Detail != "batch LG-1 has stock movements, so its qty_in_batch can no longer change; record a movement instead" {
+		t.Fatalf("detail = %q", p.Detail)
+	}
+	got.QtyInBatch = 1000
+	a.must(http.StatusOK, "PUT", "/batch/"+b.ID, got, &got)
+	if got.QtyRemaining != 450 {
+		t.Fatalf("after edit qty_remaining = %d", got.QtyRemaining)
+	}
+	// Before that, the balance follows qty_in_batch, but an edit cannot sell
+	// a released batch out.
+	fresh := a.seedBatch(drugID, "LG-2")
+	a.must(http.StatusOK, "PATCH", "/batch/"+fresh.ID, map[string]any{"qty_in_batch": 300}, &got)
+	if got.QtyRemaining != 300 {
+		t.Fatalf("after edit qty_remaining = %d", got.QtyRemaining)
+	}
+	a.must(http.StatusOK, "POST", "/batch/"+fresh.ID+"/release", nil, nil)
+	expect(t, a.do(mw.RoleManufacturer, "PATCH", "/batch/"+fresh.ID, map[string]any{"qty_in_batch": 0}), http.StatusConflict, "stock_status")
+	a.must(http.StatusOK, "GET", "/batch/"+fresh.ID, nil, &got)
+	if got.Status != models.BatchReleased || got.QtyRemaining != 300 {
+		t.Fatalf("batch = %+v", got)
+	}
+
+	// Distributions racing through the handler: exactly as many succeed as
+	// the balance covers, and the last one sells the batch out. This runs
+	// against the memory store's lock; the row lock of
+	// services.RecordStockMovement needs Postgres and is not exercised here.
+	var wg sync.WaitGroup
+	codes := make([]int, 20)
+	for i := range codes {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			codes[i] = move(models.MovementDistribution, 50).Code
+		}()
+	}
+	wg.Wait()
+	if n := len(slices.DeleteFunc(slices.Clone(codes), func(c int) bool { return c != http.StatusCreated })); n != 9 {
+		t.Fatalf("%d distributions succeeded, want 9: %v", n, codes)
+	}
+	var ledger models.StockLedger
+	a.must(http.StatusOK, "GET", "/batch/"+b.ID+"/stock", nil, &ledger)
+	if ledger.QtyRemaining != 0 || ledger.QtyInBatch != 1000 || len(ledger.Movements) != 12 {
+		t.Fatalf("ledger = %d/%d, %d movements", ledger.QtyRemaining, ledger.QtyInBatch, len(ledger.Movements))
+	}
+	if last := ledger.Movements[len(ledger.Movements)-1]; last.BalanceAfter != 0 {
+		t.Fatalf("last movement = %+v", last)
+	}
+	a.must(http.StatusOK, "GET", "/batch/"+b.ID, nil, &got)
+	if got.Status != models.BatchSoldOut {
+		t.Fatalf("status = %s", got.Status)
+	}
+	var history struct{ Items []models.BatchStatusChange }
+	a.must(http.StatusOK, "GET", "/batch/"+b.ID+"/history", nil, &history)
+	if last := history.Items[len(history.Items)-1]; last.ToStatus != models.BatchSoldOut || last.Reason != "stock balance reached zero" {
+		t.Fatalf("history = %+v", last)
+	}
+	// Only the ledger sells a batch out, and only stock can release it again.
+	expect(t, a.do(mw.RoleManufacturer, "POST", "/batch/"+b.ID+"/release", nil), http.StatusConflict, "illegal_transition")
+
+	// A return restocks a sold-out batch and releases it, so the returned
+	// units can be distributed again.
+	if m := moved(models.MovementReturn, 5); m.BalanceAfter != 5 || m.BatchStatus != models.BatchReleased {
+		t.Fatalf("return after sold out = %+v", m)
+	}
+	a.must(http.StatusOK, "GET", "/batch/"+b.ID+"/history", nil, &history)
+	if last := history.Items[len(history.Items)-1]; last.ToStatus != models.BatchReleased || last.Reason != "returned stock brought the balance above zero" {
+		t.Fatalf("history = %+v", last)
+	}
+	if m := moved(models.MovementDistribution, 5); m.BalanceAfter != 0 || m.BatchStatus != models.BatchSoldOut {
+		t.Fatalf("distribution after restock = %+v", m)
+	}
+	expect(t, a.do(mw.RoleRegistryAdmin, "DELETE", "/batch/"+b.ID, nil), http.StatusConflict, "in_use")
+	expect(t, a.do(mw.RoleReadOnly, "GET", "/batch/"+uuid.NewString()+"/stock", nil), http.StatusNotFound, "not_found")
+}
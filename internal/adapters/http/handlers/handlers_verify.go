@@ -0,0 +1,37 @@
+package handlers
+
+import (
+	"net/http"
+
+	"moh/internal/repository"
+	"moh/models"
+	"moh/shared"
+	"moh/shared/responed"
+
+	"github.com/gin-gonic/gin"
+)
+
+// VerifyHandler serves POST /verify, the public pack check: the payload of
+// a pack's GS1 DataMatrix, answered with genuine, recalled, expired or
+// unknown and the reasons, written in the Accept-Language language. A
+// payload that is not a GS1 element string is a 400.
+func VerifyHandler(verifier repository.Verifier) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var in models.VerifyRequest
+		if err := decodeStrict(c, &in); err != nil {
+			responed.Error(c, shared.BadRequest("invalid_json", "invalid_json", err.Error()))
+			return
+		}
+		out, err := verifier.Verify(c.Request.Context(), in)
+		if err != nil {
+			responed.Error(c, err)
+			return
+		}
+		tr := shared.Translator(c.GetHeader("Accept-Language"))
+		for i, r := range out.Reasons {
+			out.Reasons[i].Message = shared.Message(tr, r.Code, r.Args...)
+		}
+		c.Header("Content-Language", tr.Locale())
+		c.JSON(http.StatusOK, out)
+	}
+}
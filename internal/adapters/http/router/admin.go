@@ -0,0 +1,17 @@
+package router
+
+import (
+	"moh/internal/adapters/http/handlers"
+	"moh/internal/scheduler"
+	mw "moh/shared/middlewares"
+
+	"github.com/gin-gonic/gin"
+)
+
+// AdminRouter registers the operational endpoints under /admin, all of them
+// restricted to registry_admin.
+func AdminRouter(r *gin.Engine, keys *mw.KeySet, sched *scheduler.Scheduler) {
+	g := r.Group("/admin", mw.AuthGin(keys), mw.RequireRoles(mw.RoleRegistryAdmin))
+
+	g.GET("/jobs", handlers.ListJobsHandler(sched))
+}
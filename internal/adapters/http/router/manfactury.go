@@ -5,50 +5,173 @@ import (
 	"net/http"
 
 	"moh/internal/adapters/http/handlers"
+	"moh/internal/repository"
+	"moh/models"
+	mw "moh/shared/middlewares"
 
 	"github.com/gin-gonic/gin"
-	"github.com/jackc/pgx/v5/pgxpool"
 )
 
+// Options are the policy settings of the /manufacturer endpoints.
+type Options struct {
+	// BrandSimilarityThreshold is the look-alike/sound-alike score from
+	// which POST /drug needs ?override_lasa=true; 0 turns the check off.
+	BrandSimilarityThreshold float64
+
+	// PriceCeilingPolicy is what POST, PUT and PATCH /batch do with a price
+	// above the ceiling in force; empty means models.PriceCeilingReject.
+	PriceCeilingPolicy models.PriceCeilingPolicy
+}
+
 // ManufacturerRouter registers all endpoints under /manufacturer.
-func ManufacturerRouter(r *gin.Engine, db *pgxpool.Pool) {
+// Everything except /ping, /verify and preflight requires a valid JWT;
+// write access is further restricted by role:
+//
+//	read (GET)                       any role
+//	catalog, registry, registration  registry_admin
+//	drug prices                      registry_admin
+//	drug, batch create/update        registry_admin, manufacturer
+//	delete                           registry_admin
+//
+// Batch status transitions:
+//
+//	release, mark-sold-out           registry_admin, manufacturer
+//	hold                             registry_admin, inspector, manufacturer
+//	expire, deactivate               registry_admin
+//
+// mark-sold-out is for batches with no stock movements, whose stock is not
+// tracked here. Stock movements (release to market, distribution, return,
+// destruction) are registry_admin and manufacturer; the movement that
+// empties a released batch marks it sold out, and a return that restocks a
+// sold-out batch releases it again.
+//
+// Recalls (open, extend, close) are registry_admin and inspector; opening or
+// extending a recall is the only way to move a batch to recalled.
+//
+// Serialized packs:
+//
+//	upload serials                   registry_admin, manufacturer
+//	report events                    registry_admin, inspector, manufacturer, supply_chain
+//	custody, clones                  any role but supply_chain
+func ManufacturerRouter(r *gin.Engine, store *repository.Store, keys *mw.KeySet, opts Options) {
 	g := r.Group("/manufacturer")
 
 	// Health
 	g.GET("/ping", func(c *gin.Context) { c.String(http.StatusOK, "ok") })
 
+	// Pack verification, open to pharmacies and the public
+	g.POST("/verify", handlers.VerifyHandler(store.Verify))
+
 	// CORS preflight (optional)
 	g.OPTIONS("/*path", func(c *gin.Context) { c.Status(http.StatusNoContent) })
 
+	authed := g.Group("", mw.AuthGin(keys))
+	read := authed.Group("", mw.RequireRoles(mw.RoleRegistryAdmin, mw.RoleInspector, mw.RoleManufacturer, mw.RoleReadOnly))
+	admin := authed.Group("", mw.RequireRoles(mw.RoleRegistryAdmin))
+	supply := authed.Group("", mw.RequireRoles(mw.RoleRegistryAdmin, mw.RoleManufacturer))
+	inspect := authed.Group("", mw.RequireRoles(mw.RoleRegistryAdmin, mw.RoleInspector))
+	hold := authed.Group("", mw.RequireRoles(mw.RoleRegistryAdmin, mw.RoleInspector, mw.RoleManufacturer))
+	trace := authed.Group("", mw.RequireRoles(mw.RoleRegistryAdmin, mw.RoleInspector, mw.RoleManufacturer, mw.RoleSupplyChain))
+
 	// ===== Master (POST) =====
-	g.POST("/inn", handlers.AddAPIHandler(db))                // INN / API
-	g.POST("/route", handlers.AddRouteOfAdminHandler(db))     // Route
-	g.POST("/dosage", handlers.AddDosageFormHandler(db))      // Dosage form
-	g.POST("/strength", handlers.AddStrengthUnitHandler(db))  // Strength unit
-	g.POST("/auth-holder", handlers.AddAuthHolderHandler(db)) // Authorization holder
-	g.POST("/marketing-authorization", handlers.AddMarketingAuthorizationHandler(db))
-	g.POST("/manfactory", handlers.AddManufacturingSiteHandler(db)) // legacy alias
-	g.POST("/manufacturing-site", handlers.AddManufacturingSiteHandler(db))
+	admin.POST("/inn", handlers.AddAPIHandler(store.APIs))                        // INN / API
+	admin.POST("/route", handlers.AddRouteOfAdminHandler(store.RoutesOfAdmin))    // Route
+	admin.POST("/dosage", handlers.AddDosageFormHandler(store.DosageForms))       // Dosage form
+	admin.POST("/strength", handlers.AddStrengthUnitHandler(store.StrengthUnits)) // Strength unit
+	admin.POST("/auth-holder", handlers.AddAuthHolderHandler(store.AuthHolders))  // Authorization holder
+	admin.POST("/marketing-authorization", handlers.AddMarketingAuthorizationHandler(store.MarketingAuthorizations))
+	admin.POST("/manfactory", handlers.AddManufacturingSiteHandler(store.ManufacturingSites)) // legacy alias
+	admin.POST("/manufacturing-site", handlers.AddManufacturingSiteHandler(store.ManufacturingSites))
 
 	// ===== Domain (POST) =====
-	g.POST("/drug", handlers.AddDrugHandler(db))
-	g.POST("/drug-registration", handlers.AddDrugRegistrationHandler(db))
-	g.POST("/drug-registration/site", handlers.AddDrugRegistrationSiteHandler(db))
-	g.POST("/drug-registration/auth-holder", handlers.AddDrugRegistrationAuthHolderHandler(db))
-	g.POST("/batch", handlers.AddBatchHandler(db))
+	supply.POST("/drug", handlers.AddDrugHandler(store.Drugs, store.Brands, opts.BrandSimilarityThreshold))
+	supply.POST("/drug/ingredient", handlers.AddDrugIngredientHandler(store.DrugIngredients))
+	supply.POST("/drug/presentation", handlers.AddPresentationHandler(store.Presentations))
+	admin.POST("/drug-registration", handlers.AddDrugRegistrationHandler(store.Registrations))
+	admin.POST("/drug-registration/bundle", handlers.AddDrugRegistrationBundleHandler(store.Registrations))
+	admin.POST("/drug-registration/site", handlers.AddDrugRegistrationSiteHandler(store.RegistrationSites))
+	admin.POST("/drug-registration/auth-holder", handlers.AddDrugRegistrationAuthHolderHandler(store.RegistrationAuthHolders))
+	supply.POST("/batch", handlers.AddBatchHandler(store.Batches, opts.PriceCeilingPolicy))
+	admin.POST("/drug-price", handlers.AddDrugPriceHandler(store.Prices))
 
 	// ===== Simple list (GET) =====
-	g.GET("/inn", handlers.ListAPIsHandler(db))
-	g.GET("/route", handlers.ListRoutesOfAdminHandler(db))
-	g.GET("/dosage", handlers.ListDosageFormsHandler(db))
-	g.GET("/strength", handlers.ListStrengthUnitsHandler(db))
-	g.GET("/auth-holder", handlers.ListAuthHoldersHandler(db))
-	g.GET("/marketing-authorization", handlers.ListMarketingAuthorizationsHandler(db))
-	g.GET("/manufacturing-site", handlers.ListManufacturingSitesHandler(db))
-
-	g.GET("/drug", handlers.ListDrugsHandler(db))
-	g.GET("/registration", handlers.ListDrugRegistrationsHandler(db))
-	g.GET("/registration/site", handlers.ListDrugRegistrationSitesHandler(db))
-	g.GET("/registration/holder", handlers.ListDrugRegistrationAuthHoldersHandler(db))
-	g.GET("/batch", handlers.ListBatchesHandler(db))
+	read.GET("/inn", handlers.ListAPIsHandler(store.APIs))
+	read.GET("/route", handlers.ListRoutesOfAdminHandler(store.RoutesOfAdmin))
+	read.GET("/dosage", handlers.ListDosageFormsHandler(store.DosageForms))
+	read.GET("/strength", handlers.ListStrengthUnitsHandler(store.StrengthUnits))
+	read.GET("/strength/convert", handlers.ConvertStrengthHandler(store.Units))
+	read.GET("/auth-holder", handlers.ListAuthHoldersHandler(store.AuthHolders))
+	read.GET("/marketing-authorization", handlers.ListMarketingAuthorizationsHandler(store.MarketingAuthorizations))
+	read.GET("/manufacturing-site", handlers.ListManufacturingSitesHandler(store.ManufacturingSites))
+
+	read.GET("/drug", handlers.ListDrugsHandler(store.Drugs))
+	read.GET("/drug/ingredient", handlers.ListDrugIngredientsHandler(store.DrugIngredients))
+	read.GET("/drug/presentation", handlers.ListPresentationsHandler(store.Presentations))
+	read.GET("/drug/similar-brands", handlers.SimilarBrandsHandler(store.Brands, opts.BrandSimilarityThreshold))
+	read.GET("/registration", handlers.ListDrugRegistrationsHandler(store.Registrations))
+	read.GET("/registration/site", handlers.ListDrugRegistrationSitesHandler(store.RegistrationSites))
+	read.GET("/registration/holder", handlers.ListDrugRegistrationAuthHoldersHandler(store.RegistrationAuthHolders))
+	read.GET("/batch", handlers.ListBatchesHandler(store.Batches))
+	read.GET("/drug-price", handlers.ListDrugPricesHandler(store.Prices))
+	read.GET("/drug-price/violations", handlers.ListPriceViolationsHandler(store.Prices))
+
+	// ===== Search =====
+	read.GET("/search", handlers.SearchHandler(store.Search))
+
+	// ===== Item (GET / PUT / PATCH / DELETE by id) =====
+	// writers is the group allowed to PUT/PATCH; deletes are always admin-only.
+	item := func(path string, writers *gin.RouterGroup, get, put, patch, del gin.HandlerFunc) {
+		read.GET(path+"/:id", get)
+		writers.PUT(path+"/:id", put)
+		writers.PATCH(path+"/:id", patch)
+		admin.DELETE(path+"/:id", del)
+	}
+	item("/inn", admin, handlers.GetAPIHandler(store.APIs), handlers.UpdateAPIHandler(store.APIs), handlers.PatchAPIHandler(store.APIs), handlers.DeleteAPIHandler(store.APIs))
+	item("/route", admin, handlers.GetRouteOfAdminHandler(store.RoutesOfAdmin), handlers.UpdateRouteOfAdminHandler(store.RoutesOfAdmin), handlers.PatchRouteOfAdminHandler(store.RoutesOfAdmin), handlers.DeleteRouteOfAdminHandler(store.RoutesOfAdmin))
+	item("/dosage", admin, handlers.GetDosageFormHandler(store.DosageForms), handlers.UpdateDosageFormHandler(store.DosageForms), handlers.PatchDosageFormHandler(store.DosageForms), handlers.DeleteDosageFormHandler(store.DosageForms))
+	item("/strength", admin, handlers.GetStrengthUnitHandler(store.StrengthUnits), handlers.UpdateStrengthUnitHandler(store.StrengthUnits), handlers.PatchStrengthUnitHandler(store.StrengthUnits), handlers.DeleteStrengthUnitHandler(store.StrengthUnits))
+	item("/auth-holder", admin, handlers.GetAuthHolderHandler(store.AuthHolders), handlers.UpdateAuthHolderHandler(store.AuthHolders), handlers.PatchAuthHolderHandler(store.AuthHolders), handlers.DeleteAuthHolderHandler(store.AuthHolders))
+	item("/marketing-authorization", admin, handlers.GetMarketingAuthorizationHandler(store.MarketingAuthorizations), handlers.UpdateMarketingAuthorizationHandler(store.MarketingAuthorizations), handlers.PatchMarketingAuthorizationHandler(store.MarketingAuthorizations), handlers.DeleteMarketingAuthorizationHandler(store.MarketingAuthorizations))
+	item("/manufacturing-site", admin, handlers.GetManufacturingSiteHandler(store.ManufacturingSites), handlers.UpdateManufacturingSiteHandler(store.ManufacturingSites), handlers.PatchManufacturingSiteHandler(store.ManufacturingSites), handlers.DeleteManufacturingSiteHandler(store.ManufacturingSites))
+
+	item("/drug", supply, handlers.GetDrugHandler(store.Drugs), handlers.UpdateDrugHandler(store.Drugs), handlers.PatchDrugHandler(store.Drugs), handlers.DeleteDrugHandler(store.Drugs))
+	item("/drug/ingredient", supply, handlers.GetDrugIngredientHandler(store.DrugIngredients), handlers.UpdateDrugIngredientHandler(store.DrugIngredients), handlers.PatchDrugIngredientHandler(store.DrugIngredients), handlers.DeleteDrugIngredientHandler(store.DrugIngredients))
+	item("/drug/presentation", supply, handlers.GetPresentationHandler(store.Presentations), handlers.UpdatePresentationHandler(store.Presentations), handlers.PatchPresentationHandler(store.Presentations), handlers.DeletePresentationHandler(store.Presentations))
+	item("/drug-registration", admin, handlers.GetDrugRegistrationHandler(store.Registrations), handlers.UpdateDrugRegistrationHandler(store.Registrations), handlers.PatchDrugRegistrationHandler(store.Registrations), handlers.DeleteDrugRegistrationHandler(store.Registrations))
+	item("/drug-registration/site", admin, handlers.GetDrugRegistrationSiteHandler(store.RegistrationSites), handlers.UpdateDrugRegistrationSiteHandler(store.RegistrationSites), handlers.PatchDrugRegistrationSiteHandler(store.RegistrationSites), handlers.DeleteDrugRegistrationSiteHandler(store.RegistrationSites))
+	item("/drug-registration/auth-holder", admin, handlers.GetDrugRegistrationAuthHolderHandler(store.RegistrationAuthHolders), handlers.UpdateDrugRegistrationAuthHolderHandler(store.RegistrationAuthHolders), handlers.PatchDrugRegistrationAuthHolderHandler(store.RegistrationAuthHolders), handlers.DeleteDrugRegistrationAuthHolderHandler(store.RegistrationAuthHolders))
+	item("/drug-price", admin, handlers.GetDrugPriceHandler(store.Prices), handlers.UpdateDrugPriceHandler(store.Prices), handlers.PatchDrugPriceHandler(store.Prices), handlers.DeleteDrugPriceHandler(store.Prices))
+	item("/batch", supply, handlers.GetBatchHandler(store.Batches), handlers.UpdateBatchHandler(store.Batches, opts.PriceCeilingPolicy), handlers.PatchBatchHandler(store.Batches, opts.PriceCeilingPolicy), handlers.DeleteBatchHandler(store.Batches))
+
+	// ===== Equivalence =====
+	read.GET("/drug/:id/equivalents", handlers.DrugEquivalentsHandler(store.Equivalents))
+
+	// ===== Barcodes =====
+	read.GET("/gtin/:gtin", handlers.LookupGTINHandler(store.Presentations))
+
+	// ===== Batch status =====
+	supply.POST("/batch/:id/release", handlers.TransitionBatchHandler(store.Batches, models.BatchReleased))
+	hold.POST("/batch/:id/hold", handlers.TransitionBatchHandler(store.Batches, models.BatchOnHold))
+	admin.POST("/batch/:id/expire", handlers.TransitionBatchHandler(store.Batches, models.BatchExpired))
+	supply.POST("/batch/:id/mark-sold-out", handlers.TransitionBatchHandler(store.Batches, models.BatchSoldOut))
+	admin.POST("/batch/:id/deactivate", handlers.TransitionBatchHandler(store.Batches, models.BatchInactive))
+	read.GET("/batch/:id/history", handlers.ListBatchStatusHistoryHandler(store.Batches))
+
+	// ===== Stock ledger =====
+	supply.POST("/batch/:id/stock", handlers.RecordStockMovementHandler(store.Batches))
+	read.GET("/batch/:id/stock", handlers.StockLedgerHandler(store.Batches))
+
+	// ===== Recalls =====
+	inspect.POST("/recall", handlers.OpenRecallHandler(store.Recalls))
+	inspect.POST("/recall/:id/extend", handlers.ExtendRecallHandler(store.Recalls))
+	inspect.POST("/recall/:id/close", handlers.CloseRecallHandler(store.Recalls))
+	read.GET("/recall", handlers.ListRecallsHandler(store.Recalls))
+	read.GET("/recall/:id", handlers.GetRecallHandler(store.Recalls))
+
+	// ===== Serialized packs =====
+	supply.POST("/batch/:id/serials", handlers.CommissionSerialsHandler(store.Serials))
+	trace.POST("/serial/event", handlers.RecordSerialEventHandler(store.Serials))
+	read.GET("/serial", handlers.ListSerialsHandler(store.Serials))
+	read.GET("/serial/custody", handlers.SerialCustodyHandler(store.Serials))
+	read.GET("/serial/clones", handlers.ListSerialClonesHandler(store.Serials))
 }
@@ -0,0 +1,899 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"moh/internal/services"
+	"moh/models"
+	"moh/shared"
+
+	"github.com/google/uuid"
+)
+
+// memStore holds every in-memory table behind one lock, so foreign-key
+// checks and multi-table operations see a consistent state.
+type memStore struct {
+	mu sync.RWMutex
+
+	apis          *memTable[models.API, models.APIFilter]
+	dosageForms   *memTable[models.DosageForm, models.CodeNameFilter]
+	strengthUnits *memTable[models.StrengthUnit, models.CodeNameFilter]
+	routes        *memTable[models.RouteOfAdmin, models.CodeNameFilter]
+	authHolders   *memTable[models.AuthHolder, models.AuthHolderFilter]
+	mas           *memTable[models.MarketingAuthorization, models.CountryFilter]
+	sites         *memTable[models.ManufacturingSite, models.CountryFilter]
+	drugs         *memTable[models.Drug, models.DrugFilter]
+	ingredients   *memTable[models.DrugIngredient, models.DrugIngredientFilter]
+	presentations *memTable[models.Presentation, models.PresentationFilter]
+	regs          *memTable[models.DrugRegistration, models.DrugRegistrationFilter]
+	regSites      *memTable[models.DrugRegistrationSite, models.DrugRegistrationSiteFilter]
+	regHolders    *memTable[models.DrugRegistrationAuthHolder, models.DrugRegistrationAuthHolderFilter]
+	batches       *memTable[models.Batch, models.BatchFilter]
+	prices        *memTable[models.DrugPrice, models.DrugPriceFilter]
+
+	history   map[string][]models.BatchStatusChange // by batch id
+	recalls   map[string]models.Recall
+	recallSeq int
+
+	serials      map[string]models.Serial
+	serialEvents map[string][]models.SerialEvent // by serial id, as recorded
+
+	movements map[string][]models.StockMovement // by batch id
+}
+
+// NewMemory returns an empty Store kept in process memory. It enforces the
+// uniqueness, foreign-key and status rules of the Postgres schema and is
+// safe for concurrent use.
+func NewMemory() *Store {
+	s := &memStore{
+		history:      map[string][]models.BatchStatusChange{},
+		recalls:      map[string]models.Recall{},
+		serials:      map[string]models.Serial{},
+		serialEvents: map[string][]models.SerialEvent{},
+		movements:    map[string][]models.StockMovement{},
+	}
+
+	// usedByDrug is the refs hook of the tables drugs point at through ref.
+	usedByDrug := func(ref func(models.Drug) string) func(string) string {
+		return func(id string) string {
+			for _, d := range s.drugs.rows {
+				if ref(d) == id {
+					return "drugs"
+				}
+			}
+			return ""
+		}
+	}
+	// usedByIngredient is the same for the tables ingredients point at.
+	usedByIngredient := func(refs func(models.DrugIngredient) []string) func(string) string {
+		return func(id string) string {
+			for _, i := range s.ingredients.rows {
+				if slices.Contains(refs(i), id) {
+					return "drug_ingredients"
+				}
+			}
+			return ""
+		}
+	}
+
+	s.dosageForms = newCodeNameTable(s, func(m *models.DosageForm) codeNamedRef {
+		return codeNamedRef{&m.ID, &m.Code, &m.Name, &m.Names, &m.CreatedAt, &m.UpdatedAt}
+	}, usedByDrug(func(d models.Drug) string { return d.DosageFormID }))
+	s.strengthUnits = newCodeNameTable(s, func(m *models.StrengthUnit) codeNamedRef {
+		return codeNamedRef{&m.ID, &m.Code, &m.Name, &m.Names, &m.CreatedAt, &m.UpdatedAt}
+	}, firstRef(
+		usedByDrug(func(d models.Drug) string { return d.StrengthUnitID }),
+		usedByIngredient(func(i models.DrugIngredient) []string { return []string{i.StrengthUnitID, i.PerUnitID} }),
+		func(id string) string {
+			for _, p := range s.presentations.rows {
+				if p.PackUnitID == id {
+					return "presentations"
+				}
+			}
+			return ""
+		},
+	))
+	s.routes = newCodeNameTable(s, func(m *models.RouteOfAdmin) codeNamedRef {
+		return codeNamedRef{&m.ID, &m.Code, &m.Name, &m.Names, &m.CreatedAt, &m.UpdatedAt}
+	}, usedByDrug(func(d models.Drug) string { return d.RouteID }))
+
+	s.apis = newTable(s, tableSpec[models.API, models.APIFilter]{
+		id:     func(m *models.API) *string { return &m.ID },
+		times:  func(m *models.API) (**time.Time, **time.Time) { return &m.CreatedAt, &m.UpdatedAt },
+		unique: []func(models.API) (string, bool){func(m models.API) (string, bool) { return strings.ToLower(m.Name), true }},
+		dupKey: "name_exists",
+		refs: firstRef(
+			usedByDrug(func(d models.Drug) string { return d.APIID }),
+			usedByIngredient(func(i models.DrugIngredient) []string { return []string{i.APIID} }),
+		),
+		match: func(m models.API, f models.APIFilter) bool {
+			return (f.Status == "" || m.Status == f.Status) && (f.Q == "" || namesContain(m.Names, f.Q))
+		},
+		sorts: map[string]sortKey[models.API]{
+			"name":       func(m models.API) any { return nameSortKey(m.Name) },
+			"status":     func(m models.API) any { return string(m.Status) },
+			"created_at": func(m models.API) any { return timeKey(m.CreatedAt) },
+			"updated_at": func(m models.API) any { return timeKey(m.UpdatedAt) },
+		},
+		defaultSort: "name",
+	})
+
+	s.authHolders = newTable(s, tableSpec[models.AuthHolder, models.AuthHolderFilter]{
+		id:    func(m *models.AuthHolder) *string { return &m.ID },
+		times: func(m *models.AuthHolder) (**time.Time, **time.Time) { return &m.CreatedAt, &m.UpdatedAt },
+		unique: []func(models.AuthHolder) (string, bool){
+			func(m models.AuthHolder) (string, bool) { return strings.ToLower(m.Name), true },
+			func(m models.AuthHolder) (string, bool) { return m.RegistrationNumber, m.RegistrationNumber != "" },
+		},
+		dupKey: "auth_holder_exists",
+		refs: func(id string) string {
+			for _, l := range s.regHolders.rows {
+				if l.AuthHolderID == id {
+					return "drug_registration_auth_holders"
+				}
+			}
+			return ""
+		},
+		match: func(m models.AuthHolder, f models.AuthHolderFilter) bool {
+			return (f.RegistrationNumber == "" || m.RegistrationNumber == strings.TrimSpace(f.RegistrationNumber)) &&
+				(f.Q == "" || containsFold(m.Name, f.Q))
+		},
+		sorts: map[string]sortKey[models.AuthHolder]{
+			"name":                func(m models.AuthHolder) any { return lowerKey(m.Name) },
+			"registration_number": func(m models.AuthHolder) any { return m.RegistrationNumber },
+			"created_at":          func(m models.AuthHolder) any { return timeKey(m.CreatedAt) },
+			"updated_at":          func(m models.AuthHolder) any { return timeKey(m.UpdatedAt) },
+		},
+		defaultSort: "name",
+	})
+
+	s.mas = newCountryTable(s, func(m *models.MarketingAuthorization) countryNamedRef {
+		return countryNamedRef{&m.ID, &m.Name, nil, &m.Country, &m.CreatedAt, &m.UpdatedAt}
+	}, "marketing_authorization_exists", func(id string) string {
+		for _, r := range s.regs.rows {
+			if r.MAID == id {
+				return "drug_registrations"
+			}
+		}
+		return ""
+	})
+	s.sites = newCountryTable(s, func(m *models.ManufacturingSite) countryNamedRef {
+		return countryNamedRef{&m.ID, &m.Name, &m.Names, &m.Country, &m.CreatedAt, &m.UpdatedAt}
+	}, "manufacturing_site_exists", func(id string) string {
+		for _, l := range s.regSites.rows {
+			if l.SiteID == id {
+				return "drug_registration_sites"
+			}
+		}
+		return ""
+	})
+
+	s.drugs = newTable(s, tableSpec[models.Drug, models.DrugFilter]{
+		id:    func(m *models.Drug) *string { return &m.ID },
+		times: func(m *models.Drug) (**time.Time, **time.Time) { return &m.CreatedAt, &m.UpdatedAt },
+		unique: []func(models.Drug) (string, bool){func(m models.Drug) (string, bool) {
+			return fmt.Sprintf("%s\x00%s\x00%s\x00%s\x00%s\x00%v", strings.ToLower(m.BrandName), m.APIID, m.DosageFormID, m.RouteID, m.StrengthUnitID, m.Dose.Reduced()), true
+		}},
+		dupKey: "drug_exists",
+		fks: func(m models.Drug) []fk {
+			return []fk{
+				{"dosage_form_id", has(s.dosageForms.rows, m.DosageFormID)},
+				{"route_id", has(s.routes.rows, m.RouteID)},
+				{"strength_unit_id", has(s.strengthUnits.rows, m.StrengthUnitID)},
+				{"api_id", has(s.apis.rows, m.APIID)},
+			}
+		},
+		refs: func(id string) string {
+			for _, r := range s.regs.rows {
+				if r.DrugID == id {
+					return "drug_registrations"
+				}
+			}
+			for _, b := range s.batches.rows {
+				if b.DrugID == id {
+					return "batches"
+				}
+			}
+			for _, p := range s.prices.rows {
+				if p.DrugID == id {
+					return "drug_prices"
+				}
+			}
+			return ""
+		},
+		// The drug's API, unit and dose are its first ingredient; see
+		// services.AddDrug and services.UpdateDrug.
+		afterInsert: func(m models.Drug) {
+			id := uuid.NewString()
+			s.ingredients.rows[id] = models.DrugIngredient{
+				ID: id, DrugID: m.ID, APIID: m.APIID, Strength: m.Dose, StrengthUnitID: m.StrengthUnitID,
+				CreatedAt: m.CreatedAt, UpdatedAt: m.UpdatedAt,
+			}
+		},
+		afterUpdate: func(old, m models.Drug) error {
+			for id, i := range s.ingredients.rows {
+				if i.DrugID != m.ID || i.APIID != old.APIID {
+					continue
+				}
+				if i.APIID == m.APIID && i.Strength.Cmp(m.Dose) == 0 && i.StrengthUnitID == m.StrengthUnitID {
+					return nil
+				}
+				for _, other := range s.ingredients.rows {
+					if other.ID != id && other.DrugID == m.ID && other.APIID == m.APIID {
+						return shared.Conflict("already_exists", "ingredient_exists")
+					}
+				}
+				i.APIID, i.Strength, i.StrengthUnitID, i.UpdatedAt = m.APIID, m.Dose, m.StrengthUnitID, m.UpdatedAt
+				s.ingredients.rows[id] = i
+			}
+			return nil
+		},
+		onDelete: func(id string) {
+			for iid, i := range s.ingredients.rows {
+				if i.DrugID == id {
+					delete(s.ingredients.rows, iid)
+				}
+			}
+			for pid, p := range s.presentations.rows {
+				if p.DrugID == id {
+					delete(s.presentations.rows, pid)
+				}
+			}
+		},
+		match: func(m models.Drug, f models.DrugFilter) bool {
+			return (f.APIID == "" || m.APIID == f.APIID || s.hasIngredient(m.ID, f.APIID)) &&
+				(f.DosageFormID == "" || m.DosageFormID == f.DosageFormID) &&
+				(f.RouteID == "" || m.RouteID == f.RouteID) &&
+				(f.StrengthUnitID == "" || m.StrengthUnitID == f.StrengthUnitID) &&
+				(f.Q == "" || namesContain(m.BrandNames, f.Q))
+		},
+		sorts: map[string]sortKey[models.Drug]{
+			"brand_name": func(m models.Drug) any { return nameSortKey(m.BrandName) },
+			"dose":       func(m models.Drug) any { return m.Dose },
+			"created_at": func(m models.Drug) any { return timeKey(m.CreatedAt) },
+			"updated_at": func(m models.Drug) any { return timeKey(m.UpdatedAt) },
+		},
+		defaultSort: "brand_name",
+	})
+
+	s.ingredients = newTable(s, tableSpec[models.DrugIngredient, models.DrugIngredientFilter]{
+		id:    func(m *models.DrugIngredient) *string { return &m.ID },
+		times: func(m *models.DrugIngredient) (**time.Time, **time.Time) { return &m.CreatedAt, &m.UpdatedAt },
+		unique: []func(models.DrugIngredient) (string, bool){func(m models.DrugIngredient) (string, bool) {
+			return m.DrugID + "/" + m.APIID, true
+		}},
+		dupKey: "ingredient_exists",
+		fks: func(m models.DrugIngredient) []fk {
+			return []fk{
+				{"drug_id", has(s.drugs.rows, m.DrugID)},
+				{"api_id", has(s.apis.rows, m.APIID)},
+				{"strength_unit_id", has(s.strengthUnits.rows, m.StrengthUnitID)},
+				{"per_unit_id", m.PerUnitID == "" || has(s.strengthUnits.rows, m.PerUnitID)},
+			}
+		},
+		match: func(m models.DrugIngredient, f models.DrugIngredientFilter) bool {
+			return (f.DrugID == "" || m.DrugID == f.DrugID) && (f.APIID == "" || m.APIID == f.APIID)
+		},
+		sorts: map[string]sortKey[models.DrugIngredient]{
+			"strength":   func(m models.DrugIngredient) any { return m.Strength },
+			"created_at": func(m models.DrugIngredient) any { return timeKey(m.CreatedAt) },
+			"updated_at": func(m models.DrugIngredient) any { return timeKey(m.UpdatedAt) },
+		},
+		defaultSort: "created_at",
+	})
+
+	s.regs = newTable(s, tableSpec[models.DrugRegistration, models.DrugRegistrationFilter]{
+		id:    func(m *models.DrugRegistration) *string { return &m.ID },
+		times: func(m *models.DrugRegistration) (**time.Time, **time.Time) { return &m.CreatedAt, &m.UpdatedAt },
+		unique: []func(models.DrugRegistration) (string, bool){
+			func(m models.DrugRegistration) (string, bool) {
+				return "number:" + m.RegistrationNumber, m.RegistrationNumber != ""
+			},
+			func(m models.DrugRegistration) (string, bool) { return "primary:" + m.DrugID, m.IsPrimary },
+		},
+		dupKey: "registration_exists",
+		fks: func(m models.DrugRegistration) []fk {
+			return []fk{{"drug_id", has(s.drugs.rows, m.DrugID)}, {"ma_id", has(s.mas.rows, m.MAID)}}
+		},
+		refs: func(id string) string {
+			for _, l := range s.regSites.rows {
+				if l.DrugRegistrationID == id {
+					return "drug_registration_sites"
+				}
+			}
+			for _, l := range s.regHolders.rows {
+				if l.DrugRegistrationID == id {
+					return "drug_registration_auth_holders"
+				}
+			}
+			for _, b := range s.batches.rows {
+				if b.DrugRegistrationID == id {
+					return "batches"
+				}
+			}
+			return ""
+		},
+		match: func(m models.DrugRegistration, f models.DrugRegistrationFilter) bool {
+			return (f.Status == "" || m.Status == f.Status) &&
+				(f.DrugID == "" || m.DrugID == f.DrugID) &&
+				(f.MAID == "" || m.MAID == f.MAID) &&
+				(f.ValidOn.IsZero() || (!m.ValidFrom.After(f.ValidOn) && !m.ValidTo.Before(f.ValidOn))) &&
+				(f.IsPrimary == nil || m.IsPrimary == *f.IsPrimary)
+		},
+		sorts: map[string]sortKey[models.DrugRegistration]{
+			"valid_to":            func(m models.DrugRegistration) any { return m.ValidTo },
+			"valid_from":          func(m models.DrugRegistration) any { return m.ValidFrom },
+			"registration_number": func(m models.DrugRegistration) any { return m.RegistrationNumber },
+			"created_at":          func(m models.DrugRegistration) any { return timeKey(m.CreatedAt) },
+			"updated_at":          func(m models.DrugRegistration) any { return timeKey(m.UpdatedAt) },
+		},
+		defaultSort: "-valid_to",
+	})
+
+	s.regSites = newTable(s, tableSpec[models.DrugRegistrationSite, models.DrugRegistrationSiteFilter]{
+		id: func(m *models.DrugRegistrationSite) *string { return &m.ID },
+		unique: []func(models.DrugRegistrationSite) (string, bool){func(m models.DrugRegistrationSite) (string, bool) {
+			return m.DrugRegistrationID + "/" + m.SiteID, true
+		}},
+		dupKey: "link_exists",
+		fks: func(m models.DrugRegistrationSite) []fk {
+			return []fk{{"drug_registration_id", has(s.regs.rows, m.DrugRegistrationID)}, {"site_id", has(s.sites.rows, m.SiteID)}}
+		},
+		match: func(m models.DrugRegistrationSite, f models.DrugRegistrationSiteFilter) bool {
+			return (f.DrugRegistrationID == "" || m.DrugRegistrationID == f.DrugRegistrationID) &&
+				(f.SiteID == "" || m.SiteID == f.SiteID)
+		},
+		sorts:       map[string]sortKey[models.DrugRegistrationSite]{"role": func(m models.DrugRegistrationSite) any { return m.Role }},
+		defaultSort: "role",
+	})
+
+	s.regHolders = newTable(s, tableSpec[models.DrugRegistrationAuthHolder, models.DrugRegistrationAuthHolderFilter]{
+		id: func(m *models.DrugRegistrationAuthHolder) *string { return &m.ID },
+		unique: []func(models.DrugRegistrationAuthHolder) (string, bool){func(m models.DrugRegistrationAuthHolder) (string, bool) {
+			return m.DrugRegistrationID + "/" + m.AuthHolderID, true
+		}},
+		dupKey: "link_exists",
+		fks: func(m models.DrugRegistrationAuthHolder) []fk {
+			return []fk{{"drug_registration_id", has(s.regs.rows, m.DrugRegistrationID)}, {"auth_holder_id", has(s.authHolders.rows, m.AuthHolderID)}}
+		},
+		match: func(m models.DrugRegistrationAuthHolder, f models.DrugRegistrationAuthHolderFilter) bool {
+			return (f.DrugRegistrationID == "" || m.DrugRegistrationID == f.DrugRegistrationID) &&
+				(f.AuthHolderID == "" || m.AuthHolderID == f.AuthHolderID)
+		},
+		sorts:       map[string]sortKey[models.DrugRegistrationAuthHolder]{"role": func(m models.DrugRegistrationAuthHolder) any { return m.Role }},
+		defaultSort: "role",
+	})
+
+	s.presentations = newPresentationTable(s)
+	s.batches = newBatchTable(s)
+	s.prices = newPriceTable(s)
+
+	return &Store{
+		APIs:                    s.apis,
+		DosageForms:             s.dosageForms,
+		StrengthUnits:           s.strengthUnits,
+		RoutesOfAdmin:           s.routes,
+		Units:                   memUnits{s},
+		Equivalents:             memEquivalents{s},
+		AuthHolders:             s.authHolders,
+		MarketingAuthorizations: s.mas,
+		ManufacturingSites:      s.sites,
+		Drugs:                   s.drugs,
+		DrugIngredients:         s.ingredients,
+		Presentations:           memPresentations{s.presentations},
+		Brands:                  memBrands{s},
+		Registrations:           memRegistrations{s.regs},
+		RegistrationSites:       s.regSites,
+		RegistrationAuthHolders: s.regHolders,
+		Batches:                 memBatches{s.batches},
+		Prices:                  memPrices{s.prices},
+		Recalls:                 memRecalls{s},
+		Serials:                 memSerials{s},
+		Search:                  memSearch{s},
+		Verify:                  memVerifier{s},
+	}
+}
+
+// tableSpec describes the columns and constraints of one in-memory table.
+// Every hook runs with the store lock held.
+type tableSpec[T, F any] struct {
+	id    func(*T) *string
+	times func(*T) (created, updated **time.Time) // nil for tables without timestamps
+
+	unique []func(T) (string, bool) // unique keys; false leaves a row out (partial index)
+	dupKey string
+	fks    func(T) []fk        // the row's references, in column order
+	refs   func(string) string // a table still referencing the row, or ""
+
+	beforeAdd    func(*T) error
+	beforeUpdate func(old T, in *T) error
+	afterInsert  func(T)               // writes to other tables, once the row is in
+	afterUpdate  func(old, in T) error // writes to other tables; an error leaves the row as it was
+	onDelete     func(id string)       // ON DELETE CASCADE
+
+	match       func(T, F) bool
+	sorts       map[string]sortKey[T]
+	defaultSort string
+}
+
+// memTable is a CRUD repository over one map of rows.
+type memTable[T, F any] struct {
+	s    *memStore
+	rows map[string]T
+	spec tableSpec[T, F]
+}
+
+func newTable[T, F any](s *memStore, spec tableSpec[T, F]) *memTable[T, F] {
+	return &memTable[T, F]{s: s, rows: map[string]T{}, spec: spec}
+}
+
+func (t *memTable[T, F]) Add(ctx context.Context, in T) (T, error) {
+	*t.spec.id(&in) = uuid.NewString()
+	if t.spec.beforeAdd != nil {
+		if err := t.spec.beforeAdd(&in); err != nil {
+			var zero T
+			return zero, err
+		}
+	}
+	if err := prepare(&in); err != nil {
+		var zero T
+		return zero, err
+	}
+	t.s.mu.Lock()
+	defer t.s.mu.Unlock()
+	return t.insert(in)
+}
+
+// insert stores a prepared row. The caller holds the lock.
+func (t *memTable[T, F]) insert(in T) (T, error) {
+	if err := t.check(in); err != nil {
+		var zero T
+		return zero, err
+	}
+	if t.spec.times != nil {
+		now := memNow()
+		created, updated := t.spec.times(&in)
+		*created, *updated = &now, &now
+	}
+	t.rows[*t.spec.id(&in)] = in
+	if t.spec.afterInsert != nil {
+		t.spec.afterInsert(in)
+	}
+	return in, nil
+}
+
+func (t *memTable[T, F]) Get(ctx context.Context, id string) (T, error) {
+	t.s.mu.RLock()
+	defer t.s.mu.RUnlock()
+	row, ok := t.rows[id]
+	if !ok {
+		var zero T
+		return zero, services.ErrNotFound
+	}
+	return row, nil
+}
+
+func (t *memTable[T, F]) Update(ctx context.Context, id string, in T) (T, error) {
+	var zero T
+	*t.spec.id(&in) = id
+	if err := prepare(&in); err != nil {
+		return zero, err
+	}
+	t.s.mu.Lock()
+	defer t.s.mu.Unlock()
+
+	old, ok := t.rows[id]
+	if !ok {
+		return zero, services.ErrNotFound
+	}
+	if t.spec.beforeUpdate != nil {
+		if err := t.spec.beforeUpdate(old, &in); err != nil {
+			return zero, err
+		}
+	}
+	if err := t.check(in); err != nil {
+		return zero, err
+	}
+	if t.spec.times != nil {
+		now := memNow()
+		oldCreated, _ := t.spec.times(&old)
+		created, updated := t.spec.times(&in)
+		*created, *updated = *oldCreated, &now
+	}
+	if t.spec.afterUpdate != nil {
+		if err := t.spec.afterUpdate(old, in); err != nil {
+			return zero, err
+		}
+	}
+	t.rows[id] = in
+	return in, nil
+}
+
+func (t *memTable[T, F]) Delete(ctx context.Context, id string) error {
+	t.s.mu.Lock()
+	defer t.s.mu.Unlock()
+	if _, ok := t.rows[id]; !ok {
+		return services.ErrNotFound
+	}
+	if t.spec.refs != nil {
+		if table := t.spec.refs(id); table != "" {
+			return services.InUse(table)
+		}
+	}
+	if t.spec.onDelete != nil {
+		t.spec.onDelete(id)
+	}
+	delete(t.rows, id)
+	return nil
+}
+
+func (t *memTable[T, F]) List(ctx context.Context, f F, p models.ListParams) (models.Page[T], error) {
+	t.s.mu.RLock()
+	rows := make([]T, 0, len(t.rows))
+	for _, r := range t.rows {
+		if t.spec.match(r, f) {
+			rows = append(rows, r)
+		}
+	}
+	t.s.mu.RUnlock()
+	return listRows(rows, func(m T) string { return *t.spec.id(&m) }, t.spec.sorts, t.spec.defaultSort, p)
+}
+
+// check enforces the unique keys, then the foreign keys, of a row about to
+// be written, in the order Postgres reports them.
+func (t *memTable[T, F]) check(in T) error {
+	id := *t.spec.id(&in)
+	for _, key := range t.spec.unique {
+		k, ok := key(in)
+		if !ok {
+			continue
+		}
+		for otherID, other := range t.rows {
+			if otherID == id {
+				continue
+			}
+			if k2, ok := key(other); ok && k2 == k {
+				return shared.Conflict("already_exists", t.spec.dupKey)
+			}
+		}
+	}
+	if t.spec.fks != nil {
+		for _, ref := range t.spec.fks(in) {
+			if !ref.ok {
+				return shared.ForeignKey(ref.column, "")
+			}
+		}
+	}
+	return nil
+}
+
+// prepare normalizes and validates a row (a pointer to a model) the way the
+// services do.
+func prepare(v any) error {
+	if n, ok := v.(interface{ Normalize() }); ok {
+		n.Normalize()
+	}
+	if m, ok := v.(interface{ Validate() error }); ok {
+		if err := m.Validate(); err != nil {
+			return models.ValidationError(err)
+		}
+	}
+	return nil
+}
+
+// fk is one foreign-key column of a row and whether the row it refers to
+// exists.
+type fk struct {
+	column string
+	ok     bool
+}
+
+func has[T any](rows map[string]T, id string) bool {
+	_, ok := rows[id]
+	return ok
+}
+
+// firstRef combines refs hooks, reporting the first table found.
+func firstRef(refs ...func(string) string) func(string) string {
+	return func(id string) string {
+		for _, ref := range refs {
+			if table := ref(id); table != "" {
+				return table
+			}
+		}
+		return ""
+	}
+}
+
+// hasIngredient reports whether drug contains api. The caller holds the lock.
+func (s *memStore) hasIngredient(drug, api string) bool {
+	for _, i := range s.ingredients.rows {
+		if i.DrugID == drug && i.APIID == api {
+			return true
+		}
+	}
+	return false
+}
+
+// memNow is the timestamp written to created_at/updated_at, at the
+// precision Postgres keeps.
+func memNow() time.Time { return time.Now().UTC().Truncate(time.Microsecond) }
+
+// ===== Tables sharing a shape =====
+
+// codeNamed is the sortable view of a dosage form, strength unit or route.
+type codeNamed struct {
+	code, name       string
+	names            models.LocalizedName
+	created, updated *time.Time
+}
+
+type codeNamedRef struct {
+	id, code, name   *string
+	names            *models.LocalizedName
+	created, updated **time.Time
+}
+
+// newCodeNameTable builds the table of a catalog entity with a unique code.
+func newCodeNameTable[T any](s *memStore, fields func(*T) codeNamedRef, refs func(string) string) *memTable[T, models.CodeNameFilter] {
+	view := func(m T) codeNamed {
+		f := fields(&m)
+		return codeNamed{*f.code, *f.name, *f.names, *f.created, *f.updated}
+	}
+	return newTable(s, tableSpec[T, models.CodeNameFilter]{
+		id:     func(m *T) *string { return fields(m).id },
+		times:  func(m *T) (**time.Time, **time.Time) { f := fields(m); return f.created, f.updated },
+		unique: []func(T) (string, bool){func(m T) (string, bool) { return view(m).code, true }},
+		dupKey: "code_exists",
+		refs:   refs,
+		match: func(m T, f models.CodeNameFilter) bool {
+			v := view(m)
+			return (f.Code == "" || v.code == strings.ToUpper(strings.TrimSpace(f.Code))) &&
+				(f.Q == "" || containsFold(v.code, f.Q) || namesContain(v.names, f.Q))
+		},
+		sorts: map[string]sortKey[T]{
+			"code":       func(m T) any { return view(m).code },
+			"name":       func(m T) any { return nameSortKey(view(m).name) },
+			"created_at": func(m T) any { return timeKey(view(m).created) },
+			"updated_at": func(m T) any { return timeKey(view(m).updated) },
+		},
+		defaultSort: "code",
+	})
+}
+
+type countryNamedRef struct {
+	id, name         *string
+	names            *models.LocalizedName // nil for entities with a single name
+	country          *string
+	created, updated **time.Time
+}
+
+// newCountryTable builds the table of a registry entity unique by name and country.
+func newCountryTable[T any](s *memStore, fields func(*T) countryNamedRef, dupKey string, refs func(string) string) *memTable[T, models.CountryFilter] {
+	return newTable(s, tableSpec[T, models.CountryFilter]{
+		id:    func(m *T) *string { return fields(m).id },
+		times: func(m *T) (**time.Time, **time.Time) { f := fields(m); return f.created, f.updated },
+		unique: []func(T) (string, bool){func(m T) (string, bool) {
+			f := fields(&m)
+			return strings.ToLower(*f.name) + "/" + *f.country, true
+		}},
+		dupKey: dupKey,
+		refs:   refs,
+		match: func(m T, f models.CountryFilter) bool {
+			r := fields(&m)
+			q := f.Q == "" || containsFold(*r.name, f.Q)
+			if r.names != nil {
+				q = f.Q == "" || namesContain(*r.names, f.Q)
+			}
+			return (f.Country == "" || *r.country == strings.ToUpper(strings.TrimSpace(f.Country))) && q
+		},
+		sorts: map[string]sortKey[T]{
+			"name": func(m T) any {
+				if r := fields(&m); r.names != nil {
+					return nameSortKey(*r.name)
+				}
+				return lowerKey(*fields(&m).name)
+			},
+			"country":    func(m T) any { return *fields(&m).country },
+			"created_at": func(m T) any { return timeKey(*fields(&m).created) },
+			"updated_at": func(m T) any { return timeKey(*fields(&m).updated) },
+		},
+		defaultSort: "name",
+	})
+}
+
+// ===== Units =====
+
+type memUnits struct{ s *memStore }
+
+// Convert follows services.ConvertStrength.
+func (r memUnits) Convert(ctx context.Context, in models.UnitConversion) (models.UnitConversion, error) {
+	if err := in.Validate(); err != nil {
+		return models.UnitConversion{}, models.QueryError(err)
+	}
+	r.s.mu.RLock()
+	from, okFrom := r.s.strengthUnits.rows[in.From]
+	to, okTo := r.s.strengthUnits.rows[in.To]
+	r.s.mu.RUnlock()
+	switch {
+	case !okFrom:
+		return models.UnitConversion{}, services.InvalidQuery("unknown_unit", in.From)
+	case !okTo:
+		return models.UnitConversion{}, services.InvalidQuery("unknown_unit", in.To)
+	}
+	return services.ConvertUnits(in.Value, from, to)
+}
+
+// ===== Equivalents =====
+
+type memEquivalents struct{ s *memStore }
+
+// Find follows services.DrugEquivalents.
+func (r memEquivalents) Find(ctx context.Context, drugID string) (models.DrugEquivalents, error) {
+	s := r.s
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	drug, ok := s.drugs.rows[drugID]
+	if !ok {
+		return models.DrugEquivalents{}, services.ErrNotFound
+	}
+	group := s.dosageForms.rows[drug.DosageFormID].Group
+	today := dateOf(time.Now())
+
+	var candidates []models.DrugEquivalent
+	for _, d := range s.drugs.rows {
+		if d.ID == drug.ID || d.RouteID != drug.RouteID {
+			continue
+		}
+		if d.DosageFormID != drug.DosageFormID && (group == "" || s.dosageForms.rows[d.DosageFormID].Group != group) {
+			continue
+		}
+		reg, ok := s.activeRegistration(d.ID, today)
+		if !ok {
+			continue
+		}
+		c := models.DrugEquivalent{Drug: d, RegistrationID: reg.ID, RegistrationNumber: reg.RegistrationNumber}
+		for _, b := range s.batches.rows {
+			if b.DrugID == d.ID && b.Status == models.BatchReleased {
+				c.ReleasedBatches++
+			}
+		}
+		if c.ReleasedBatches > 0 {
+			candidates = append(candidates, c)
+		}
+	}
+	services.SortEquivalents(candidates)
+
+	var ingredients []models.DrugIngredient
+	for _, i := range s.ingredients.rows {
+		ingredients = append(ingredients, i)
+	}
+	units := make([]models.StrengthUnit, 0, len(s.strengthUnits.rows))
+	for _, u := range s.strengthUnits.rows {
+		units = append(units, u)
+	}
+	return services.MatchEquivalents(drug.ID, candidates, ingredients, units), nil
+}
+
+// activeRegistration is the registration drug id is marketed under on day:
+// active and in force, preferring the primary one, then the longest valid.
+func (s *memStore) activeRegistration(drugID string, day time.Time) (models.DrugRegistration, bool) {
+	var best models.DrugRegistration
+	found := false
+	for _, r := range s.regs.rows {
+		if r.DrugID != drugID || r.Status != models.RegistrationActive || day.Before(dateOf(r.ValidFrom)) || day.After(dateOf(r.ValidTo)) {
+			continue
+		}
+		better := !found || r.IsPrimary && !best.IsPrimary
+		if found && r.IsPrimary == best.IsPrimary {
+			better = r.ValidTo.After(best.ValidTo) || r.ValidTo.Equal(best.ValidTo) && r.ID < best.ID
+		}
+		if better {
+			best, found = r, true
+		}
+	}
+	return best, found
+}
+
+// ===== Brands =====
+
+type memBrands struct{ s *memStore }
+
+func (r memBrands) Similar(ctx context.Context, in models.BrandCheck) ([]models.BrandMatch, error) {
+	in.Normalize()
+	if err := in.Validate(); err != nil {
+		return nil, models.QueryError(err)
+	}
+	r.s.mu.RLock()
+	candidates := make([]services.BrandCandidate, 0, len(r.s.drugs.rows))
+	for _, d := range r.s.drugs.rows {
+		candidates = append(candidates, services.BrandCandidate{DrugID: d.ID, BrandName: d.BrandName, BrandNames: d.BrandNames})
+	}
+	r.s.mu.RUnlock()
+	return services.RankBrands(in, candidates), nil
+}
+
+// Check follows services.CheckBrand.
+func (r memBrands) Check(ctx context.Context, in models.Drug, threshold float64, override bool) error {
+	check, ok := services.DrugBrandCheck(in, threshold, override)
+	if !ok {
+		return nil
+	}
+	matches, err := r.Similar(ctx, check)
+	if err != nil {
+		return err
+	}
+	return services.SimilarBrandError(matches, threshold)
+}
+
+// ===== Registrations =====
+
+type memRegistrations struct {
+	*memTable[models.DrugRegistration, models.DrugRegistrationFilter]
+}
+
+// CreateBundle stores the registration and its links under one lock and
+// removes whatever it stored if a later part fails.
+func (r memRegistrations) CreateBundle(ctx context.Context, in models.DrugRegistrationBundle) (models.DrugRegistrationBundle, error) {
+	if err := in.Validate(); err != nil {
+		return models.DrugRegistrationBundle{}, models.ValidationError(err)
+	}
+
+	s := r.s
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	out := models.DrugRegistrationBundle{
+		Sites:       make([]models.DrugRegistrationSite, 0, len(in.Sites)),
+		AuthHolders: make([]models.DrugRegistrationAuthHolder, 0, len(in.AuthHolders)),
+	}
+	rollback := func() {
+		for _, l := range out.Sites {
+			delete(s.regSites.rows, l.ID)
+		}
+		for _, l := range out.AuthHolders {
+			delete(s.regHolders.rows, l.ID)
+		}
+		delete(s.regs.rows, out.Registration.ID)
+	}
+
+	reg := in.Registration
+	reg.ID = uuid.NewString()
+	if err := prepare(&reg); err != nil {
+		return models.DrugRegistrationBundle{}, shared.Within("registration", err)
+	}
+	reg, err := s.regs.insert(reg)
+	if err != nil {
+		return models.DrugRegistrationBundle{}, shared.Within("registration", err)
+	}
+	out.Registration = reg
+
+	for i, site := range in.Sites {
+		site.ID, site.DrugRegistrationID = uuid.NewString(), reg.ID
+		if err = prepare(&site); err == nil {
+			site, err = s.regSites.insert(site)
+		}
+		if err != nil {
+			rollback()
+			return models.DrugRegistrationBundle{}, shared.Within(fmt.Sprintf("sites[%d]", i), err)
+		}
+		out.Sites = append(out.Sites, site)
+	}
+	for i, h := range in.AuthHolders {
+		h.ID, h.DrugRegistrationID = uuid.NewString(), reg.ID
+		if err = prepare(&h); err == nil {
+			h, err = s.regHolders.insert(h)
+		}
+		if err != nil {
+			rollback()
+			return models.DrugRegistrationBundle{}, shared.Within(fmt.Sprintf("auth_holders[%d]", i), err)
+		}
+		out.AuthHolders = append(out.AuthHolders, h)
+	}
+	return out, nil
+}
@@ -0,0 +1,430 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"moh/internal/services"
+	"moh/models"
+	"moh/shared"
+
+	"github.com/google/uuid"
+)
+
+func newBatchTable(s *memStore) *memTable[models.Batch, models.BatchFilter] {
+	return newTable(s, tableSpec[models.Batch, models.BatchFilter]{
+		id:    func(m *models.Batch) *string { return &m.ID },
+		times: func(m *models.Batch) (**time.Time, **time.Time) { return &m.CreatedAt, &m.UpdatedAt },
+		unique: []func(models.Batch) (string, bool){func(m models.Batch) (string, bool) {
+			return m.DrugID + "/" + m.BatchNumber, true
+		}},
+		dupKey: "batch_exists",
+		fks: func(m models.Batch) []fk {
+			return []fk{
+				{"drug_id", has(s.drugs.rows, m.DrugID)},
+				{"drug_registration_id", m.DrugRegistrationID == "" || has(s.regs.rows, m.DrugRegistrationID)},
+				{"presentation_id", m.PresentationID == "" || s.presentations.rows[m.PresentationID].DrugID == m.DrugID},
+			}
+		},
+		refs: func(id string) string {
+			for _, r := range s.recalls {
+				if slices.Contains(r.BatchIDs, id) {
+					return "recall_batches"
+				}
+			}
+			if s.serialized(id) {
+				return "serials"
+			}
+			if len(s.movements[id]) > 0 {
+				return "stock_movements"
+			}
+			return ""
+		},
+		beforeAdd: func(m *models.Batch) error {
+			if m.Status == "" {
+				m.Status = models.BatchPlanned
+			}
+			if !m.Status.IsInitial() {
+				return shared.Validation(shared.NewFieldError("status", "oneof", "planned released", "initial_status", string(m.Status)))
+			}
+			m.QtyRemaining = m.QtyInBatch
+			return nil
+		},
+		beforeUpdate: func(old models.Batch, in *models.Batch) error {
+			if in.Status != old.Status {
+				return services.IllegalTransition("use_transition_endpoints")
+			}
+			var err error
+			in.QtyRemaining, err = services.BatchQtyRemaining(old, in.QtyInBatch, len(s.movements[old.ID]) > 0)
+			return err
+		},
+		onDelete: func(id string) { delete(s.history, id) },
+		match: func(m models.Batch, f models.BatchFilter) bool {
+			return (f.Status == "" || m.Status == f.Status) &&
+				(f.DrugID == "" || m.DrugID == f.DrugID) &&
+				(f.DrugRegistrationID == "" || m.DrugRegistrationID == f.DrugRegistrationID) &&
+				(f.PresentationID == "" || m.PresentationID == f.PresentationID) &&
+				(f.BatchNumber == "" || m.BatchNumber == f.BatchNumber) &&
+				(f.ExpireBefore.IsZero() || m.ExpireDate.Before(f.ExpireBefore)) &&
+				(f.ExpireAfter.IsZero() || m.ExpireDate.After(f.ExpireAfter))
+		},
+		sorts: map[string]sortKey[models.Batch]{
+			"expire_date":  func(m models.Batch) any { return m.ExpireDate },
+			"mfg_date":     func(m models.Batch) any { return m.MfgDate },
+			"batch_number": func(m models.Batch) any { return m.BatchNumber },
+			"qty_in_batch": func(m models.Batch) any { return m.QtyInBatch },
+			"price":        func(m models.Batch) any { return m.Price },
+			"created_at":   func(m models.Batch) any { return timeKey(m.CreatedAt) },
+			"updated_at":   func(m models.Batch) any { return timeKey(m.UpdatedAt) },
+		},
+		defaultSort: "-expire_date",
+	})
+}
+
+type memBatches struct {
+	*memTable[models.Batch, models.BatchFilter]
+}
+
+// Add follows services.AddBatch.
+func (r memBatches) Add(ctx context.Context, in models.Batch, policy models.PriceCeilingPolicy) (models.Batch, error) {
+	if in.Status == "" {
+		in.Status = models.BatchPlanned
+	}
+	if err := r.checkPrice(in, policy); err != nil {
+		return models.Batch{}, err
+	}
+	return r.memTable.Add(ctx, in)
+}
+
+// Update follows services.UpdateBatch.
+func (r memBatches) Update(ctx context.Context, id string, in models.Batch, policy models.PriceCeilingPolicy) (models.Batch, error) {
+	if err := r.checkPrice(in, policy); err != nil {
+		return models.Batch{}, err
+	}
+	return r.memTable.Update(ctx, id, in)
+}
+
+// checkPrice follows services.checkPriceCeiling. A batch that does not
+// validate is left for the table to refuse.
+func (r memBatches) checkPrice(in models.Batch, policy models.PriceCeilingPolicy) error {
+	if policy == models.PriceCeilingFlag || prepare(&in) != nil || in.Price.IsZero() || in.Currency == "" {
+		return nil
+	}
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+	if c, ok := r.s.ceiling(in); ok && in.Price.Cmp(c.MaxPrice) > 0 {
+		return services.PriceAboveCeiling(in, c)
+	}
+	return nil
+}
+
+// Transition follows services.TransitionBatch.
+func (r memBatches) Transition(ctx context.Context, id string, to models.BatchStatus, t models.BatchTransition, actor string) (models.Batch, error) {
+	if err := prepare(&t); err != nil {
+		return models.Batch{}, err
+	}
+	switch to {
+	case models.BatchRecalled:
+		return models.Batch{}, services.IllegalTransition("recall_only")
+	case models.BatchOnHold, models.BatchInactive:
+		if t.Reason == "" {
+			return models.Batch{}, shared.Validation(shared.NewFieldError("reason", "required", string(to), "required_for_status"))
+		}
+	}
+
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+	b, ok := r.rows[id]
+	if !ok {
+		return models.Batch{}, services.ErrNotFound
+	}
+	if to == models.BatchSoldOut && len(r.s.movements[id]) > 0 {
+		return models.Batch{}, services.IllegalTransition("stock_ledger_only")
+	}
+	if err := services.CheckTransition(b, to); err != nil {
+		return models.Batch{}, err
+	}
+	return r.s.transition(id, to, t.Reason, actor), nil
+}
+
+func (r memBatches) History(ctx context.Context, id string) ([]models.BatchStatusChange, error) {
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+	if _, ok := r.rows[id]; !ok {
+		return nil, services.ErrNotFound
+	}
+	return append([]models.BatchStatusChange{}, r.s.history[id]...), nil
+}
+
+// Move follows services.RecordStockMovement.
+func (r memBatches) Move(ctx context.Context, id string, in models.StockMovement, actor string) (models.StockMovement, error) {
+	in.ID = uuid.NewString()
+	if err := prepare(&in); err != nil {
+		return models.StockMovement{}, err
+	}
+	in.BatchID, in.CreatedBy = id, actor
+
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+	b, ok := r.rows[id]
+	if !ok {
+		return models.StockMovement{}, services.ErrNotFound
+	}
+	var issued int64
+	for _, m := range r.s.movements[id] {
+		issued += m.Issued()
+	}
+	balance, err := services.StockBalance(b, issued, in)
+	if err != nil {
+		return models.StockMovement{}, err
+	}
+	now := memNow()
+	in.BalanceAfter, in.CreatedAt = balance, &now
+	r.s.movements[id] = append(r.s.movements[id], in)
+	b.QtyRemaining, b.UpdatedAt = balance, &now
+	r.rows[id] = b
+	if to, reason, ok := services.StockStatus(b.Status, balance); ok {
+		b = r.s.transition(id, to, reason, actor)
+	}
+	in.BatchStatus = b.Status
+	return in, nil
+}
+
+// Stock follows services.GetStockLedger.
+func (r memBatches) Stock(ctx context.Context, id string) (models.StockLedger, error) {
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+	b, ok := r.rows[id]
+	if !ok {
+		return models.StockLedger{}, services.ErrNotFound
+	}
+	return models.StockLedger{
+		BatchID: id, QtyInBatch: b.QtyInBatch, QtyRemaining: b.QtyRemaining,
+		Movements: append([]models.StockMovement{}, r.s.movements[id]...),
+	}, nil
+}
+
+// transition moves a batch already checked to be allowed to move and records
+// the change. The caller holds the lock.
+func (s *memStore) transition(id string, to models.BatchStatus, reason, actor string) models.Batch {
+	now := memNow()
+	b := s.batches.rows[id]
+	s.history[id] = append(s.history[id], models.BatchStatusChange{
+		ID:         uuid.NewString(),
+		BatchID:    id,
+		FromStatus: b.Status,
+		ToStatus:   to,
+		Reason:     reason,
+		ChangedBy:  actor,
+		ChangedAt:  now,
+	})
+	b.Status, b.UpdatedAt = to, &now
+	if to == models.BatchSoldOut {
+		b.QtyRemaining = 0
+	}
+	s.batches.rows[id] = b
+	return b
+}
+
+// ===== Recalls =====
+
+type memRecalls struct {
+	s *memStore
+}
+
+// Open follows services.OpenRecall.
+func (r memRecalls) Open(ctx context.Context, in models.Recall, actor string) (models.Recall, error) {
+	in.ID = uuid.NewString()
+	if err := prepare(&in); err != nil {
+		return models.Recall{}, err
+	}
+	today := dateOf(time.Now())
+	if in.StartedOn.IsZero() {
+		in.StartedOn = today
+	}
+
+	s := r.s
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	number := fmt.Sprintf("RC-%d-%06d", today.Year(), s.recallSeq+1)
+	ids, err := s.checkRecallBatches(in.BatchIDs)
+	if err != nil {
+		return models.Recall{}, err
+	}
+	s.recallSeq++
+
+	now := memNow()
+	rec := models.Recall{
+		ID:            in.ID,
+		RecallNumber:  number,
+		Class:         in.Class,
+		Level:         in.Level,
+		ReasonCodes:   slices.Clone(in.ReasonCodes),
+		Reason:        in.Reason,
+		InitiatedBy:   in.InitiatedBy,
+		InitiatorName: in.InitiatorName,
+		StartedOn:     dateOf(in.StartedOn),
+		Status:        models.RecallOpen,
+		CreatedBy:     actor,
+		CreatedAt:     &now,
+		UpdatedAt:     &now,
+	}
+	s.recallBatches(number, ids, in.Reason, actor)
+	rec.BatchIDs = ids
+	s.recalls[rec.ID] = rec
+	return cloneRecall(rec), nil
+}
+
+// Extend follows services.ExtendRecall.
+func (r memRecalls) Extend(ctx context.Context, id string, in models.RecallExtension, actor string) (models.Recall, error) {
+	if err := prepare(&in); err != nil {
+		return models.Recall{}, err
+	}
+
+	s := r.s
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	rec, err := s.openRecall(id)
+	if err != nil {
+		return models.Recall{}, err
+	}
+	ids, err := s.checkRecallBatches(in.BatchIDs)
+	if err != nil {
+		return models.Recall{}, err
+	}
+	s.recallBatches(rec.RecallNumber, ids, in.Reason, actor)
+	for _, batchID := range ids {
+		if !slices.Contains(rec.BatchIDs, batchID) {
+			rec.BatchIDs = append(rec.BatchIDs, batchID)
+		}
+	}
+	now := memNow()
+	rec.UpdatedAt = &now
+	s.recalls[id] = rec
+	return cloneRecall(rec), nil
+}
+
+// Close follows services.CloseRecall.
+func (r memRecalls) Close(ctx context.Context, id string, in models.RecallClosure) (models.Recall, error) {
+	if err := prepare(&in); err != nil {
+		return models.Recall{}, err
+	}
+	closedOn := dateOf(time.Now())
+	if !in.ClosedOn.IsZero() {
+		closedOn = dateOf(in.ClosedOn)
+	}
+
+	s := r.s
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	rec, err := s.openRecall(id)
+	if err != nil {
+		return models.Recall{}, err
+	}
+	if closedOn.Before(rec.StartedOn) {
+		return models.Recall{}, shared.Validation(shared.NewFieldError("closed_on", "gtefield", "started_on", ""))
+	}
+	now := memNow()
+	rec.Status, rec.ClosedOn, rec.CloseNote, rec.UpdatedAt = models.RecallClosed, &closedOn, in.Note, &now
+	s.recalls[id] = rec
+	return cloneRecall(rec), nil
+}
+
+func (r memRecalls) Get(ctx context.Context, id string) (models.Recall, error) {
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+	rec, ok := r.s.recalls[id]
+	if !ok {
+		return models.Recall{}, services.ErrNotFound
+	}
+	return cloneRecall(rec), nil
+}
+
+func (r memRecalls) List(ctx context.Context, f models.RecallFilter, p models.ListParams) (models.Page[models.Recall], error) {
+	s := r.s
+	s.mu.RLock()
+	rows := make([]models.Recall, 0, len(s.recalls))
+	for _, rec := range s.recalls {
+		if f.Status != "" && rec.Status != f.Status ||
+			f.Class != "" && rec.Class != f.Class ||
+			f.BatchID != "" && !slices.Contains(rec.BatchIDs, f.BatchID) {
+			continue
+		}
+		if f.DrugID != "" && !slices.ContainsFunc(rec.BatchIDs, func(id string) bool { return s.batches.rows[id].DrugID == f.DrugID }) {
+			continue
+		}
+		rows = append(rows, cloneRecall(rec))
+	}
+	s.mu.RUnlock()
+
+	sorts := map[string]sortKey[models.Recall]{
+		"started_on":    func(m models.Recall) any { return m.StartedOn },
+		"recall_number": func(m models.Recall) any { return m.RecallNumber },
+		"created_at":    func(m models.Recall) any { return timeKey(m.CreatedAt) },
+		"updated_at":    func(m models.Recall) any { return timeKey(m.UpdatedAt) },
+	}
+	return listRows(rows, func(m models.Recall) string { return m.ID }, sorts, "-started_on", p)
+}
+
+// openRecall returns a recall, failing unless it is open. The caller holds the lock.
+func (s *memStore) openRecall(id string) (models.Recall, error) {
+	rec, ok := s.recalls[id]
+	if !ok {
+		return models.Recall{}, services.ErrNotFound
+	}
+	if rec.Status != models.RecallOpen {
+		return models.Recall{}, services.IllegalTransition("recall_not_open", rec.RecallNumber, string(rec.Status))
+	}
+	return rec, nil
+}
+
+// checkRecallBatches returns the batch ids in the order services lock them,
+// failing with the services error for the first batch that cannot be
+// recalled, before anything is changed. The caller holds the lock.
+func (s *memStore) checkRecallBatches(batchIDs []string) ([]string, error) {
+	ids := slices.Clone(batchIDs)
+	slices.Sort(ids)
+	for _, id := range ids {
+		b, ok := s.batches.rows[id]
+		if !ok {
+			return nil, shared.ForeignKey("batch_ids", "batch_missing", id)
+		}
+		if b.Status != models.BatchRecalled && !b.Status.CanTransitionTo(models.BatchRecalled) {
+			return nil, shared.Within("batch "+id, services.IllegalTransition("illegal_transition_from", string(b.Status), string(models.BatchRecalled)))
+		}
+	}
+	return ids, nil
+}
+
+// recallBatches moves checked batches to recalled under recall number,
+// skipping those already recalled. The caller holds the lock.
+func (s *memStore) recallBatches(number string, ids []string, reason, actor string) {
+	note := "recall " + number
+	if reason != "" {
+		note += ": " + reason
+	}
+	if r := []rune(note); len(r) > 500 {
+		note = string(r[:500])
+	}
+	for _, id := range ids {
+		if s.batches.rows[id].Status != models.BatchRecalled {
+			s.transition(id, models.BatchRecalled, note, actor)
+		}
+	}
+}
+
+func cloneRecall(r models.Recall) models.Recall {
+	r.ReasonCodes = slices.Clone(r.ReasonCodes)
+	r.BatchIDs = slices.Clone(r.BatchIDs)
+	return r
+}
+
+// dateOf truncates t to its calendar date, as a Postgres date column does.
+func dateOf(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,204 @@
+package repository
+
+import (
+	"cmp"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+	"time"
+
+	"moh/internal/services"
+	"moh/models"
+
+	"golang.org/x/text/collate"
+	"golang.org/x/text/language"
+)
+
+// Same bounds as the SQL lists.
+const (
+	defaultListLimit = 50
+	maxListLimit     = 500
+)
+
+// sortKey returns the value a row is ordered by. It must be a string,
+// nameKey, float64, int64, time.Time or models.Decimal, and of the same
+// type for every row.
+type sortKey[T any] func(T) any
+
+// memCursor mirrors the services cursor: the sort it was issued for and the
+// (key, id) of the last row handed out.
+type memCursor struct {
+	Sort string          `json:"s"`
+	Key  json.RawMessage `json:"k"`
+	ID   string          `json:"id"`
+}
+
+// listRows pages through rows the way services.listPage does: filtered rows
+// are ordered by (sort key, id) and a cursor resumes strictly after the last
+// row of the previous page. Text keys compare bytewise rather than by the
+// database collation, except translatable names in a list with a lang,
+// which are localized first and compared with that language's collation.
+func listRows[T any](rows []T, id func(T) string, sorts map[string]sortKey[T], defaultSort string, p models.ListParams) (models.Page[T], error) {
+	limit := p.Limit
+	if limit <= 0 {
+		limit = defaultListLimit
+	}
+	if limit > maxListLimit {
+		limit = maxListLimit
+	}
+
+	sort := strings.TrimSpace(p.Sort)
+	if sort == "" {
+		sort = defaultSort
+	}
+	desc := strings.HasPrefix(sort, "-")
+	key, ok := sorts[strings.TrimPrefix(sort, "-")]
+	if !ok {
+		return models.Page[T]{}, services.InvalidQuery("unknown_sort", strings.TrimPrefix(sort, "-"))
+	}
+	var coll *collate.Collator
+	if p.Lang != "" {
+		sort += "@" + p.Lang
+		coll = collate.New(language.Make(p.Lang))
+		for i := range rows {
+			if l, ok := any(&rows[i]).(interface{ Localize(string) }); ok {
+				l.Localize(p.Lang)
+			}
+		}
+	}
+
+	order := func(a, b T) int {
+		c := compareKeys(key(a), key(b), coll)
+		if c == 0 {
+			c = strings.Compare(id(a), id(b))
+		}
+		if desc {
+			return -c
+		}
+		return c
+	}
+	slices.SortFunc(rows, order)
+	total := int64(len(rows))
+
+	if p.Cursor != "" {
+		cur, err := decodeMemCursor[T](p.Cursor, key)
+		if err != nil {
+			return models.Page[T]{}, err
+		}
+		if cur.sort != sort {
+			return models.Page[T]{}, services.InvalidQuery("cursor_sort", cur.sort)
+		}
+		start := len(rows)
+		for i, r := range rows {
+			c := compareKeys(key(r), cur.key, coll)
+			if c == 0 {
+				c = strings.Compare(id(r), cur.id)
+			}
+			if desc {
+				c = -c
+			}
+			if c > 0 {
+				start = i
+				break
+			}
+		}
+		rows = rows[start:]
+	}
+
+	page := models.Page[T]{Items: make([]T, 0, min(len(rows), limit)), Total: total, Limit: limit}
+	if len(rows) > limit {
+		rows = rows[:limit]
+		last := rows[len(rows)-1]
+		k, _ := json.Marshal(key(last))
+		b, _ := json.Marshal(memCursor{Sort: sort, Key: k, ID: id(last)})
+		page.NextCursor = base64.RawURLEncoding.EncodeToString(b)
+	}
+	page.Items = append(page.Items, rows...)
+	return page, nil
+}
+
+type decodedCursor struct {
+	sort string
+	key  any
+	id   string
+}
+
+// decodeMemCursor reads a cursor back, decoding its key into the type the
+// sort key function produces.
+func decodeMemCursor[T any](s string, key sortKey[T]) (decodedCursor, error) {
+	malformed := services.InvalidQuery("malformed_cursor")
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return decodedCursor{}, malformed
+	}
+	var c memCursor
+	if err := json.Unmarshal(b, &c); err != nil || c.ID == "" {
+		return decodedCursor{}, malformed
+	}
+	var zero T
+	v := reflect.New(reflect.TypeOf(key(zero)))
+	if err := json.Unmarshal(c.Key, v.Interface()); err != nil {
+		return decodedCursor{}, malformed
+	}
+	return decodedCursor{sort: c.Sort, key: v.Elem().Interface(), id: c.ID}, nil
+}
+
+// compareKeys orders two sort keys; coll, if not nil, compares nameKeys.
+func compareKeys(a, b any, coll *collate.Collator) int {
+	switch a := a.(type) {
+	case string:
+		return strings.Compare(a, b.(string))
+	case nameKey:
+		if coll != nil {
+			return coll.CompareString(string(a), string(b.(nameKey)))
+		}
+		return strings.Compare(string(a), string(b.(nameKey)))
+	case float64:
+		return cmp.Compare(a, b.(float64))
+	case int64:
+		return cmp.Compare(a, b.(int64))
+	case time.Time:
+		return a.Compare(b.(time.Time))
+	case models.Decimal:
+		return a.Cmp(b.(models.Decimal))
+	default:
+		panic(fmt.Sprintf("repository: unsupported sort key type %T", a))
+	}
+}
+
+// Sort keys shared by several tables.
+
+func timeKey(t *time.Time) any {
+	if t == nil {
+		return time.Time{}
+	}
+	return *t
+}
+
+func lowerKey(s string) any { return strings.ToLower(s) }
+
+// nameKey is the sort key of a translatable name, compared with the
+// collation of the list's lang like the SQL lists' ICU collations.
+type nameKey string
+
+func nameSortKey(s string) any { return nameKey(strings.ToLower(s)) }
+
+// namesContain reports whether any translation in names contains q,
+// ignoring case.
+func namesContain(names models.LocalizedName, q string) bool {
+	for _, v := range names {
+		if containsFold(v, q) {
+			return true
+		}
+	}
+	return false
+}
+
+// containsFold reports whether s contains q, ignoring case, like the
+// services' ILIKE '%q%' filters.
+func containsFold(s, q string) bool {
+	return strings.Contains(strings.ToLower(s), strings.ToLower(strings.TrimSpace(q)))
+}
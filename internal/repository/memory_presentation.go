@@ -0,0 +1,158 @@
+package repository
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"time"
+
+	"moh/internal/services"
+	"moh/models"
+)
+
+func newPresentationTable(s *memStore) *memTable[models.Presentation, models.PresentationFilter] {
+	return newTable(s, tableSpec[models.Presentation, models.PresentationFilter]{
+		id:    func(m *models.Presentation) *string { return &m.ID },
+		times: func(m *models.Presentation) (**time.Time, **time.Time) { return &m.CreatedAt, &m.UpdatedAt },
+		unique: []func(models.Presentation) (string, bool){
+			func(m models.Presentation) (string, bool) { return "gtin:" + m.GTIN, true },
+			func(m models.Presentation) (string, bool) { return "local:" + m.LocalCode, m.LocalCode != "" },
+		},
+		dupKey: "presentation_exists",
+		fks: func(m models.Presentation) []fk {
+			return []fk{
+				{"drug_id", has(s.drugs.rows, m.DrugID)},
+				{"pack_unit_id", m.PackUnitID == "" || has(s.strengthUnits.rows, m.PackUnitID)},
+			}
+		},
+		refs: func(id string) string {
+			if s.packed(id) {
+				return "batches"
+			}
+			if s.priced(id) {
+				return "drug_prices"
+			}
+			return ""
+		},
+		// Batches and prices reference (presentation_id, drug_id), so a
+		// presentation in use keeps its drug.
+		beforeUpdate: func(old models.Presentation, in *models.Presentation) error {
+			if in.DrugID == old.DrugID {
+				return nil
+			}
+			if s.packed(old.ID) {
+				return services.InUse("batches")
+			}
+			if s.priced(old.ID) {
+				return services.InUse("drug_prices")
+			}
+			return nil
+		},
+		match: func(m models.Presentation, f models.PresentationFilter) bool {
+			return (f.DrugID == "" || m.DrugID == f.DrugID) &&
+				(f.GTIN == "" || m.GTIN == models.NormalizeGTIN(f.GTIN)) &&
+				(f.LocalCode == "" || m.LocalCode == strings.ToUpper(strings.TrimSpace(f.LocalCode))) &&
+				(f.ContainerType == "" || m.ContainerType == f.ContainerType)
+		},
+		sorts: map[string]sortKey[models.Presentation]{
+			"gtin":       func(m models.Presentation) any { return m.GTIN },
+			"pack_size":  func(m models.Presentation) any { return m.PackSize },
+			"created_at": func(m models.Presentation) any { return timeKey(m.CreatedAt) },
+			"updated_at": func(m models.Presentation) any { return timeKey(m.UpdatedAt) },
+		},
+		defaultSort: "created_at",
+	})
+}
+
+// packed reports whether any batch is packed in presentation id. The
+// caller holds the lock.
+func (s *memStore) packed(id string) bool {
+	for _, b := range s.batches.rows {
+		if b.PresentationID == id {
+			return true
+		}
+	}
+	return false
+}
+
+// priced reports whether any price ceiling covers presentation id. The
+// caller holds the lock.
+func (s *memStore) priced(id string) bool {
+	for _, c := range s.prices.rows {
+		if c.PresentationID == id {
+			return true
+		}
+	}
+	return false
+}
+
+type memPresentations struct {
+	*memTable[models.Presentation, models.PresentationFilter]
+}
+
+// LookupGTIN follows services.LookupGTIN.
+func (r memPresentations) LookupGTIN(ctx context.Context, gtin string) (models.GTINLookup, error) {
+	gtin = models.NormalizeGTIN(gtin)
+	if !models.ValidGTIN(gtin) {
+		return models.GTINLookup{}, services.InvalidQuery("invalid_gtin", gtin)
+	}
+
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+	for _, p := range r.rows {
+		if p.GTIN != gtin {
+			continue
+		}
+		out := models.GTINLookup{GTIN: gtin, Presentation: p, Drug: r.s.drugs.rows[p.DrugID]}
+		if reg, ok := r.s.activeRegistration(p.DrugID, dateOf(time.Now())); ok {
+			out.Registration = &reg
+		}
+		return out, nil
+	}
+	return models.GTINLookup{}, services.ErrNotFound
+}
+
+type memVerifier struct{ s *memStore }
+
+// Verify follows services.VerifyPack.
+func (r memVerifier) Verify(ctx context.Context, in models.VerifyRequest) (models.Verification, error) {
+	scan, err := services.ScanPack(in)
+	if err != nil {
+		return models.Verification{}, err
+	}
+	gtin, _ := scan.Get(models.AIGTIN)
+	number, _ := scan.Get(models.AIBatch)
+
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+	var m services.PackMatch
+	for _, p := range r.s.presentations.rows {
+		if gtin != "" && p.GTIN == gtin {
+			m.Presentation, m.BrandName = &p, r.s.drugs.rows[p.DrugID].BrandName
+			break
+		}
+	}
+	if m.Presentation == nil || number == "" {
+		return services.JudgePack(scan, m, time.Now()), nil
+	}
+	for _, b := range r.s.batches.rows {
+		if b.DrugID == m.Presentation.DrugID && b.BatchNumber == number {
+			m.Batch = &b
+			break
+		}
+	}
+	if m.Batch != nil && m.Batch.Status == models.BatchRecalled {
+		var best models.Recall
+		for _, rc := range r.s.recalls {
+			if !slices.Contains(rc.BatchIDs, m.Batch.ID) {
+				continue
+			}
+			open, bestOpen := rc.Status == models.RecallOpen, best.Status == models.RecallOpen
+			if best.ID == "" || open && !bestOpen || open == bestOpen && rc.StartedOn.After(best.StartedOn) {
+				best = rc
+			}
+		}
+		m.RecallNumber = best.RecallNumber
+	}
+	return services.JudgePack(scan, m, time.Now()), nil
+}
@@ -0,0 +1,87 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"moh/models"
+)
+
+func newPriceTable(s *memStore) *memTable[models.DrugPrice, models.DrugPriceFilter] {
+	return newTable(s, tableSpec[models.DrugPrice, models.DrugPriceFilter]{
+		id:    func(m *models.DrugPrice) *string { return &m.ID },
+		times: func(m *models.DrugPrice) (**time.Time, **time.Time) { return &m.CreatedAt, &m.UpdatedAt },
+		unique: []func(models.DrugPrice) (string, bool){func(m models.DrugPrice) (string, bool) {
+			return fmt.Sprintf("%s\x00%s\x00%s\x00%s", m.DrugID, m.PresentationID, m.Currency, dateOf(m.EffectiveFrom).Format(time.DateOnly)), true
+		}},
+		dupKey: "price_exists",
+		fks: func(m models.DrugPrice) []fk {
+			return []fk{
+				{"drug_id", has(s.drugs.rows, m.DrugID)},
+				{"presentation_id", m.PresentationID == "" || s.presentations.rows[m.PresentationID].DrugID == m.DrugID},
+			}
+		},
+		match: func(m models.DrugPrice, f models.DrugPriceFilter) bool {
+			return (f.DrugID == "" || m.DrugID == f.DrugID) &&
+				(f.PresentationID == "" || m.PresentationID == f.PresentationID) &&
+				(f.Currency == "" || m.Currency == strings.ToUpper(f.Currency))
+		},
+		sorts: map[string]sortKey[models.DrugPrice]{
+			"effective_from": func(m models.DrugPrice) any { return m.EffectiveFrom },
+			"max_price":      func(m models.DrugPrice) any { return m.MaxPrice },
+			"created_at":     func(m models.DrugPrice) any { return timeKey(m.CreatedAt) },
+		},
+		defaultSort: "effective_from",
+	})
+}
+
+type memPrices struct {
+	*memTable[models.DrugPrice, models.DrugPriceFilter]
+}
+
+// Violations follows services.ListPriceViolations.
+func (r memPrices) Violations(ctx context.Context, f models.PriceViolationFilter, p models.ListParams) (models.Page[models.PriceViolation], error) {
+	r.s.mu.RLock()
+	var rows []models.PriceViolation
+	for _, b := range r.s.batches.rows {
+		if (f.DrugID != "" && b.DrugID != f.DrugID) || (f.Currency != "" && b.Currency != strings.ToUpper(f.Currency)) {
+			continue
+		}
+		c, ok := r.s.ceiling(b)
+		if !ok || b.Price.Cmp(c.MaxPrice) <= 0 {
+			continue
+		}
+		rows = append(rows, models.PriceViolation{
+			BatchID: b.ID, DrugID: b.DrugID, BatchNumber: b.BatchNumber, PresentationID: b.PresentationID, MfgDate: b.MfgDate,
+			Price: b.Price, Currency: b.Currency, PriceID: c.ID, MaxPrice: c.MaxPrice, EffectiveFrom: c.EffectiveFrom,
+		})
+	}
+	r.s.mu.RUnlock()
+	return listRows(rows, func(m models.PriceViolation) string { return m.BatchID }, map[string]sortKey[models.PriceViolation]{
+		"mfg_date":     func(m models.PriceViolation) any { return m.MfgDate },
+		"batch_number": func(m models.PriceViolation) any { return m.BatchNumber },
+	}, "-mfg_date", p)
+}
+
+// ceiling is the price ceiling in force for b, as in the
+// batch_price_violations view: the latest for its presentation, else the
+// latest drug-wide one.
+func (s *memStore) ceiling(b models.Batch) (models.DrugPrice, bool) {
+	var best models.DrugPrice
+	found := false
+	for _, c := range s.prices.rows {
+		if !c.Covers(b) {
+			continue
+		}
+		better := !found || c.PresentationID != "" && best.PresentationID == ""
+		if found && (c.PresentationID == "") == (best.PresentationID == "") {
+			better = c.EffectiveFrom.After(best.EffectiveFrom)
+		}
+		if better {
+			best, found = c, true
+		}
+	}
+	return best, found
+}
@@ -0,0 +1,95 @@
+package repository
+
+import (
+	"cmp"
+	"context"
+	"slices"
+
+	"moh/internal/services"
+	"moh/models"
+)
+
+type memSearch struct{ s *memStore }
+
+// Search scores the same values as services.Search with its Go
+// approximation of the full-text and trigram matching.
+func (r memSearch) Search(ctx context.Context, p models.SearchParams) (models.SearchResult, error) {
+	p.Normalize()
+	if err := p.Validate(); err != nil {
+		return models.SearchResult{}, models.QueryError(err)
+	}
+	limit := p.Limit
+	if limit <= 0 {
+		limit = 20
+	}
+	wanted := func(t models.SearchType) bool { return len(p.Types) == 0 || slices.Contains(p.Types, t) }
+
+	var hits []models.SearchHit
+	// consider adds the entity if one of its values matches, scored by the best.
+	consider := func(t models.SearchType, id, title string, values ...[2]string) {
+		var best models.SearchHit
+		for _, v := range values {
+			if score, ok := services.SearchScore(p.Q, v[1]); ok && score > best.Score {
+				best = models.SearchHit{Type: t, ID: id, Title: title, Field: v[0], Highlight: v[1], Score: score}
+			}
+		}
+		if best.Score > 0 {
+			hits = append(hits, best)
+		}
+	}
+
+	s := r.s
+	s.mu.RLock()
+	if wanted(models.SearchDrug) {
+		for _, d := range s.drugs.rows {
+			consider(models.SearchDrug, d.ID, d.BrandName, nameValues("brand_name", d.BrandNames)...)
+		}
+	}
+	if wanted(models.SearchAPI) {
+		for _, a := range s.apis.rows {
+			consider(models.SearchAPI, a.ID, a.Name, nameValues("name", a.Names)...)
+		}
+	}
+	if wanted(models.SearchAuthHolder) {
+		for _, h := range s.authHolders.rows {
+			values := [][2]string{{"name", h.Name}}
+			if h.RegistrationNumber != "" {
+				values = append(values, [2]string{"registration_number", h.RegistrationNumber})
+			}
+			consider(models.SearchAuthHolder, h.ID, h.Name, values...)
+		}
+	}
+	if wanted(models.SearchRegistration) {
+		for _, reg := range s.regs.rows {
+			if reg.RegistrationNumber != "" {
+				consider(models.SearchRegistration, reg.ID, reg.RegistrationNumber, [2]string{"registration_number", reg.RegistrationNumber})
+			}
+		}
+	}
+	s.mu.RUnlock()
+
+	slices.SortFunc(hits, func(a, b models.SearchHit) int {
+		return cmp.Or(
+			cmp.Compare(b.Score, a.Score),
+			cmp.Compare(slices.Index(models.SearchTypes, a.Type), slices.Index(models.SearchTypes, b.Type)),
+			cmp.Compare(a.Title, b.Title),
+			cmp.Compare(a.ID, b.ID),
+		)
+	})
+	hits = hits[:min(len(hits), limit)]
+	for i := range hits {
+		hits[i].Highlight = services.Highlight(hits[i].Highlight, p.Q)
+	}
+	return models.SearchResult{Query: p.Q, Hits: append([]models.SearchHit{}, hits...)}, nil
+}
+
+// nameValues lists every translation of names as a searchable field value.
+func nameValues(field string, names models.LocalizedName) [][2]string {
+	out := make([][2]string, 0, len(names))
+	for _, lang := range models.Locales {
+		if v, ok := names[lang]; ok {
+			out = append(out, [2]string{field, v})
+		}
+	}
+	return out
+}
@@ -0,0 +1,183 @@
+package repository
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"moh/internal/services"
+	"moh/models"
+	"moh/shared"
+
+	"github.com/google/uuid"
+)
+
+type memSerials struct{ s *memStore }
+
+// Commission follows services.CommissionSerials.
+func (r memSerials) Commission(ctx context.Context, batchID string, in models.SerialUpload, actor string) (models.SerialUploadResult, error) {
+	if err := prepare(&in); err != nil {
+		return models.SerialUploadResult{}, err
+	}
+
+	s := r.s
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	b, ok := s.batches.rows[batchID]
+	if !ok {
+		return models.SerialUploadResult{}, services.ErrNotFound
+	}
+	gtin := s.presentations.rows[b.PresentationID].GTIN
+	if gtin == "" {
+		return models.SerialUploadResult{}, shared.Conflict("no_presentation", "batch_no_presentation", b.BatchNumber)
+	}
+	var dups []string
+	for _, m := range s.serials {
+		if m.GTIN == gtin && slices.Contains(in.Serials, m.Serial) {
+			dups = append(dups, m.Serial)
+		}
+	}
+	if len(dups) > 0 {
+		slices.Sort(dups)
+		return models.SerialUploadResult{}, shared.Conflict("already_exists", "serials_exist", gtin, strings.Join(dups[:min(len(dups), 10)], ", "))
+	}
+
+	now := memNow()
+	occurredAt := now
+	if !in.OccurredAt.IsZero() {
+		occurredAt = in.OccurredAt
+	}
+	for _, serial := range in.Serials {
+		m := models.Serial{
+			ID: uuid.NewString(), BatchID: batchID, GTIN: gtin, Serial: serial,
+			Status: models.SerialCommissioned, CreatedAt: &now, UpdatedAt: &now,
+		}
+		s.serials[m.ID] = m
+		s.serialEvents[m.ID] = []models.SerialEvent{{
+			ID: uuid.NewString(), SerialID: m.ID, GTIN: gtin, Serial: serial, Type: models.EventCommission,
+			Location: in.Location, Actor: actor, OccurredAt: occurredAt, RecordedAt: &now,
+		}}
+	}
+	return models.SerialUploadResult{BatchID: batchID, GTIN: gtin, Commissioned: len(in.Serials)}, nil
+}
+
+// Record follows services.RecordSerialEvent.
+func (r memSerials) Record(ctx context.Context, in models.SerialEvent, actor string) (models.SerialEvent, error) {
+	in.ID = uuid.NewString()
+	if err := prepare(&in); err != nil {
+		return models.SerialEvent{}, err
+	}
+	in.Actor = actor
+
+	s := r.s
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	m, ok := s.serialByKey(in.GTIN, in.Serial)
+	if !ok {
+		return models.SerialEvent{}, shared.NotFound("serial_unknown", in.GTIN, in.Serial)
+	}
+	now := memNow()
+	if in.OccurredAt.IsZero() {
+		in.OccurredAt = now
+	}
+	in.SerialID, in.RecordedAt = m.ID, &now
+	next, anomaly := m.Status.Apply(in.Type)
+	in.Anomaly = anomaly
+	s.serialEvents[m.ID] = append(s.serialEvents[m.ID], in)
+	if next != m.Status {
+		m.Status, m.UpdatedAt = next, &now
+		s.serials[m.ID] = m
+	}
+	return in, nil
+}
+
+// Custody follows services.SerialCustody.
+func (r memSerials) Custody(ctx context.Context, key models.SerialKey) (models.SerialCustody, error) {
+	key.Normalize()
+	if err := key.Validate(); err != nil {
+		return models.SerialCustody{}, models.QueryError(err)
+	}
+
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+	m, ok := r.s.serialByKey(key.GTIN, key.Serial)
+	if !ok {
+		return models.SerialCustody{}, shared.NotFound("serial_unknown", key.GTIN, key.Serial)
+	}
+	events := slices.Clone(r.s.serialEvents[m.ID])
+	slices.SortStableFunc(events, func(a, b models.SerialEvent) int { return a.OccurredAt.Compare(b.OccurredAt) })
+	return models.SerialCustody{Serial: m, Events: events}, nil
+}
+
+// List follows services.ListSerials.
+func (r memSerials) List(ctx context.Context, f models.SerialFilter, p models.ListParams) (models.Page[models.Serial], error) {
+	gtin := models.NormalizeGTIN(f.GTIN)
+	r.s.mu.RLock()
+	var rows []models.Serial
+	for _, m := range r.s.serials {
+		if (f.BatchID == "" || m.BatchID == f.BatchID) && (f.GTIN == "" || m.GTIN == gtin) && (f.Status == "" || m.Status == f.Status) {
+			rows = append(rows, m)
+		}
+	}
+	r.s.mu.RUnlock()
+	return listRows(rows, func(m models.Serial) string { return m.ID }, map[string]sortKey[models.Serial]{
+		"serial":     func(m models.Serial) any { return m.Serial },
+		"created_at": func(m models.Serial) any { return timeKey(m.CreatedAt) },
+		"updated_at": func(m models.Serial) any { return timeKey(m.UpdatedAt) },
+	}, "serial", p)
+}
+
+// Clones follows services.ListSerialClones.
+func (r memSerials) Clones(ctx context.Context, f models.SerialCloneFilter, p models.ListParams) (models.Page[models.SerialClone], error) {
+	gtin := models.NormalizeGTIN(f.GTIN)
+	r.s.mu.RLock()
+	var rows []models.SerialClone
+	for _, m := range r.s.serials {
+		if (f.BatchID != "" && m.BatchID != f.BatchID) || (f.GTIN != "" && m.GTIN != gtin) {
+			continue
+		}
+		c := models.SerialClone{SerialID: m.ID, BatchID: m.BatchID, GTIN: m.GTIN, Serial: m.Serial}
+		for _, e := range r.s.serialEvents[m.ID] {
+			if e.Type != models.EventDispense {
+				continue
+			}
+			if c.Dispenses == 0 || e.OccurredAt.Before(c.FirstDispensedAt) {
+				c.FirstDispensedAt = e.OccurredAt
+			}
+			if e.OccurredAt.After(c.LastDispensedAt) {
+				c.LastDispensedAt = e.OccurredAt
+			}
+			c.Dispenses++
+		}
+		if c.Dispenses > 1 {
+			rows = append(rows, c)
+		}
+	}
+	r.s.mu.RUnlock()
+	return listRows(rows, func(m models.SerialClone) string { return m.SerialID }, map[string]sortKey[models.SerialClone]{
+		"last_dispensed_at": func(m models.SerialClone) any { return m.LastDispensedAt },
+		"dispenses":         func(m models.SerialClone) any { return m.Dispenses },
+	}, "-last_dispensed_at", p)
+}
+
+// serialByKey finds a pack by GTIN and serial number. The caller holds the
+// lock.
+func (s *memStore) serialByKey(gtin, serial string) (models.Serial, bool) {
+	for _, m := range s.serials {
+		if m.GTIN == gtin && m.Serial == serial {
+			return m, true
+		}
+	}
+	return models.Serial{}, false
+}
+
+// serialized reports whether any pack of batch id has a serial. The caller
+// holds the lock.
+func (s *memStore) serialized(id string) bool {
+	for _, m := range s.serials {
+		if m.BatchID == id {
+			return true
+		}
+	}
+	return false
+}
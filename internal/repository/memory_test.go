@@ -0,0 +1,48 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+
+	"moh/models"
+)
+
+// Concurrent inserts of the same code must leave exactly one row.
+func TestMemoryConcurrentUniqueness(t *testing.T) {
+	store := NewMemory()
+	ctx := context.Background()
+
+	var (
+		wg      sync.WaitGroup
+		mu      sync.Mutex
+		created int
+	)
+	for i := range 50 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := store.DosageForms.Add(ctx, models.DosageForm{Code: "tab", Name: fmt.Sprintf("Tablet %d", i)})
+			if err == nil {
+				mu.Lock()
+				created++
+				mu.Unlock()
+			} else if err.Error() != "code already exists" {
+				t.Errorf("add: %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if created != 1 {
+		t.Fatalf("created %d rows, want 1", created)
+	}
+	page, err := store.DosageForms.List(ctx, models.CodeNameFilter{}, models.ListParams{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if page.Total != 1 {
+		t.Fatalf("total = %d, want 1", page.Total)
+	}
+}
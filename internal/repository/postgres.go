@@ -0,0 +1,215 @@
+package repository
+
+import (
+	"context"
+
+	"moh/internal/services"
+	"moh/models"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// NewPostgres returns a Store whose repositories run the services SQL on db.
+func NewPostgres(db *pgxpool.Pool) *Store {
+	return &Store{
+		APIs:                    pgCRUD[models.API, models.APIFilter]{db, services.AddAPI, services.GetAPI, services.UpdateAPI, services.DeleteAPI, services.ListAPIs},
+		DosageForms:             pgCRUD[models.DosageForm, models.CodeNameFilter]{db, services.AddDosageForm, services.GetDosageForm, services.UpdateDosageForm, services.DeleteDosageForm, services.ListDosageForms},
+		StrengthUnits:           pgCRUD[models.StrengthUnit, models.CodeNameFilter]{db, services.AddStrengthUnit, services.GetStrengthUnit, services.UpdateStrengthUnit, services.DeleteStrengthUnit, services.ListStrengthUnits},
+		Units:                   pgUnits{db},
+		Equivalents:             pgEquivalents{db},
+		RoutesOfAdmin:           pgCRUD[models.RouteOfAdmin, models.CodeNameFilter]{db, services.AddRouteOfAdmin, services.GetRouteOfAdmin, services.UpdateRouteOfAdmin, services.DeleteRouteOfAdmin, services.ListRoutesOfAdmin},
+		AuthHolders:             pgCRUD[models.AuthHolder, models.AuthHolderFilter]{db, services.AddAuthHolder, services.GetAuthHolder, services.UpdateAuthHolder, services.DeleteAuthHolder, services.ListAuthHolders},
+		MarketingAuthorizations: pgCRUD[models.MarketingAuthorization, models.CountryFilter]{db, services.AddMarketingAuthorization, services.GetMarketingAuthorization, services.UpdateMarketingAuthorization, services.DeleteMarketingAuthorization, services.ListMarketingAuthorizations},
+		ManufacturingSites:      pgCRUD[models.ManufacturingSite, models.CountryFilter]{db, services.AddManufacturingSite, services.GetManufacturingSite, services.UpdateManufacturingSite, services.DeleteManufacturingSite, services.ListManufacturingSites},
+		Drugs:                   pgCRUD[models.Drug, models.DrugFilter]{db, services.AddDrug, services.GetDrug, services.UpdateDrug, services.DeleteDrug, services.ListDrugs},
+		DrugIngredients:         pgCRUD[models.DrugIngredient, models.DrugIngredientFilter]{db, services.AddDrugIngredient, services.GetDrugIngredient, services.UpdateDrugIngredient, services.DeleteDrugIngredient, services.ListDrugIngredients},
+		Brands:                  pgBrands{db},
+		Presentations: pgPresentations{
+			pgCRUD[models.Presentation, models.PresentationFilter]{db, services.AddPresentation, services.GetPresentation, services.UpdatePresentation, services.DeletePresentation, services.ListPresentations},
+		},
+		Registrations: pgRegistrations{
+			pgCRUD[models.DrugRegistration, models.DrugRegistrationFilter]{db, services.AddDrugRegistration, services.GetDrugRegistration, services.UpdateDrugRegistration, services.DeleteDrugRegistration, services.ListDrugRegistrations},
+		},
+		RegistrationSites:       pgCRUD[models.DrugRegistrationSite, models.DrugRegistrationSiteFilter]{db, services.AddDrugRegistrationSite, services.GetDrugRegistrationSite, services.UpdateDrugRegistrationSite, services.DeleteDrugRegistrationSite, services.ListDrugRegistrationSites},
+		RegistrationAuthHolders: pgCRUD[models.DrugRegistrationAuthHolder, models.DrugRegistrationAuthHolderFilter]{db, services.AddDrugRegistrationAuthHolder, services.GetDrugRegistrationAuthHolder, services.UpdateDrugRegistrationAuthHolder, services.DeleteDrugRegistrationAuthHolder, services.ListDrugRegistrationAuthHolders},
+		Batches: pgBatches{
+			// Add and Update take the price ceiling policy; see pgBatches.
+			pgCRUD[models.Batch, models.BatchFilter]{db, nil, services.GetBatch, nil, services.DeleteBatch, services.ListBatches},
+		},
+		Prices: pgPrices{
+			pgCRUD[models.DrugPrice, models.DrugPriceFilter]{db, services.AddDrugPrice, services.GetDrugPrice, services.UpdateDrugPrice, services.DeleteDrugPrice, services.ListDrugPrices},
+		},
+		Recalls: pgRecalls{db},
+		Serials: pgSerials{db},
+		Search:  pgSearch{db},
+		Verify:  pgVerifier{db},
+	}
+}
+
+// pgCRUD adapts the package-level services functions of one entity to CRUD.
+type pgCRUD[T, F any] struct {
+	db     services.DBTX
+	add    func(context.Context, services.DBTX, T) (T, error)
+	get    func(context.Context, services.DBTX, string) (T, error)
+	update func(context.Context, services.DBTX, string, T) (T, error)
+	del    func(context.Context, services.DBTX, string) error
+	list   func(context.Context, services.DBTX, F, models.ListParams) (models.Page[T], error)
+}
+
+func (r pgCRUD[T, F]) Add(ctx context.Context, in T) (T, error) { return r.add(ctx, r.db, in) }
+
+func (r pgCRUD[T, F]) Get(ctx context.Context, id string) (T, error) { return r.get(ctx, r.db, id) }
+
+func (r pgCRUD[T, F]) Update(ctx context.Context, id string, in T) (T, error) {
+	return r.update(ctx, r.db, id, in)
+}
+
+func (r pgCRUD[T, F]) Delete(ctx context.Context, id string) error { return r.del(ctx, r.db, id) }
+
+func (r pgCRUD[T, F]) List(ctx context.Context, f F, p models.ListParams) (models.Page[T], error) {
+	return r.list(ctx, r.db, f, p)
+}
+
+type pgUnits struct {
+	db services.DBTX
+}
+
+func (r pgUnits) Convert(ctx context.Context, in models.UnitConversion) (models.UnitConversion, error) {
+	return services.ConvertStrength(ctx, r.db, in)
+}
+
+type pgEquivalents struct {
+	db services.DBTX
+}
+
+func (r pgEquivalents) Find(ctx context.Context, drugID string) (models.DrugEquivalents, error) {
+	return services.DrugEquivalents(ctx, r.db, drugID)
+}
+
+type pgBrands struct {
+	db services.DBTX
+}
+
+func (r pgBrands) Similar(ctx context.Context, in models.BrandCheck) ([]models.BrandMatch, error) {
+	return services.SimilarBrands(ctx, r.db, in)
+}
+
+func (r pgBrands) Check(ctx context.Context, in models.Drug, threshold float64, override bool) error {
+	return services.CheckBrand(ctx, r.db, in, threshold, override)
+}
+
+type pgRegistrations struct {
+	pgCRUD[models.DrugRegistration, models.DrugRegistrationFilter]
+}
+
+func (r pgRegistrations) CreateBundle(ctx context.Context, in models.DrugRegistrationBundle) (models.DrugRegistrationBundle, error) {
+	return services.CreateDrugRegistrationBundle(ctx, r.db, in)
+}
+
+type pgBatches struct {
+	pgCRUD[models.Batch, models.BatchFilter]
+}
+
+func (r pgBatches) Add(ctx context.Context, in models.Batch, policy models.PriceCeilingPolicy) (models.Batch, error) {
+	return services.AddBatch(ctx, r.db, in, policy)
+}
+
+func (r pgBatches) Update(ctx context.Context, id string, in models.Batch, policy models.PriceCeilingPolicy) (models.Batch, error) {
+	return services.UpdateBatch(ctx, r.db, id, in, policy)
+}
+
+func (r pgBatches) Transition(ctx context.Context, id string, to models.BatchStatus, t models.BatchTransition, actor string) (models.Batch, error) {
+	return services.TransitionBatch(ctx, r.db, id, to, t, actor)
+}
+
+func (r pgBatches) History(ctx context.Context, id string) ([]models.BatchStatusChange, error) {
+	return services.ListBatchStatusHistory(ctx, r.db, id)
+}
+
+func (r pgBatches) Move(ctx context.Context, id string, in models.StockMovement, actor string) (models.StockMovement, error) {
+	return services.RecordStockMovement(ctx, r.db, id, in, actor)
+}
+
+func (r pgBatches) Stock(ctx context.Context, id string) (models.StockLedger, error) {
+	return services.GetStockLedger(ctx, r.db, id)
+}
+
+type pgPresentations struct {
+	pgCRUD[models.Presentation, models.PresentationFilter]
+}
+
+func (r pgPresentations) LookupGTIN(ctx context.Context, gtin string) (models.GTINLookup, error) {
+	return services.LookupGTIN(ctx, r.db, gtin)
+}
+
+type pgPrices struct {
+	pgCRUD[models.DrugPrice, models.DrugPriceFilter]
+}
+
+func (r pgPrices) Violations(ctx context.Context, f models.PriceViolationFilter, p models.ListParams) (models.Page[models.PriceViolation], error) {
+	return services.ListPriceViolations(ctx, r.db, f, p)
+}
+
+type pgRecalls struct {
+	db services.DBTX
+}
+
+func (r pgRecalls) Open(ctx context.Context, in models.Recall, actor string) (models.Recall, error) {
+	return services.OpenRecall(ctx, r.db, in, actor)
+}
+
+func (r pgRecalls) Extend(ctx context.Context, id string, in models.RecallExtension, actor string) (models.Recall, error) {
+	return services.ExtendRecall(ctx, r.db, id, in, actor)
+}
+
+func (r pgRecalls) Close(ctx context.Context, id string, in models.RecallClosure) (models.Recall, error) {
+	return services.CloseRecall(ctx, r.db, id, in)
+}
+
+func (r pgRecalls) Get(ctx context.Context, id string) (models.Recall, error) {
+	return services.GetRecall(ctx, r.db, id)
+}
+
+func (r pgRecalls) List(ctx context.Context, f models.RecallFilter, p models.ListParams) (models.Page[models.Recall], error) {
+	return services.ListRecalls(ctx, r.db, f, p)
+}
+
+type pgSerials struct {
+	db services.DBTX
+}
+
+func (r pgSerials) Commission(ctx context.Context, batchID string, in models.SerialUpload, actor string) (models.SerialUploadResult, error) {
+	return services.CommissionSerials(ctx, r.db, batchID, in, actor)
+}
+
+func (r pgSerials) Record(ctx context.Context, in models.SerialEvent, actor string) (models.SerialEvent, error) {
+	return services.RecordSerialEvent(ctx, r.db, in, actor)
+}
+
+func (r pgSerials) Custody(ctx context.Context, key models.SerialKey) (models.SerialCustody, error) {
+	return services.SerialCustody(ctx, r.db, key)
+}
+
+func (r pgSerials) List(ctx context.Context, f models.SerialFilter, p models.ListParams) (models.Page[models.Serial], error) {
+	return services.ListSerials(ctx, r.db, f, p)
+}
+
+func (r pgSerials) Clones(ctx context.Context, f models.SerialCloneFilter, p models.ListParams) (models.Page[models.SerialClone], error) {
+	return services.ListSerialClones(ctx, r.db, f, p)
+}
+
+type pgSearch struct {
+	db services.DBTX
+}
+
+func (r pgSearch) Search(ctx context.Context, p models.SearchParams) (models.SearchResult, error) {
+	return services.Search(ctx, r.db, p)
+}
+
+type pgVerifier struct {
+	db services.DBTX
+}
+
+func (r pgVerifier) Verify(ctx context.Context, in models.VerifyRequest) (models.Verification, error) {
+	return services.VerifyPack(ctx, r.db, in)
+}
@@ -0,0 +1,154 @@
+// Package repository defines what the HTTP handlers need from storage, one
+// interface per aggregate, with two implementations: Postgres, which wraps
+// the SQL in internal/services, and Memory, a thread-safe in-process store
+// with the same validation, uniqueness and foreign-key behaviour, used by
+// tests that should not need a database.
+//
+// Both implementations report failures with the services errors
+// (ErrNotFound, ErrInUse, ErrInvalidQuery, ErrIllegalTransition) and the same
+// messages, so callers cannot tell them apart.
+package repository
+
+import (
+	"context"
+
+	"moh/models"
+)
+
+// CRUD is the repository of an entity that is created, read, replaced,
+// deleted and listed as a whole. F is its list filter.
+type CRUD[T, F any] interface {
+	Add(ctx context.Context, in T) (T, error)
+	Get(ctx context.Context, id string) (T, error)
+	Update(ctx context.Context, id string, in T) (T, error)
+	Delete(ctx context.Context, id string) error
+	List(ctx context.Context, f F, p models.ListParams) (models.Page[T], error)
+}
+
+// Catalog and registry master data.
+type (
+	APIs                    = CRUD[models.API, models.APIFilter]
+	DosageForms             = CRUD[models.DosageForm, models.CodeNameFilter]
+	StrengthUnits           = CRUD[models.StrengthUnit, models.CodeNameFilter]
+	RoutesOfAdmin           = CRUD[models.RouteOfAdmin, models.CodeNameFilter]
+	AuthHolders             = CRUD[models.AuthHolder, models.AuthHolderFilter]
+	MarketingAuthorizations = CRUD[models.MarketingAuthorization, models.CountryFilter]
+	ManufacturingSites      = CRUD[models.ManufacturingSite, models.CountryFilter]
+)
+
+type (
+	Drugs           = CRUD[models.Drug, models.DrugFilter]
+	DrugIngredients = CRUD[models.DrugIngredient, models.DrugIngredientFilter]
+)
+
+// Presentations are the packs a drug is marketed in; a GTIN identifies
+// one, and through it the drug and its registration.
+type Presentations interface {
+	CRUD[models.Presentation, models.PresentationFilter]
+	LookupGTIN(ctx context.Context, gtin string) (models.GTINLookup, error)
+}
+
+// Units converts strengths between strength units.
+type Units interface {
+	Convert(ctx context.Context, in models.UnitConversion) (models.UnitConversion, error)
+}
+
+// Equivalents finds the marketed drugs interchangeable with a drug.
+type Equivalents interface {
+	Find(ctx context.Context, drugID string) (models.DrugEquivalents, error)
+}
+
+// Brands finds registered brand names a new one could be confused with, and
+// refuses a new drug's brand that is too close to one.
+type Brands interface {
+	Similar(ctx context.Context, in models.BrandCheck) ([]models.BrandMatch, error)
+	Check(ctx context.Context, in models.Drug, threshold float64, override bool) error
+}
+
+// Registrations also creates a registration together with its links.
+type Registrations interface {
+	CRUD[models.DrugRegistration, models.DrugRegistrationFilter]
+	CreateBundle(ctx context.Context, in models.DrugRegistrationBundle) (models.DrugRegistrationBundle, error)
+}
+
+type (
+	RegistrationSites       = CRUD[models.DrugRegistrationSite, models.DrugRegistrationSiteFilter]
+	RegistrationAuthHolders = CRUD[models.DrugRegistrationAuthHolder, models.DrugRegistrationAuthHolderFilter]
+)
+
+// Batches also moves batches through their status life cycle and keeps
+// their stock ledger. Add and Update check the price against its ceiling
+// under the given policy.
+type Batches interface {
+	Add(ctx context.Context, in models.Batch, policy models.PriceCeilingPolicy) (models.Batch, error)
+	Get(ctx context.Context, id string) (models.Batch, error)
+	Update(ctx context.Context, id string, in models.Batch, policy models.PriceCeilingPolicy) (models.Batch, error)
+	Delete(ctx context.Context, id string) error
+	List(ctx context.Context, f models.BatchFilter, p models.ListParams) (models.Page[models.Batch], error)
+	Transition(ctx context.Context, id string, to models.BatchStatus, t models.BatchTransition, actor string) (models.Batch, error)
+	History(ctx context.Context, id string) ([]models.BatchStatusChange, error)
+	Move(ctx context.Context, id string, in models.StockMovement, actor string) (models.StockMovement, error)
+	Stock(ctx context.Context, id string) (models.StockLedger, error)
+}
+
+// Prices keeps the ceiling price schedule and lists the batches above it.
+type Prices interface {
+	CRUD[models.DrugPrice, models.DrugPriceFilter]
+	Violations(ctx context.Context, f models.PriceViolationFilter, p models.ListParams) (models.Page[models.PriceViolation], error)
+}
+
+// Recalls are opened, extended and closed rather than edited.
+type Recalls interface {
+	Open(ctx context.Context, in models.Recall, actor string) (models.Recall, error)
+	Extend(ctx context.Context, id string, in models.RecallExtension, actor string) (models.Recall, error)
+	Close(ctx context.Context, id string, in models.RecallClosure) (models.Recall, error)
+	Get(ctx context.Context, id string) (models.Recall, error)
+	List(ctx context.Context, f models.RecallFilter, p models.ListParams) (models.Page[models.Recall], error)
+}
+
+// Serials tracks individual packs: their serial numbers, commissioned in
+// bulk per batch, and the chain of custody each one builds up.
+type Serials interface {
+	Commission(ctx context.Context, batchID string, in models.SerialUpload, actor string) (models.SerialUploadResult, error)
+	Record(ctx context.Context, in models.SerialEvent, actor string) (models.SerialEvent, error)
+	Custody(ctx context.Context, key models.SerialKey) (models.SerialCustody, error)
+	List(ctx context.Context, f models.SerialFilter, p models.ListParams) (models.Page[models.Serial], error)
+	Clones(ctx context.Context, f models.SerialCloneFilter, p models.ListParams) (models.Page[models.SerialClone], error)
+}
+
+// Searcher ranks drugs, APIs, auth holders and registrations against a
+// free-text query.
+type Searcher interface {
+	Search(ctx context.Context, p models.SearchParams) (models.SearchResult, error)
+}
+
+// Verifier judges a pack by the GS1 code printed on it.
+type Verifier interface {
+	Verify(ctx context.Context, in models.VerifyRequest) (models.Verification, error)
+}
+
+// Store holds one repository per aggregate.
+type Store struct {
+	APIs                    APIs
+	DosageForms             DosageForms
+	StrengthUnits           StrengthUnits
+	RoutesOfAdmin           RoutesOfAdmin
+	Units                   Units
+	Equivalents             Equivalents
+	AuthHolders             AuthHolders
+	MarketingAuthorizations MarketingAuthorizations
+	ManufacturingSites      ManufacturingSites
+	Drugs                   Drugs
+	DrugIngredients         DrugIngredients
+	Presentations           Presentations
+	Brands                  Brands
+	Registrations           Registrations
+	RegistrationSites       RegistrationSites
+	RegistrationAuthHolders RegistrationAuthHolders
+	Batches                 Batches
+	Prices                  Prices
+	Recalls                 Recalls
+	Serials                 Serials
+	Search                  Searcher
+	Verify                  Verifier
+}
@@ -0,0 +1,16 @@
+package scheduler
+
+import (
+	"time"
+
+	"moh/internal/services"
+)
+
+// ExpiryJobs expire batches past their expire_date and registrations past
+// their valid_to, every interval.
+func ExpiryJobs(interval time.Duration) []Job {
+	return []Job{
+		{Name: "expire_batches", Interval: interval, Run: services.ExpireBatches},
+		{Name: "expire_drug_registrations", Interval: interval, Run: services.ExpireDrugRegistrations},
+	}
+}
@@ -0,0 +1,199 @@
+// Package scheduler runs periodic maintenance jobs inside the server process.
+//
+// Every replica runs the scheduler. Each run of a job takes a transaction-level
+// Postgres advisory lock keyed by the job name; a replica that fails to get it
+// skips that run, so a job never runs twice at the same time. The outcome of
+// every run is stored in scheduler_job_runs.
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"log/slog"
+	"os"
+	"sync"
+	"time"
+
+	"moh/models"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// lockNamespace is the high half of every job's advisory lock key, keeping
+// them apart from the migration lock and from other applications.
+const lockNamespace int64 = 0x6d6f6873 << 32 // "mohs"
+
+// Job is one periodic task. Run does its work inside tx and returns the
+// number of rows it changed; an error rolls the whole run back.
+type Job struct {
+	Name     string
+	Interval time.Duration
+	Run      func(ctx context.Context, tx pgx.Tx) (int64, error)
+}
+
+// Scheduler runs jobs on their intervals until its context is cancelled.
+type Scheduler struct {
+	db       *pgxpool.Pool
+	jobs     []Job
+	instance string
+
+	mu      sync.Mutex
+	nextRun map[string]time.Time
+}
+
+// New returns a scheduler for jobs. It does nothing until Start.
+func New(db *pgxpool.Pool, jobs ...Job) *Scheduler {
+	host, _ := os.Hostname()
+	return &Scheduler{
+		db:       db,
+		jobs:     jobs,
+		instance: fmt.Sprintf("%s/%d", host, os.Getpid()),
+		nextRun:  map[string]time.Time{},
+	}
+}
+
+// Start runs every job once now and then on its interval, each in its own
+// goroutine, until ctx is cancelled.
+func (s *Scheduler) Start(ctx context.Context) {
+	for _, job := range s.jobs {
+		go s.loop(ctx, job)
+	}
+}
+
+func (s *Scheduler) loop(ctx context.Context, job Job) {
+	t := time.NewTicker(job.Interval)
+	defer t.Stop()
+	for {
+		s.setNextRun(job.Name, time.Now().Add(job.Interval))
+		if err := s.RunOnce(ctx, job); err != nil && ctx.Err() == nil {
+			slog.Error("scheduler: job failed", "job", job.Name, "err", err)
+		}
+		select {
+		case <-ctx.Done():
+			s.setNextRun(job.Name, time.Time{})
+			return
+		case <-t.C:
+		}
+	}
+}
+
+func (s *Scheduler) setNextRun(name string, at time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if at.IsZero() {
+		delete(s.nextRun, name)
+		return
+	}
+	s.nextRun[name] = at
+}
+
+// RunOnce runs job now unless another instance is already running it.
+func (s *Scheduler) RunOnce(ctx context.Context, job Job) error {
+	started := time.Now()
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	var locked bool
+	if err := tx.QueryRow(ctx, `SELECT pg_try_advisory_xact_lock($1)`, lockID(job.Name)).Scan(&locked); err != nil {
+		return err
+	}
+	if !locked {
+		slog.Debug("scheduler: job is running elsewhere, skipping", "job", job.Name)
+		return nil
+	}
+
+	affected, runErr := job.Run(ctx, tx)
+	if runErr != nil {
+		// The work is rolled back; record the failure outside the transaction.
+		_ = tx.Rollback(ctx)
+		if err := s.record(ctx, s.db, job.Name, started, 0, runErr); err != nil {
+			return errors.Join(runErr, err)
+		}
+		return runErr
+	}
+	if err := s.record(ctx, tx, job.Name, started, affected, nil); err != nil {
+		return err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return err
+	}
+	if affected > 0 {
+		slog.Info("scheduler: job done", "job", job.Name, "affected", affected, "took", time.Since(started))
+	}
+	return nil
+}
+
+// execer is satisfied by both *pgxpool.Pool and pgx.Tx.
+type execer interface {
+	Exec(ctx context.Context, sql string, args ...any) (pgconn.CommandTag, error)
+}
+
+func (s *Scheduler) record(ctx context.Context, db execer, name string, started time.Time, affected int64, runErr error) error {
+	status, msg, failed := "ok", "", 0
+	if runErr != nil {
+		status, msg, failed = "error", runErr.Error(), 1
+	}
+	const q = `
+		INSERT INTO public.scheduler_job_runs
+			(job_name, last_started_at, last_finished_at, last_status, last_error, last_affected, last_instance, run_count, error_count)
+		VALUES ($1, $2, now(), $3, NULLIF($4, ''), $5, $6, 1, $7)
+		ON CONFLICT (job_name) DO UPDATE SET
+			last_started_at = EXCLUDED.last_started_at,
+			last_finished_at = EXCLUDED.last_finished_at,
+			last_status = EXCLUDED.last_status,
+			last_error = EXCLUDED.last_error,
+			last_affected = EXCLUDED.last_affected,
+			last_instance = EXCLUDED.last_instance,
+			run_count = scheduler_job_runs.run_count + 1,
+			error_count = scheduler_job_runs.error_count + EXCLUDED.error_count
+	`
+	_, err := db.Exec(ctx, q, name, started, status, msg, affected, s.instance, failed)
+	return err
+}
+
+// Status reports every job with its last run, as recorded by any instance,
+// and its next run on this instance.
+func (s *Scheduler) Status(ctx context.Context) ([]models.JobStatus, error) {
+	const q = `
+		SELECT job_name, last_started_at, last_finished_at, last_status, COALESCE(last_error, '') AS last_error,
+		       last_affected, last_instance, run_count, error_count
+		FROM public.scheduler_job_runs
+	`
+	var runs []models.JobStatus
+	if err := pgxscan.Select(ctx, s.db, &runs, q); err != nil {
+		return nil, err
+	}
+	byName := make(map[string]models.JobStatus, len(runs))
+	for _, r := range runs {
+		byName[r.Name] = r
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	out := make([]models.JobStatus, 0, len(s.jobs))
+	for _, job := range s.jobs {
+		st := byName[job.Name]
+		st.Name = job.Name
+		st.Interval = job.Interval.String()
+		if next, ok := s.nextRun[job.Name]; ok {
+			st.NextRunAt = &next
+		}
+		out = append(out, st)
+	}
+	return out, nil
+}
+
+// lockID derives the advisory lock key of a job from its name.
+func lockID(name string) int64 {
+	h := fnv.New32a()
+	h.Write([]byte(name))
+	return lockNamespace | int64(h.Sum32())
+}
@@ -0,0 +1,131 @@
+package services
+
+import (
+	"context"
+	"errors"
+
+	"moh/models"
+	"moh/shared"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+const batchColumns = `id, drug_id, COALESCE(drug_registration_id::text, '') AS drug_registration_id,
+	COALESCE(presentation_id::text, '') AS presentation_id, batch_number, mfg_date, expire_date, qty_in_batch, qty_remaining, status, price, COALESCE(currency, '') AS currency, created_at, updated_at`
+
+// TransitionBatch moves a batch to status to, provided the move is legal from
+// its current status, and records the change in batch_status_history.
+// actor identifies who made the change (the token subject). Only a batch
+// with no stock movements is marked sold out by hand, with nothing left, as
+// 0014 did for batches sold out before the ledger; otherwise the movement
+// that uses up its last units does it.
+func TransitionBatch(ctx context.Context, db DBTX, id string, to models.BatchStatus, t models.BatchTransition, actor string) (models.Batch, error) {
+	t.Normalize()
+	if err := t.Validate(); err != nil {
+		return models.Batch{}, models.ValidationError(err)
+	}
+	switch to {
+	case models.BatchRecalled:
+		return models.Batch{}, IllegalTransition("recall_only")
+	case models.BatchOnHold, models.BatchInactive:
+		if t.Reason == "" {
+			return models.Batch{}, shared.Validation(shared.NewFieldError("reason", "required", string(to), "required_for_status"))
+		}
+	}
+
+	var out models.Batch
+	err := pgx.BeginFunc(ctx, db, func(tx pgx.Tx) error {
+		if to == models.BatchSoldOut {
+			// The batch row is locked before its ledger is read, as
+			// RecordStockMovement locks it before writing to it.
+			const q = `
+				SELECT EXISTS (SELECT 1 FROM public.stock_movements m WHERE m.batch_id = b.id)
+				FROM public.batches b WHERE b.id = $1
+				FOR UPDATE OF b
+			`
+			var moved bool
+			if err := tx.QueryRow(ctx, q, id).Scan(&moved); err != nil {
+				if errors.Is(err, pgx.ErrNoRows) {
+					return ErrNotFound
+				}
+				return err
+			}
+			if moved {
+				return IllegalTransition("stock_ledger_only")
+			}
+		}
+		var err error
+		out, err = transitionBatchTx(ctx, tx, id, to, t.Reason, actor)
+		return err
+	})
+	return out, err
+}
+
+// transitionBatchTx does the work of TransitionBatch inside tx. The batch row
+// is locked first so concurrent transitions of one batch serialize.
+func transitionBatchTx(ctx context.Context, tx pgx.Tx, id string, to models.BatchStatus, reason, actor string) (models.Batch, error) {
+	var b models.Batch
+	if err := pgxscan.Get(ctx, tx, &b, `SELECT `+batchColumns+` FROM public.batches WHERE id = $1 FOR UPDATE`, id); err != nil {
+		if pgxscan.NotFound(err) {
+			return models.Batch{}, ErrNotFound
+		}
+		return models.Batch{}, err
+	}
+	from := b.Status
+	if err := CheckTransition(b, to); err != nil {
+		return models.Batch{}, err
+	}
+
+	// A sold-out batch has nothing left: the ledger empties it before it
+	// gets here, a batch marked by hand is emptied now.
+	q := `
+		UPDATE public.batches
+		SET status = $2, qty_remaining = CASE WHEN $2 = 'sold_out' THEN 0 ELSE qty_remaining END, updated_at = now()
+		WHERE id = $1
+		RETURNING ` + batchColumns
+	var out models.Batch
+	if err := pgxscan.Get(ctx, tx, &out, q, id, to); err != nil {
+		return models.Batch{}, err
+	}
+
+	const h = `
+		INSERT INTO public.batch_status_history (id, batch_id, from_status, to_status, reason, changed_by)
+		VALUES ($1, $2, $3, $4, NULLIF($5, ''), $6)
+	`
+	if _, err := tx.Exec(ctx, h, uuid.NewString(), id, from, to, reason, actor); err != nil {
+		return models.Batch{}, err
+	}
+	return out, nil
+}
+
+// CheckTransition refuses to move batch b to status to if the move is
+// illegal from its status, or releases a sold-out batch with no stock.
+func CheckTransition(b models.Batch, to models.BatchStatus) error {
+	if !b.Status.CanTransitionTo(to) {
+		return IllegalTransition("illegal_transition_from", string(b.Status), string(to))
+	}
+	if b.Status == models.BatchSoldOut && to == models.BatchReleased && b.QtyRemaining == 0 {
+		return IllegalTransition("sold_out_no_stock", b.BatchNumber)
+	}
+	return nil
+}
+
+// ListBatchStatusHistory returns every status change of a batch, oldest first.
+func ListBatchStatusHistory(ctx context.Context, db DBTX, batchID string) ([]models.BatchStatusChange, error) {
+	if _, err := GetBatch(ctx, db, batchID); err != nil {
+		return nil, err
+	}
+	const q = `
+		SELECT id, batch_id, from_status, to_status, COALESCE(reason, '') AS reason, changed_by, changed_at
+		FROM public.batch_status_history
+		WHERE batch_id = $1
+		ORDER BY changed_at, id
+	`
+	out := []models.BatchStatusChange{}
+	if err := pgxscan.Select(ctx, db, &out, q, batchID); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
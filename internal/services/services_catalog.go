@@ -3,124 +3,266 @@ package services
 
 import (
 	"context"
-	"errors"
-	"strings"
 
 	"github.com/georgysavva/scany/v2/pgxscan"
 	"github.com/google/uuid"
-	"github.com/jackc/pgx/v5/pgconn"
-	"github.com/jackc/pgx/v5/pgxpool"
 	"moh/models"
 )
 
+const dosageFormColumns = `id, code, name, names, COALESCE(form_group, '') AS form_group, created_at, updated_at`
+
 // AddDosageForm creates a dosage_form.
-func AddDosageForm(ctx context.Context, db *pgxpool.Pool, in models.DosageForm) (models.DosageForm, error) {
+func AddDosageForm(ctx context.Context, db DBTX, in models.DosageForm) (models.DosageForm, error) {
 	in.ID = uuid.NewString()
-	in.Code = strings.ToUpper(strings.TrimSpace(in.Code))
-	in.Name = strings.TrimSpace(in.Name)
+	in.Normalize()
 
 	if err := in.Validate(); err != nil {
-		msg, _ := models.FirstError(err)
-		return models.DosageForm{}, errors.New(msg)
+		return models.DosageForm{}, models.ValidationError(err)
 	}
 
 	const q = `
-		INSERT INTO dosage_form (id, code, name)
-		VALUES ($1, $2, $3)
-		RETURNING id, code, name, created_at, updated_at
-	`
+		INSERT INTO public.dosage_forms (id, code, name, names, form_group)
+		VALUES ($1, $2, $3, $4, NULLIF($5, ''))
+		RETURNING ` + dosageFormColumns
 	var out models.DosageForm
-	if err := pgxscan.Get(ctx, db, &out, q, in.ID, in.Code, in.Name); err != nil {
-		var pgErr *pgconn.PgError
-		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
-			return models.DosageForm{}, errors.New("code already exists")
-		}
-		return models.DosageForm{}, err
+	if err := pgxscan.Get(ctx, db, &out, q, in.ID, in.Code, in.Name, in.Names, in.Group); err != nil {
+		return models.DosageForm{}, dbError(err, "code_exists")
 	}
 	return out, nil
 }
 
+const strengthUnitColumns = `id, code, name, names, COALESCE(dimension, '') AS dimension, factor, created_at, updated_at`
+
 // AddStrengthUnit creates a strength_unit.
-func AddStrengthUnit(ctx context.Context, db *pgxpool.Pool, in models.StrengthUnit) (models.StrengthUnit, error) {
+func AddStrengthUnit(ctx context.Context, db DBTX, in models.StrengthUnit) (models.StrengthUnit, error) {
 	in.ID = uuid.NewString()
-	in.Code = strings.ToUpper(strings.TrimSpace(in.Code))
-	in.Name = strings.TrimSpace(in.Name)
+	in.Normalize()
 
 	if err := in.Validate(); err != nil {
-		msg, _ := models.FirstError(err)
-		return models.StrengthUnit{}, errors.New(msg)
+		return models.StrengthUnit{}, models.ValidationError(err)
 	}
 
 	const q = `
-		INSERT INTO strength_unit (id, code, name)
-		VALUES ($1, $2, $3)
-		RETURNING id, code, name, created_at, updated_at
-	`
+		INSERT INTO public.strength_units (id, code, name, names, dimension, factor)
+		VALUES ($1, $2, $3, $4, NULLIF($5, ''), $6)
+		RETURNING ` + strengthUnitColumns
 	var out models.StrengthUnit
-	if err := pgxscan.Get(ctx, db, &out, q, in.ID, in.Code, in.Name); err != nil {
-		var pgErr *pgconn.PgError
-		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
-			return models.StrengthUnit{}, errors.New("code already exists")
-		}
-		return models.StrengthUnit{}, err
+	if err := pgxscan.Get(ctx, db, &out, q, in.ID, in.Code, in.Name, in.Names, in.Dimension, in.Factor); err != nil {
+		return models.StrengthUnit{}, dbError(err, "code_exists")
 	}
 	return out, nil
 }
 
 // AddRouteOfAdmin creates a route_of_admin.
-func AddRouteOfAdmin(ctx context.Context, db *pgxpool.Pool, in models.RouteOfAdmin) (models.RouteOfAdmin, error) {
+func AddRouteOfAdmin(ctx context.Context, db DBTX, in models.RouteOfAdmin) (models.RouteOfAdmin, error) {
 	in.ID = uuid.NewString()
-	in.Code = strings.ToUpper(strings.TrimSpace(in.Code))
-	in.Name = strings.TrimSpace(in.Name)
+	in.Normalize()
 
 	if err := in.Validate(); err != nil {
-		msg, _ := models.FirstError(err)
-		return models.RouteOfAdmin{}, errors.New(msg)
+		return models.RouteOfAdmin{}, models.ValidationError(err)
 	}
 
 	const q = `
-		INSERT INTO route_of_admin (id, code, name)
-		VALUES ($1, $2, $3)
-		RETURNING id, code, name, created_at, updated_at
+		INSERT INTO public.routes_of_admin (id, code, name, names)
+		VALUES ($1, $2, $3, $4)
+		RETURNING id, code, name, names, created_at, updated_at
 	`
 	var out models.RouteOfAdmin
-	if err := pgxscan.Get(ctx, db, &out, q, in.ID, in.Code, in.Name); err != nil {
-		var pgErr *pgconn.PgError
-		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
-			return models.RouteOfAdmin{}, errors.New("code already exists")
-		}
-		return models.RouteOfAdmin{}, err
+	if err := pgxscan.Get(ctx, db, &out, q, in.ID, in.Code, in.Name, in.Names); err != nil {
+		return models.RouteOfAdmin{}, dbError(err, "code_exists")
 	}
 	return out, nil
 }
 
 // AddAPI creates an API (active ingredient).
-func AddAPI(ctx context.Context, db *pgxpool.Pool, in models.API) (models.API, error) {
+func AddAPI(ctx context.Context, db DBTX, in models.API) (models.API, error) {
 	in.ID = uuid.NewString()
-	in.Name = strings.TrimSpace(in.Name)
-	if s := strings.ToLower(strings.TrimSpace(string(in.Status))); s == "" {
-		in.Status = models.APIStatusActive
-	} else {
-		in.Status = models.APIStatus(s)
+	in.Normalize()
+
+	if err := in.Validate(); err != nil {
+		return models.API{}, models.ValidationError(err)
 	}
 
+	const q = `
+		INSERT INTO public.apis (id, name, names, status)
+		VALUES ($1, $2, $3, $4)
+		RETURNING id, name, names, status, created_at, updated_at
+	`
+	var out models.API
+	if err := pgxscan.Get(ctx, db, &out, q, in.ID, in.Name, in.Names, in.Status); err != nil {
+		return models.API{}, dbError(err, "name_exists")
+	}
+	return out, nil
+}
+
+// GetDosageForm returns one dosage form by id.
+func GetDosageForm(ctx context.Context, db DBTX, id string) (models.DosageForm, error) {
+	const q = `SELECT ` + dosageFormColumns + ` FROM public.dosage_forms WHERE id = $1`
+	var out models.DosageForm
+	if err := pgxscan.Get(ctx, db, &out, q, id); err != nil {
+		if pgxscan.NotFound(err) {
+			return models.DosageForm{}, ErrNotFound
+		}
+		return models.DosageForm{}, err
+	}
+	return out, nil
+}
+
+// UpdateDosageForm replaces a dosage form's code, name and group.
+func UpdateDosageForm(ctx context.Context, db DBTX, id string, in models.DosageForm) (models.DosageForm, error) {
+	in.ID = id
+	in.Normalize()
+
 	if err := in.Validate(); err != nil {
-		msg, _ := models.FirstError(err)
-		return models.API{}, errors.New(msg)
+		return models.DosageForm{}, models.ValidationError(err)
 	}
 
 	const q = `
-		INSERT INTO api (id, name, status)
-		VALUES ($1, $2, $3)
-		RETURNING id, name, status, created_at, updated_at
+		UPDATE public.dosage_forms
+		SET code = $2, name = $3, names = $4, form_group = NULLIF($5, ''), updated_at = now()
+		WHERE id = $1
+		RETURNING ` + dosageFormColumns
+	var out models.DosageForm
+	if err := pgxscan.Get(ctx, db, &out, q, in.ID, in.Code, in.Name, in.Names, in.Group); err != nil {
+		if pgxscan.NotFound(err) {
+			return models.DosageForm{}, ErrNotFound
+		}
+		return models.DosageForm{}, dbError(err, "code_exists")
+	}
+	return out, nil
+}
+
+// DeleteDosageForm removes a dosage form that no drug uses.
+func DeleteDosageForm(ctx context.Context, db DBTX, id string) error {
+	return deleteByID(ctx, db, "public.dosage_forms", id)
+}
+
+// GetStrengthUnit returns one strength unit by id.
+func GetStrengthUnit(ctx context.Context, db DBTX, id string) (models.StrengthUnit, error) {
+	const q = `SELECT ` + strengthUnitColumns + ` FROM public.strength_units WHERE id = $1`
+	var out models.StrengthUnit
+	if err := pgxscan.Get(ctx, db, &out, q, id); err != nil {
+		if pgxscan.NotFound(err) {
+			return models.StrengthUnit{}, ErrNotFound
+		}
+		return models.StrengthUnit{}, err
+	}
+	return out, nil
+}
+
+// UpdateStrengthUnit replaces a strength unit's code, name and conversion.
+func UpdateStrengthUnit(ctx context.Context, db DBTX, id string, in models.StrengthUnit) (models.StrengthUnit, error) {
+	in.ID = id
+	in.Normalize()
+
+	if err := in.Validate(); err != nil {
+		return models.StrengthUnit{}, models.ValidationError(err)
+	}
+
+	const q = `
+		UPDATE public.strength_units
+		SET code = $2, name = $3, names = $4, dimension = NULLIF($5, ''), factor = $6, updated_at = now()
+		WHERE id = $1
+		RETURNING ` + strengthUnitColumns
+	var out models.StrengthUnit
+	if err := pgxscan.Get(ctx, db, &out, q, in.ID, in.Code, in.Name, in.Names, in.Dimension, in.Factor); err != nil {
+		if pgxscan.NotFound(err) {
+			return models.StrengthUnit{}, ErrNotFound
+		}
+		return models.StrengthUnit{}, dbError(err, "code_exists")
+	}
+	return out, nil
+}
+
+// DeleteStrengthUnit removes a strength unit that no drug uses.
+func DeleteStrengthUnit(ctx context.Context, db DBTX, id string) error {
+	return deleteByID(ctx, db, "public.strength_units", id)
+}
+
+// GetRouteOfAdmin returns one route of administration by id.
+func GetRouteOfAdmin(ctx context.Context, db DBTX, id string) (models.RouteOfAdmin, error) {
+	const q = `SELECT id, code, name, names, created_at, updated_at
+	           FROM public.routes_of_admin WHERE id = $1`
+	var out models.RouteOfAdmin
+	if err := pgxscan.Get(ctx, db, &out, q, id); err != nil {
+		if pgxscan.NotFound(err) {
+			return models.RouteOfAdmin{}, ErrNotFound
+		}
+		return models.RouteOfAdmin{}, err
+	}
+	return out, nil
+}
+
+// UpdateRouteOfAdmin replaces a route's code and name.
+func UpdateRouteOfAdmin(ctx context.Context, db DBTX, id string, in models.RouteOfAdmin) (models.RouteOfAdmin, error) {
+	in.ID = id
+	in.Normalize()
+
+	if err := in.Validate(); err != nil {
+		return models.RouteOfAdmin{}, models.ValidationError(err)
+	}
+
+	const q = `
+		UPDATE public.routes_of_admin
+		SET code = $2, name = $3, names = $4, updated_at = now()
+		WHERE id = $1
+		RETURNING id, code, name, names, created_at, updated_at
 	`
+	var out models.RouteOfAdmin
+	if err := pgxscan.Get(ctx, db, &out, q, in.ID, in.Code, in.Name, in.Names); err != nil {
+		if pgxscan.NotFound(err) {
+			return models.RouteOfAdmin{}, ErrNotFound
+		}
+		return models.RouteOfAdmin{}, dbError(err, "code_exists")
+	}
+	return out, nil
+}
+
+// DeleteRouteOfAdmin removes a route that no drug uses.
+func DeleteRouteOfAdmin(ctx context.Context, db DBTX, id string) error {
+	return deleteByID(ctx, db, "public.routes_of_admin", id)
+}
+
+// GetAPI returns one API (active ingredient) by id.
+func GetAPI(ctx context.Context, db DBTX, id string) (models.API, error) {
+	const q = `SELECT id, name, names, status, created_at, updated_at
+	           FROM public.apis WHERE id = $1`
 	var out models.API
-	if err := pgxscan.Get(ctx, db, &out, q, in.ID, in.Name, in.Status); err != nil {
-		var pgErr *pgconn.PgError
-		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
-			return models.API{}, errors.New("name already exists")
+	if err := pgxscan.Get(ctx, db, &out, q, id); err != nil {
+		if pgxscan.NotFound(err) {
+			return models.API{}, ErrNotFound
 		}
 		return models.API{}, err
 	}
 	return out, nil
 }
+
+// UpdateAPI replaces an API's name and status.
+func UpdateAPI(ctx context.Context, db DBTX, id string, in models.API) (models.API, error) {
+	in.ID = id
+	in.Normalize()
+
+	if err := in.Validate(); err != nil {
+		return models.API{}, models.ValidationError(err)
+	}
+
+	const q = `
+		UPDATE public.apis
+		SET name = $2, names = $3, status = $4, updated_at = now()
+		WHERE id = $1
+		RETURNING id, name, names, status, created_at, updated_at
+	`
+	var out models.API
+	if err := pgxscan.Get(ctx, db, &out, q, in.ID, in.Name, in.Names, in.Status); err != nil {
+		if pgxscan.NotFound(err) {
+			return models.API{}, ErrNotFound
+		}
+		return models.API{}, dbError(err, "name_exists")
+	}
+	return out, nil
+}
+
+// DeleteAPI removes an API that no drug uses.
+func DeleteAPI(ctx context.Context, db DBTX, id string) error {
+	return deleteByID(ctx, db, "public.apis", id)
+}
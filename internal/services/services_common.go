@@ -0,0 +1,106 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"moh/shared"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// DBTX is what every service function runs its queries on. *pgxpool.Pool,
+// *pgx.Conn and pgx.Tx all satisfy it, so services can be called on their
+// own or composed inside a caller's transaction; Begin on a pgx.Tx opens a
+// savepoint.
+type DBTX interface {
+	Exec(ctx context.Context, sql string, args ...any) (pgconn.CommandTag, error)
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+	Begin(ctx context.Context) (pgx.Tx, error)
+}
+
+// Sentinel errors. Functions return errors.Is-equal ones carrying details.
+// Every error a service returns is a *shared.Error except failures of
+// Postgres itself, which callers report as internal.
+var (
+	// ErrNotFound is returned when the requested row does not exist.
+	ErrNotFound = shared.NotFound("not_found")
+	// ErrInUse is returned when a delete would orphan dependent rows.
+	ErrInUse = shared.Conflict("in_use", "in_use")
+	// ErrInvalidQuery is returned for unknown sort fields or malformed cursors.
+	ErrInvalidQuery = shared.BadRequest("invalid_query", "invalid_query")
+	// ErrIllegalTransition is returned when a status change is not allowed
+	// from the row's current status.
+	ErrIllegalTransition = shared.Conflict("illegal_transition", "illegal_transition")
+)
+
+// InUse reports a delete refused because rows of table still reference the
+// row. It matches ErrInUse.
+func InUse(table string) error {
+	return shared.Conflict("in_use", "in_use_by", table)
+}
+
+// InvalidQuery reports list options that cannot be used, with the catalog
+// message key. It matches ErrInvalidQuery.
+func InvalidQuery(key string, args ...string) error {
+	return shared.BadRequest("invalid_query", key, args...)
+}
+
+// IllegalTransition reports a refused status change, with the catalog
+// message key saying why. It matches ErrIllegalTransition.
+func IllegalTransition(key string, args ...string) error {
+	return shared.Conflict("illegal_transition", key, args...)
+}
+
+// dbError translates the error of an insert or update: a unique violation
+// becomes a conflict with the message keyed dup, a foreign-key violation
+// names the offending column, and anything else is internal. The Postgres
+// message never reaches the result.
+func dbError(err error, dup string) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		switch pgErr.Code {
+		case "23505":
+			return shared.Conflict("already_exists", dup)
+		case "23503":
+			return shared.ForeignKey(fkColumn(pgErr), "")
+		}
+	}
+	return shared.Internal(err)
+}
+
+// isConstraint reports whether err is a violation of the named constraint.
+func isConstraint(err error, name string) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.ConstraintName == name
+}
+
+// fkColumn recovers the column of a foreign-key violation from the
+// constraint name Postgres generates for an inline REFERENCES,
+// <table>_<column>_fkey.
+func fkColumn(pgErr *pgconn.PgError) string {
+	name := strings.TrimSuffix(pgErr.ConstraintName, "_fkey")
+	return strings.TrimPrefix(name, pgErr.TableName+"_")
+}
+
+// deleteByID removes one row from table. A foreign-key violation means
+// other rows still point at it, so the delete is refused with ErrInUse.
+// table must be a trusted constant, never user input.
+func deleteByID(ctx context.Context, db DBTX, table, id string) error {
+	tag, err := db.Exec(ctx, fmt.Sprintf(`DELETE FROM %s WHERE id = $1`, table), id)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23503" {
+			return InUse(pgErr.TableName)
+		}
+		return shared.Internal(err)
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
@@ -2,261 +2,856 @@ package services
 
 import (
 	"context"
-	"errors"
+	"fmt"
 	"strings"
 
 	"moh/models"
+	"moh/shared"
 
 	"github.com/georgysavva/scany/v2/pgxscan"
 	"github.com/google/uuid"
-	"github.com/jackc/pgx/v5/pgconn"
-	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/jackc/pgx/v5"
 )
 
-func AddDrug(ctx context.Context, db *pgxpool.Pool, in models.Drug) (models.Drug, error) {
+// AddDrug creates a drug and its first ingredient, the API, unit and dose
+// of the drug row; the basis of that strength is left for the caller to
+// set on the ingredient.
+func AddDrug(ctx context.Context, db DBTX, in models.Drug) (models.Drug, error) {
 	in.ID = uuid.NewString()
-	in.BrandName = strings.TrimSpace(in.BrandName)
+	in.Normalize()
 
 	if err := in.Validate(); err != nil {
-		msg, _ := models.FirstError(err)
-		return models.Drug{}, errors.New(msg)
+		return models.Drug{}, models.ValidationError(err)
 	}
 
 	const q = `
-        INSERT INTO public.drugs
-            (id, brand_name, dosage_form_id, route_id, strength_unit_id, dose, api_id)
-        VALUES
-            ($1, $2, $3, $4, $5, $6, $7)
-        RETURNING
-            id, brand_name, dosage_form_id, route_id, strength_unit_id, dose, api_id, created_at, updated_at
+        WITH d AS (
+            INSERT INTO public.drugs
+                (id, brand_name, brand_names, dosage_form_id, route_id, strength_unit_id, dose, api_id)
+            VALUES
+                ($1, $2, $3, $4, $5, $6, $7, $8)
+            RETURNING
+                id, brand_name, brand_names, dosage_form_id, route_id, strength_unit_id, dose, api_id, created_at, updated_at
+        ), i AS (
+            INSERT INTO public.drug_ingredients (id, drug_id, api_id, strength, strength_unit_id, created_at, updated_at)
+            SELECT $9, id, api_id, dose, strength_unit_id, created_at, updated_at FROM d
+        )
+        SELECT * FROM d
     `
 	var out models.Drug
 	if err := pgxscan.Get(ctx, db, &out, q,
-		in.ID, in.BrandName, in.DosageFormID, in.RouteID, in.StrengthUnitID, in.Dose, in.APIID,
+		in.ID, in.BrandName, in.BrandNames, in.DosageFormID, in.RouteID, in.StrengthUnitID, in.Dose, in.APIID, uuid.NewString(),
 	); err != nil {
-		var pgErr *pgconn.PgError
-		if errors.As(err, &pgErr) {
-			switch pgErr.Code {
-			case "23503":
-				return models.Drug{}, errors.New("invalid foreign key")
-			case "23505":
-				return models.Drug{}, errors.New("drug already exists")
-			}
-		}
-		return models.Drug{}, err
+		return models.Drug{}, dbError(err, "drug_exists")
+	}
+	return out, nil
+}
+
+// drugIngredientColumns is the select list of a models.DrugIngredient.
+const drugIngredientColumns = `id, drug_id, api_id, strength, strength_unit_id, per_quantity, COALESCE(per_unit_id::text, '') AS per_unit_id,
+	COALESCE(basis, '') AS basis, COALESCE(salt, '') AS salt, created_at, updated_at`
+
+// AddDrugIngredient adds an active ingredient to a drug.
+func AddDrugIngredient(ctx context.Context, db DBTX, in models.DrugIngredient) (models.DrugIngredient, error) {
+	in.ID = uuid.NewString()
+	in.Normalize()
+
+	if err := in.Validate(); err != nil {
+		return models.DrugIngredient{}, models.ValidationError(err)
+	}
+
+	const q = `
+		INSERT INTO public.drug_ingredients (id, drug_id, api_id, strength, strength_unit_id, per_quantity, per_unit_id, basis, salt)
+		VALUES ($1, $2, $3, $4, $5, $6, NULLIF($7, '')::uuid, $8, NULLIF($9, ''))
+		RETURNING ` + drugIngredientColumns
+	var out models.DrugIngredient
+	if err := pgxscan.Get(ctx, db, &out, q,
+		in.ID, in.DrugID, in.APIID, in.Strength, in.StrengthUnitID, in.PerQuantity, in.PerUnitID, in.Basis, in.Salt,
+	); err != nil {
+		return models.DrugIngredient{}, dbError(err, "ingredient_exists")
 	}
 	return out, nil
 }
 
 // AddBatch creates a batch row.
-func AddBatch(ctx context.Context, db *pgxpool.Pool, in models.Batch) (models.Batch, error) {
+// New batches start as planned (the default) or, for batches imported after
+// release, as released; every later change goes through TransitionBatch.
+// A price above the ceiling in force on mfg_date is refused or, under
+// PriceCeilingFlag, stored and listed as a violation.
+func AddBatch(ctx context.Context, db DBTX, in models.Batch, policy models.PriceCeilingPolicy) (models.Batch, error) {
 	in.ID = uuid.NewString()
+	if in.Status == "" {
+		in.Status = models.BatchPlanned
+	}
+	in.Normalize()
+	if !in.Status.IsInitial() {
+		return models.Batch{}, shared.Validation(shared.NewFieldError("status", "oneof", "planned released", "initial_status", string(in.Status)))
+	}
 
 	if err := in.Validate(); err != nil {
-		msg, _ := models.FirstError(err)
-		return models.Batch{}, errors.New(msg)
+		return models.Batch{}, models.ValidationError(err)
+	}
+	if err := checkPriceCeiling(ctx, db, in, policy); err != nil {
+		return models.Batch{}, err
 	}
 
 	const q = `
-		INSERT INTO batch (id, drug_id, drug_registration_id, batch_number, mfg_date, expire_date, qty_in_batch, status, price, recall_reason)
-		VALUES ($1, $2, NULLIF($3, ''), $4, $5, $6, $7, $8, $9, $10)
-		RETURNING id, drug_id, drug_registration_id, batch_number, mfg_date, expire_date, qty_in_batch, status, price, recall_reason, created_at, updated_at
-	`
+		INSERT INTO public.batches (id, drug_id, drug_registration_id, batch_number, mfg_date, expire_date, qty_in_batch, status, price, currency,
+		                            presentation_id, qty_remaining)
+		VALUES ($1, $2, NULLIF($3, '')::uuid, $4, $5, $6, $7, $8, $9, NULLIF($10, ''), NULLIF($11, '')::uuid, $7)
+		RETURNING ` + batchColumns
 	var out models.Batch
 	if err := pgxscan.Get(ctx, db, &out, q,
-		in.ID, in.DrugID, in.DrugRegistrationID, in.BatchNumber, in.MfgDate, in.ExpireDate, in.QtyInBatch, in.Status, in.Price, in.RecallReason,
+		in.ID, in.DrugID, in.DrugRegistrationID, in.BatchNumber, in.MfgDate, in.ExpireDate, in.QtyInBatch, in.Status, in.Price, in.Currency,
+		in.PresentationID,
 	); err != nil {
-		var pgErr *pgconn.PgError
-		if errors.As(err, &pgErr) {
-			switch pgErr.Code {
-			case "23503":
-				return models.Batch{}, errors.New("invalid foreign key")
-			case "23505":
-				return models.Batch{}, errors.New("batch already exists")
-			}
-		}
-		return models.Batch{}, err
+		return models.Batch{}, dbError(err, "batch_exists")
 	}
 	return out, nil
 }
 
 // AddDrugRegistration creates a drug_registration row.
-func AddDrugRegistration(ctx context.Context, db *pgxpool.Pool, in models.DrugRegistration) (models.DrugRegistration, error) {
+func AddDrugRegistration(ctx context.Context, db DBTX, in models.DrugRegistration) (models.DrugRegistration, error) {
 	in.ID = uuid.NewString()
 
 	if err := in.Validate(); err != nil {
-		msg, _ := models.FirstError(err)
-		return models.DrugRegistration{}, errors.New(msg)
+		return models.DrugRegistration{}, models.ValidationError(err)
 	}
 
 	const q = `
-		INSERT INTO drug_registration (id, drug_id, ma_id, registration_number, status, valid_from, valid_to, is_primary)
+		INSERT INTO public.drug_registrations (id, drug_id, ma_id, registration_number, status, valid_from, valid_to, is_primary)
 		VALUES ($1, $2, $3, NULLIF($4, ''), $5, $6, $7, $8)
-		RETURNING id, drug_id, ma_id, registration_number, status, valid_from, valid_to, is_primary, created_at, updated_at
+		RETURNING id, drug_id, ma_id, COALESCE(registration_number, '') AS registration_number, status, valid_from, valid_to, is_primary, created_at, updated_at
 	`
 	var out models.DrugRegistration
 	if err := pgxscan.Get(ctx, db, &out, q,
 		in.ID, in.DrugID, in.MAID, in.RegistrationNumber, in.Status, in.ValidFrom, in.ValidTo, in.IsPrimary,
 	); err != nil {
-		var pgErr *pgconn.PgError
-		if errors.As(err, &pgErr) {
-			switch pgErr.Code {
-			case "23503":
-				return models.DrugRegistration{}, errors.New("invalid foreign key")
-			case "23505":
-				return models.DrugRegistration{}, errors.New("registration already exists")
-			}
-		}
-		return models.DrugRegistration{}, err
+		return models.DrugRegistration{}, dbError(err, "registration_exists")
 	}
 	return out, nil
 }
 
 // AddDrugRegistrationSite links a drug registration to a manufacturing site.
-func AddDrugRegistrationSite(ctx context.Context, db *pgxpool.Pool, in models.DrugRegistrationSite) (models.DrugRegistrationSite, error) {
+func AddDrugRegistrationSite(ctx context.Context, db DBTX, in models.DrugRegistrationSite) (models.DrugRegistrationSite, error) {
 	in.ID = uuid.NewString()
 
 	if err := in.Validate(); err != nil {
-		msg, _ := models.FirstError(err)
-		return models.DrugRegistrationSite{}, errors.New(msg)
+		return models.DrugRegistrationSite{}, models.ValidationError(err)
 	}
 
 	const q = `
-		INSERT INTO drug_registration_site (id, drug_registration_id, site_id, role)
+		INSERT INTO public.drug_registration_sites (id, drug_registration_id, site_id, role)
 		VALUES ($1, $2, $3, NULLIF($4, ''))
-		RETURNING id, drug_registration_id, site_id, role
+		RETURNING id, drug_registration_id, site_id, COALESCE(role, '') AS role
 	`
 	var out models.DrugRegistrationSite
 	if err := pgxscan.Get(ctx, db, &out, q, in.ID, in.DrugRegistrationID, in.SiteID, in.Role); err != nil {
-		var pgErr *pgconn.PgError
-		if errors.As(err, &pgErr) {
-			switch pgErr.Code {
-			case "23503":
-				return models.DrugRegistrationSite{}, errors.New("invalid foreign key")
-			case "23505":
-				return models.DrugRegistrationSite{}, errors.New("link already exists")
-			}
-		}
-		return models.DrugRegistrationSite{}, err
+		return models.DrugRegistrationSite{}, dbError(err, "link_exists")
 	}
 	return out, nil
 }
 
 // AddDrugRegistrationAuthHolder links a drug registration to an auth holder.
-func AddDrugRegistrationAuthHolder(ctx context.Context, db *pgxpool.Pool, in models.DrugRegistrationAuthHolder) (models.DrugRegistrationAuthHolder, error) {
+func AddDrugRegistrationAuthHolder(ctx context.Context, db DBTX, in models.DrugRegistrationAuthHolder) (models.DrugRegistrationAuthHolder, error) {
 	in.ID = uuid.NewString()
 
 	if err := in.Validate(); err != nil {
-		msg, _ := models.FirstError(err)
-		return models.DrugRegistrationAuthHolder{}, errors.New(msg)
+		return models.DrugRegistrationAuthHolder{}, models.ValidationError(err)
 	}
 
 	const q = `
-		INSERT INTO drug_registration_auth_holder (id, drug_registration_id, auth_holder_id, role)
+		INSERT INTO public.drug_registration_auth_holders (id, drug_registration_id, auth_holder_id, role)
 		VALUES ($1, $2, $3, NULLIF($4, ''))
-		RETURNING id, drug_registration_id, auth_holder_id, role
+		RETURNING id, drug_registration_id, auth_holder_id, COALESCE(role, '') AS role
 	`
 	var out models.DrugRegistrationAuthHolder
 	if err := pgxscan.Get(ctx, db, &out, q, in.ID, in.DrugRegistrationID, in.AuthHolderID, in.Role); err != nil {
-		var pgErr *pgconn.PgError
-		if errors.As(err, &pgErr) {
-			switch pgErr.Code {
-			case "23503":
-				return models.DrugRegistrationAuthHolder{}, errors.New("invalid foreign key")
-			case "23505":
-				return models.DrugRegistrationAuthHolder{}, errors.New("link already exists")
+		return models.DrugRegistrationAuthHolder{}, dbError(err, "link_exists")
+	}
+	return out, nil
+}
+
+// CreateDrugRegistrationBundle creates a registration and all of its site and
+// auth holder links in one transaction: if any part fails nothing is saved.
+// Errors from a link name its position, e.g. "sites[1]: invalid foreign key:
+// site_id" with field sites[1].site_id.
+func CreateDrugRegistrationBundle(ctx context.Context, db DBTX, in models.DrugRegistrationBundle) (models.DrugRegistrationBundle, error) {
+	if err := in.Validate(); err != nil {
+		return models.DrugRegistrationBundle{}, models.ValidationError(err)
+	}
+
+	out := models.DrugRegistrationBundle{
+		Sites:       make([]models.DrugRegistrationSite, 0, len(in.Sites)),
+		AuthHolders: make([]models.DrugRegistrationAuthHolder, 0, len(in.AuthHolders)),
+	}
+	err := pgx.BeginFunc(ctx, db, func(tx pgx.Tx) error {
+		reg, err := AddDrugRegistration(ctx, tx, in.Registration)
+		if err != nil {
+			return shared.Within("registration", err)
+		}
+		out.Registration = reg
+
+		for i, s := range in.Sites {
+			s.DrugRegistrationID = reg.ID
+			site, err := AddDrugRegistrationSite(ctx, tx, s)
+			if err != nil {
+				return shared.Within(fmt.Sprintf("sites[%d]", i), err)
 			}
+			out.Sites = append(out.Sites, site)
 		}
-		return models.DrugRegistrationAuthHolder{}, err
+		for i, h := range in.AuthHolders {
+			h.DrugRegistrationID = reg.ID
+			holder, err := AddDrugRegistrationAuthHolder(ctx, tx, h)
+			if err != nil {
+				return shared.Within(fmt.Sprintf("auth_holders[%d]", i), err)
+			}
+			out.AuthHolders = append(out.AuthHolders, holder)
+		}
+		return nil
+	})
+	if err != nil {
+		return models.DrugRegistrationBundle{}, err
 	}
 	return out, nil
 }
 
-func ListDosageForms(ctx context.Context, db *pgxpool.Pool) ([]models.DosageForm, error) {
-	const q = `SELECT id, code, name, created_at, updated_at
-	           FROM public.dosage_forms ORDER BY code`
-	var out []models.DosageForm
-	return out, pgxscan.Select(ctx, db, &out, q)
+// ===== List (keyset paginated) =====
+
+// Sort whitelists shared by the list specs below. Every expression is NOT NULL.
+var (
+	codeNameSorts = map[string]sortField{
+		"code":       {expr: "code", cast: "text"},
+		"name":       nameSort("name", "names"),
+		"created_at": {expr: "created_at", cast: "timestamptz"},
+		"updated_at": {expr: "updated_at", cast: "timestamptz"},
+	}
+	nameCountrySorts = map[string]sortField{
+		"name":       {expr: "lower(name)", cast: "text"},
+		"country":    {expr: "country", cast: "text"},
+		"created_at": {expr: "created_at", cast: "timestamptz"},
+		"updated_at": {expr: "updated_at", cast: "timestamptz"},
+	}
+	siteSorts = map[string]sortField{
+		"name":       nameSort("name", "names"),
+		"country":    {expr: "country", cast: "text"},
+		"created_at": {expr: "created_at", cast: "timestamptz"},
+		"updated_at": {expr: "updated_at", cast: "timestamptz"},
+	}
+)
+
+// addCodeNameFilter applies the filter shared by dosage forms, strength units and routes.
+func addCodeNameFilter(fs *filterSet, f models.CodeNameFilter) {
+	if f.Code != "" {
+		fs.add("code = ?", strings.ToUpper(strings.TrimSpace(f.Code)))
+	}
+	if f.Q != "" {
+		fs.add("(code ILIKE ? OR "+namesMatch("names")+")", likePattern(f.Q))
+	}
+}
+
+// addCountryFilter applies the filter shared by marketing authorizations and
+// sites; match is the condition q is checked with.
+func addCountryFilter(fs *filterSet, f models.CountryFilter, match string) {
+	if f.Country != "" {
+		fs.add("country = ?", strings.ToUpper(strings.TrimSpace(f.Country)))
+	}
+	if f.Q != "" {
+		fs.add(match, likePattern(f.Q))
+	}
 }
 
-func ListStrengthUnits(ctx context.Context, db *pgxpool.Pool) ([]models.StrengthUnit, error) {
-	const q = `SELECT id, code, name, created_at, updated_at
-	           FROM public.strength_units ORDER BY code`
-	var out []models.StrengthUnit
-	return out, pgxscan.Select(ctx, db, &out, q)
+func ListDosageForms(ctx context.Context, db DBTX, f models.CodeNameFilter, p models.ListParams) (models.Page[models.DosageForm], error) {
+	spec := listSpec{
+		table:       "public.dosage_forms",
+		columns:     dosageFormColumns,
+		sorts:       codeNameSorts,
+		defaultSort: "code",
+	}
+	var fs filterSet
+	addCodeNameFilter(&fs, f)
+	return listPage[models.DosageForm](ctx, db, spec, fs, p)
 }
 
-func ListRoutesOfAdmin(ctx context.Context, db *pgxpool.Pool) ([]models.RouteOfAdmin, error) {
-	const q = `SELECT id, code, name, created_at, updated_at
-	           FROM public.routes_of_admin ORDER BY code`
-	var out []models.RouteOfAdmin
-	return out, pgxscan.Select(ctx, db, &out, q)
+func ListStrengthUnits(ctx context.Context, db DBTX, f models.CodeNameFilter, p models.ListParams) (models.Page[models.StrengthUnit], error) {
+	spec := listSpec{
+		table:       "public.strength_units",
+		columns:     strengthUnitColumns,
+		sorts:       codeNameSorts,
+		defaultSort: "code",
+	}
+	var fs filterSet
+	addCodeNameFilter(&fs, f)
+	return listPage[models.StrengthUnit](ctx, db, spec, fs, p)
+}
+
+func ListRoutesOfAdmin(ctx context.Context, db DBTX, f models.CodeNameFilter, p models.ListParams) (models.Page[models.RouteOfAdmin], error) {
+	spec := listSpec{
+		table:       "public.routes_of_admin",
+		columns:     "id, code, name, names, created_at, updated_at",
+		sorts:       codeNameSorts,
+		defaultSort: "code",
+	}
+	var fs filterSet
+	addCodeNameFilter(&fs, f)
+	return listPage[models.RouteOfAdmin](ctx, db, spec, fs, p)
 }
 
-func ListAPIs(ctx context.Context, db *pgxpool.Pool) ([]models.API, error) {
-	const q = `SELECT id, name, status, created_at, updated_at
-	           FROM public.apis ORDER BY lower(name)`
-	var out []models.API
-	return out, pgxscan.Select(ctx, db, &out, q)
+func ListAPIs(ctx context.Context, db DBTX, f models.APIFilter, p models.ListParams) (models.Page[models.API], error) {
+	spec := listSpec{
+		table:   "public.apis",
+		columns: "id, name, names, status, created_at, updated_at",
+		sorts: map[string]sortField{
+			"name":       nameSort("name", "names"),
+			"status":     {expr: "status", cast: "text"},
+			"created_at": {expr: "created_at", cast: "timestamptz"},
+			"updated_at": {expr: "updated_at", cast: "timestamptz"},
+		},
+		defaultSort: "name",
+	}
+	var fs filterSet
+	if f.Status != "" {
+		fs.add("status = ?", f.Status)
+	}
+	if f.Q != "" {
+		fs.add(namesMatch("names"), likePattern(f.Q))
+	}
+	return listPage[models.API](ctx, db, spec, fs, p)
 }
 
-func ListManufacturingSites(ctx context.Context, db *pgxpool.Pool) ([]models.ManufacturingSite, error) {
-	const q = `SELECT id, name, country, created_at, updated_at
-	           FROM public.manufacturing_sites ORDER BY lower(name), country`
-	var out []models.ManufacturingSite
-	return out, pgxscan.Select(ctx, db, &out, q)
+func ListManufacturingSites(ctx context.Context, db DBTX, f models.CountryFilter, p models.ListParams) (models.Page[models.ManufacturingSite], error) {
+	spec := listSpec{
+		table:       "public.manufacturing_sites",
+		columns:     "id, name, names, country, created_at, updated_at",
+		sorts:       siteSorts,
+		defaultSort: "name",
+	}
+	var fs filterSet
+	addCountryFilter(&fs, f, namesMatch("names"))
+	return listPage[models.ManufacturingSite](ctx, db, spec, fs, p)
 }
 
-func ListAuthHolders(ctx context.Context, db *pgxpool.Pool) ([]models.AuthHolder, error) {
-	const q = `SELECT id, name, registration_number, created_at, updated_at
-	           FROM public.auth_holders ORDER BY lower(name)`
-	var out []models.AuthHolder
-	return out, pgxscan.Select(ctx, db, &out, q)
+func ListAuthHolders(ctx context.Context, db DBTX, f models.AuthHolderFilter, p models.ListParams) (models.Page[models.AuthHolder], error) {
+	spec := listSpec{
+		table:   "public.auth_holders",
+		columns: "id, name, COALESCE(registration_number, '') AS registration_number, created_at, updated_at",
+		sorts: map[string]sortField{
+			"name":                {expr: "lower(name)", cast: "text"},
+			"registration_number": {expr: "COALESCE(registration_number, '')", cast: "text"},
+			"created_at":          {expr: "created_at", cast: "timestamptz"},
+			"updated_at":          {expr: "updated_at", cast: "timestamptz"},
+		},
+		defaultSort: "name",
+	}
+	var fs filterSet
+	if f.RegistrationNumber != "" {
+		fs.add("registration_number = ?", strings.TrimSpace(f.RegistrationNumber))
+	}
+	if f.Q != "" {
+		fs.add("name ILIKE ?", likePattern(f.Q))
+	}
+	return listPage[models.AuthHolder](ctx, db, spec, fs, p)
 }
 
-func ListMarketingAuthorizations(ctx context.Context, db *pgxpool.Pool) ([]models.MarketingAuthorization, error) {
-	const q = `SELECT id, name, country, created_at, updated_at
-	           FROM public.marketing_authorizations ORDER BY lower(name), country`
-	var out []models.MarketingAuthorization
-	return out, pgxscan.Select(ctx, db, &out, q)
+func ListMarketingAuthorizations(ctx context.Context, db DBTX, f models.CountryFilter, p models.ListParams) (models.Page[models.MarketingAuthorization], error) {
+	spec := listSpec{
+		table:       "public.marketing_authorizations",
+		columns:     "id, name, country, created_at, updated_at",
+		sorts:       nameCountrySorts,
+		defaultSort: "name",
+	}
+	var fs filterSet
+	addCountryFilter(&fs, f, "name ILIKE ?")
+	return listPage[models.MarketingAuthorization](ctx, db, spec, fs, p)
 }
 
 // ===== Domain tables =====
 
-func ListDrugs(ctx context.Context, db *pgxpool.Pool) ([]models.Drug, error) {
-	// Make sure models.Drug has APIID field: `json:"api_id" db:"api_id"`
-	const q = `SELECT id, brand_name, dosage_form_id, route_id, strength_unit_id, dose, api_id, created_at, updated_at
-	           FROM public.drugs ORDER BY lower(brand_name)`
-	var out []models.Drug
-	return out, pgxscan.Select(ctx, db, &out, q)
+func ListDrugs(ctx context.Context, db DBTX, f models.DrugFilter, p models.ListParams) (models.Page[models.Drug], error) {
+	spec := listSpec{
+		table:   "public.drugs",
+		columns: "id, brand_name, brand_names, dosage_form_id, route_id, strength_unit_id, dose, api_id, created_at, updated_at",
+		sorts: map[string]sortField{
+			"brand_name": nameSort("brand_name", "brand_names"),
+			"dose":       {expr: "dose", cast: "numeric"},
+			"created_at": {expr: "created_at", cast: "timestamptz"},
+			"updated_at": {expr: "updated_at", cast: "timestamptz"},
+		},
+		defaultSort: "brand_name",
+	}
+	var fs filterSet
+	if f.APIID != "" {
+		fs.add("(api_id = ? OR EXISTS (SELECT 1 FROM public.drug_ingredients i WHERE i.drug_id = drugs.id AND i.api_id = ?))", f.APIID)
+	}
+	if f.DosageFormID != "" {
+		fs.add("dosage_form_id = ?", f.DosageFormID)
+	}
+	if f.RouteID != "" {
+		fs.add("route_id = ?", f.RouteID)
+	}
+	if f.StrengthUnitID != "" {
+		fs.add("strength_unit_id = ?", f.StrengthUnitID)
+	}
+	if f.Q != "" {
+		fs.add(namesMatch("brand_names"), likePattern(f.Q))
+	}
+	return listPage[models.Drug](ctx, db, spec, fs, p)
+}
+
+func ListBatches(ctx context.Context, db DBTX, f models.BatchFilter, p models.ListParams) (models.Page[models.Batch], error) {
+	spec := listSpec{
+		table:   "public.batches",
+		columns: batchColumns,
+		sorts: map[string]sortField{
+			"expire_date":  {expr: "expire_date", cast: "date"},
+			"mfg_date":     {expr: "mfg_date", cast: "date"},
+			"batch_number": {expr: "batch_number", cast: "text"},
+			"qty_in_batch": {expr: "qty_in_batch", cast: "bigint"},
+			"price":        {expr: "price", cast: "numeric"},
+			"created_at":   {expr: "created_at", cast: "timestamptz"},
+			"updated_at":   {expr: "updated_at", cast: "timestamptz"},
+		},
+		defaultSort: "-expire_date",
+	}
+	var fs filterSet
+	if f.Status != "" {
+		fs.add("status = ?", f.Status)
+	}
+	if f.DrugID != "" {
+		fs.add("drug_id = ?", f.DrugID)
+	}
+	if f.DrugRegistrationID != "" {
+		fs.add("drug_registration_id = ?", f.DrugRegistrationID)
+	}
+	if f.PresentationID != "" {
+		fs.add("presentation_id = ?", f.PresentationID)
+	}
+	if f.BatchNumber != "" {
+		fs.add("batch_number = ?", strings.TrimSpace(f.BatchNumber))
+	}
+	if !f.ExpireBefore.IsZero() {
+		fs.add("expire_date < ?", f.ExpireBefore)
+	}
+	if !f.ExpireAfter.IsZero() {
+		fs.add("expire_date > ?", f.ExpireAfter)
+	}
+	return listPage[models.Batch](ctx, db, spec, fs, p)
+}
+
+func ListDrugRegistrations(ctx context.Context, db DBTX, f models.DrugRegistrationFilter, p models.ListParams) (models.Page[models.DrugRegistration], error) {
+	spec := listSpec{
+		table: "public.drug_registrations",
+		columns: `id, drug_id, ma_id, COALESCE(registration_number, '') AS registration_number, status, valid_from, valid_to,
+		          is_primary, created_at, updated_at`,
+		sorts: map[string]sortField{
+			"valid_to":            {expr: "valid_to", cast: "date"},
+			"valid_from":          {expr: "valid_from", cast: "date"},
+			"registration_number": {expr: "COALESCE(registration_number, '')", cast: "text"},
+			"created_at":          {expr: "created_at", cast: "timestamptz"},
+			"updated_at":          {expr: "updated_at", cast: "timestamptz"},
+		},
+		defaultSort: "-valid_to",
+	}
+	var fs filterSet
+	if f.Status != "" {
+		fs.add("status = ?", f.Status)
+	}
+	if f.DrugID != "" {
+		fs.add("drug_id = ?", f.DrugID)
+	}
+	if f.MAID != "" {
+		fs.add("ma_id = ?", f.MAID)
+	}
+	if !f.ValidOn.IsZero() {
+		fs.add("valid_from <= ?", f.ValidOn)
+		fs.add("valid_to >= ?", f.ValidOn)
+	}
+	if f.IsPrimary != nil {
+		fs.add("is_primary = ?", *f.IsPrimary)
+	}
+	return listPage[models.DrugRegistration](ctx, db, spec, fs, p)
+}
+
+// ListDrugIngredients lists ingredients, oldest first, so a drug's first
+// ingredient comes first.
+func ListDrugIngredients(ctx context.Context, db DBTX, f models.DrugIngredientFilter, p models.ListParams) (models.Page[models.DrugIngredient], error) {
+	spec := listSpec{
+		table:   "public.drug_ingredients",
+		columns: drugIngredientColumns,
+		sorts: map[string]sortField{
+			"strength":   {expr: "strength", cast: "numeric"},
+			"created_at": {expr: "created_at", cast: "timestamptz"},
+			"updated_at": {expr: "updated_at", cast: "timestamptz"},
+		},
+		defaultSort: "created_at",
+	}
+	var fs filterSet
+	if f.DrugID != "" {
+		fs.add("drug_id = ?", f.DrugID)
+	}
+	if f.APIID != "" {
+		fs.add("api_id = ?", f.APIID)
+	}
+	return listPage[models.DrugIngredient](ctx, db, spec, fs, p)
+}
+
+func ListDrugRegistrationSites(ctx context.Context, db DBTX, f models.DrugRegistrationSiteFilter, p models.ListParams) (models.Page[models.DrugRegistrationSite], error) {
+	spec := listSpec{
+		table:   "public.drug_registration_sites",
+		columns: "id, drug_registration_id, site_id, COALESCE(role, '') AS role",
+		sorts: map[string]sortField{
+			"role": {expr: "COALESCE(role, '')", cast: "text"},
+		},
+		defaultSort: "role",
+	}
+	var fs filterSet
+	if f.DrugRegistrationID != "" {
+		fs.add("drug_registration_id = ?", f.DrugRegistrationID)
+	}
+	if f.SiteID != "" {
+		fs.add("site_id = ?", f.SiteID)
+	}
+	return listPage[models.DrugRegistrationSite](ctx, db, spec, fs, p)
+}
+
+func ListDrugRegistrationAuthHolders(ctx context.Context, db DBTX, f models.DrugRegistrationAuthHolderFilter, p models.ListParams) (models.Page[models.DrugRegistrationAuthHolder], error) {
+	spec := listSpec{
+		table:   "public.drug_registration_auth_holders",
+		columns: "id, drug_registration_id, auth_holder_id, COALESCE(role, '') AS role",
+		sorts: map[string]sortField{
+			"role": {expr: "COALESCE(role, '')", cast: "text"},
+		},
+		defaultSort: "role",
+	}
+	var fs filterSet
+	if f.DrugRegistrationID != "" {
+		fs.add("drug_registration_id = ?", f.DrugRegistrationID)
+	}
+	if f.AuthHolderID != "" {
+		fs.add("auth_holder_id = ?", f.AuthHolderID)
+	}
+	return listPage[models.DrugRegistrationAuthHolder](ctx, db, spec, fs, p)
+}
+
+// ===== Get / Update / Delete =====
+
+// GetDrug returns one drug by id.
+func GetDrug(ctx context.Context, db DBTX, id string) (models.Drug, error) {
+	const q = `SELECT id, brand_name, brand_names, dosage_form_id, route_id, strength_unit_id, dose, api_id, created_at, updated_at
+	           FROM public.drugs WHERE id = $1`
+	var out models.Drug
+	if err := pgxscan.Get(ctx, db, &out, q, id); err != nil {
+		if pgxscan.NotFound(err) {
+			return models.Drug{}, ErrNotFound
+		}
+		return models.Drug{}, err
+	}
+	return out, nil
+}
+
+// UpdateDrug replaces every editable column of a drug. A change of its API,
+// unit or dose is carried over to the ingredient that mirrors them.
+func UpdateDrug(ctx context.Context, db DBTX, id string, in models.Drug) (models.Drug, error) {
+	in.ID = id
+	in.Normalize()
+
+	if err := in.Validate(); err != nil {
+		return models.Drug{}, models.ValidationError(err)
+	}
+
+	const q = `
+		WITH old AS (
+			SELECT id, api_id FROM public.drugs WHERE id = $1
+		), d AS (
+			UPDATE public.drugs
+			SET brand_name = $2, brand_names = $3, dosage_form_id = $4, route_id = $5, strength_unit_id = $6, dose = $7, api_id = $8,
+			    updated_at = now()
+			WHERE id = $1
+			RETURNING id, brand_name, brand_names, dosage_form_id, route_id, strength_unit_id, dose, api_id, created_at, updated_at
+		), i AS (
+			UPDATE public.drug_ingredients i
+			SET api_id = d.api_id, strength = d.dose, strength_unit_id = d.strength_unit_id, updated_at = d.updated_at
+			FROM d JOIN old ON old.id = d.id
+			WHERE i.drug_id = d.id AND i.api_id = old.api_id
+			  AND (i.api_id, i.strength, i.strength_unit_id) IS DISTINCT FROM (d.api_id, d.dose, d.strength_unit_id)
+		)
+		SELECT * FROM d
+	`
+	var out models.Drug
+	if err := pgxscan.Get(ctx, db, &out, q,
+		in.ID, in.BrandName, in.BrandNames, in.DosageFormID, in.RouteID, in.StrengthUnitID, in.Dose, in.APIID,
+	); err != nil {
+		if pgxscan.NotFound(err) {
+			return models.Drug{}, ErrNotFound
+		}
+		if isConstraint(err, "drug_ingredients_drug_api_key") {
+			return models.Drug{}, shared.Conflict("already_exists", "ingredient_exists")
+		}
+		return models.Drug{}, dbError(err, "drug_exists")
+	}
+	return out, nil
+}
+
+// DeleteDrug removes a drug that has no registrations or batches, with its
+// ingredients.
+func DeleteDrug(ctx context.Context, db DBTX, id string) error {
+	return deleteByID(ctx, db, "public.drugs", id)
+}
+
+// GetDrugIngredient returns one drug ingredient by id.
+func GetDrugIngredient(ctx context.Context, db DBTX, id string) (models.DrugIngredient, error) {
+	const q = `SELECT ` + drugIngredientColumns + ` FROM public.drug_ingredients WHERE id = $1`
+	var out models.DrugIngredient
+	if err := pgxscan.Get(ctx, db, &out, q, id); err != nil {
+		if pgxscan.NotFound(err) {
+			return models.DrugIngredient{}, ErrNotFound
+		}
+		return models.DrugIngredient{}, err
+	}
+	return out, nil
+}
+
+// UpdateDrugIngredient replaces a drug ingredient.
+func UpdateDrugIngredient(ctx context.Context, db DBTX, id string, in models.DrugIngredient) (models.DrugIngredient, error) {
+	in.ID = id
+	in.Normalize()
+
+	if err := in.Validate(); err != nil {
+		return models.DrugIngredient{}, models.ValidationError(err)
+	}
+
+	const q = `
+		UPDATE public.drug_ingredients
+		SET drug_id = $2, api_id = $3, strength = $4, strength_unit_id = $5, per_quantity = $6,
+		    per_unit_id = NULLIF($7, '')::uuid, basis = $8, salt = NULLIF($9, ''), updated_at = now()
+		WHERE id = $1
+		RETURNING ` + drugIngredientColumns
+	var out models.DrugIngredient
+	if err := pgxscan.Get(ctx, db, &out, q,
+		in.ID, in.DrugID, in.APIID, in.Strength, in.StrengthUnitID, in.PerQuantity, in.PerUnitID, in.Basis, in.Salt,
+	); err != nil {
+		if pgxscan.NotFound(err) {
+			return models.DrugIngredient{}, ErrNotFound
+		}
+		return models.DrugIngredient{}, dbError(err, "ingredient_exists")
+	}
+	return out, nil
+}
+
+// DeleteDrugIngredient removes an ingredient from a drug.
+func DeleteDrugIngredient(ctx context.Context, db DBTX, id string) error {
+	return deleteByID(ctx, db, "public.drug_ingredients", id)
+}
+
+// GetBatch returns one batch by id.
+func GetBatch(ctx context.Context, db DBTX, id string) (models.Batch, error) {
+	const q = `SELECT ` + batchColumns + ` FROM public.batches WHERE id = $1`
+	var out models.Batch
+	if err := pgxscan.Get(ctx, db, &out, q, id); err != nil {
+		if pgxscan.NotFound(err) {
+			return models.Batch{}, ErrNotFound
+		}
+		return models.Batch{}, err
+	}
+	return out, nil
+}
+
+// UpdateBatch replaces every editable column of a batch. The status is not
+// editable: it must match the stored one, changes go through TransitionBatch.
+// qty_in_batch, and the remaining quantity with it, changes only as
+// BatchQtyRemaining allows; the batch row is locked meanwhile, so no stock
+// movement comes in between. The price is checked against its ceiling as in
+// AddBatch.
+func UpdateBatch(ctx context.Context, db DBTX, id string, in models.Batch, policy models.PriceCeilingPolicy) (models.Batch, error) {
+	in.ID = id
+	in.Normalize()
+
+	if err := in.Validate(); err != nil {
+		return models.Batch{}, models.ValidationError(err)
+	}
+	if err := checkPriceCeiling(ctx, db, in, policy); err != nil {
+		return models.Batch{}, err
+	}
+
+	var out models.Batch
+	err := pgx.BeginFunc(ctx, db, func(tx pgx.Tx) error {
+		var b models.Batch
+		if err := pgxscan.Get(ctx, tx, &b, `SELECT `+batchColumns+` FROM public.batches WHERE id = $1 FOR UPDATE`, id); err != nil {
+			if pgxscan.NotFound(err) {
+				return ErrNotFound
+			}
+			return err
+		}
+		if in.Status != b.Status {
+			return IllegalTransition("use_transition_endpoints")
+		}
+		var moved bool
+		if in.QtyInBatch != b.QtyInBatch {
+			const movedQ = `SELECT EXISTS (SELECT 1 FROM public.stock_movements WHERE batch_id = $1)`
+			if err := tx.QueryRow(ctx, movedQ, id).Scan(&moved); err != nil {
+				return err
+			}
+		}
+		remaining, err := BatchQtyRemaining(b, in.QtyInBatch, moved)
+		if err != nil {
+			return err
+		}
+
+		const q = `
+			UPDATE public.batches
+			SET drug_id = $2, drug_registration_id = NULLIF($3, '')::uuid, batch_number = $4, mfg_date = $5, expire_date = $6,
+			    qty_in_batch = $7, qty_remaining = $8, price = $9, currency = NULLIF($10, ''),
+			    presentation_id = NULLIF($11, '')::uuid, updated_at = now()
+			WHERE id = $1
+			RETURNING ` + batchColumns
+		if err := pgxscan.Get(ctx, tx, &out, q,
+			in.ID, in.DrugID, in.DrugRegistrationID, in.BatchNumber, in.MfgDate, in.ExpireDate, in.QtyInBatch, remaining, in.Price, in.Currency,
+			in.PresentationID,
+		); err != nil {
+			return dbError(err, "batch_exists")
+		}
+		return nil
+	})
+	if err != nil {
+		return models.Batch{}, err
+	}
+	return out, nil
 }
 
-func ListBatches(ctx context.Context, db *pgxpool.Pool) ([]models.Batch, error) {
-	const q = `SELECT id, drug_id, drug_registration_id, batch_number, mfg_date, expire_date,
-	              qty_in_batch, status, price, recall_reason, created_at, updated_at
-	           FROM public.batches ORDER BY expire_date DESC, batch_number`
-	var out []models.Batch
-	return out, pgxscan.Select(ctx, db, &out, q)
+// DeleteBatch removes a batch.
+func DeleteBatch(ctx context.Context, db DBTX, id string) error {
+	return deleteByID(ctx, db, "public.batches", id)
 }
 
-func ListDrugRegistrations(ctx context.Context, db *pgxpool.Pool) ([]models.DrugRegistration, error) {
-	const q = `SELECT id, drug_id, ma_id, registration_number, status, valid_from, valid_to,
+// GetDrugRegistration returns one drug registration by id.
+func GetDrugRegistration(ctx context.Context, db DBTX, id string) (models.DrugRegistration, error) {
+	const q = `SELECT id, drug_id, ma_id, COALESCE(registration_number, '') AS registration_number, status, valid_from, valid_to,
 	              is_primary, created_at, updated_at
-	           FROM public.drug_registrations ORDER BY valid_to DESC`
-	var out []models.DrugRegistration
-	return out, pgxscan.Select(ctx, db, &out, q)
+	           FROM public.drug_registrations WHERE id = $1`
+	var out models.DrugRegistration
+	if err := pgxscan.Get(ctx, db, &out, q, id); err != nil {
+		if pgxscan.NotFound(err) {
+			return models.DrugRegistration{}, ErrNotFound
+		}
+		return models.DrugRegistration{}, err
+	}
+	return out, nil
+}
+
+// UpdateDrugRegistration replaces every editable column of a drug registration.
+func UpdateDrugRegistration(ctx context.Context, db DBTX, id string, in models.DrugRegistration) (models.DrugRegistration, error) {
+	in.ID = id
+
+	if err := in.Validate(); err != nil {
+		return models.DrugRegistration{}, models.ValidationError(err)
+	}
+
+	const q = `
+		UPDATE public.drug_registrations
+		SET drug_id = $2, ma_id = $3, registration_number = NULLIF($4, ''), status = $5, valid_from = $6, valid_to = $7,
+		    is_primary = $8, updated_at = now()
+		WHERE id = $1
+		RETURNING id, drug_id, ma_id, COALESCE(registration_number, '') AS registration_number, status, valid_from, valid_to, is_primary, created_at, updated_at
+	`
+	var out models.DrugRegistration
+	if err := pgxscan.Get(ctx, db, &out, q,
+		in.ID, in.DrugID, in.MAID, in.RegistrationNumber, in.Status, in.ValidFrom, in.ValidTo, in.IsPrimary,
+	); err != nil {
+		if pgxscan.NotFound(err) {
+			return models.DrugRegistration{}, ErrNotFound
+		}
+		return models.DrugRegistration{}, dbError(err, "registration_exists")
+	}
+	return out, nil
+}
+
+// DeleteDrugRegistration removes a registration with no site, holder or batch links.
+func DeleteDrugRegistration(ctx context.Context, db DBTX, id string) error {
+	return deleteByID(ctx, db, "public.drug_registrations", id)
+}
+
+// GetDrugRegistrationSite returns one registration/site link by id.
+func GetDrugRegistrationSite(ctx context.Context, db DBTX, id string) (models.DrugRegistrationSite, error) {
+	const q = `SELECT id, drug_registration_id, site_id, COALESCE(role, '') AS role
+	           FROM public.drug_registration_sites WHERE id = $1`
+	var out models.DrugRegistrationSite
+	if err := pgxscan.Get(ctx, db, &out, q, id); err != nil {
+		if pgxscan.NotFound(err) {
+			return models.DrugRegistrationSite{}, ErrNotFound
+		}
+		return models.DrugRegistrationSite{}, err
+	}
+	return out, nil
+}
+
+// UpdateDrugRegistrationSite replaces a registration/site link.
+func UpdateDrugRegistrationSite(ctx context.Context, db DBTX, id string, in models.DrugRegistrationSite) (models.DrugRegistrationSite, error) {
+	in.ID = id
+
+	if err := in.Validate(); err != nil {
+		return models.DrugRegistrationSite{}, models.ValidationError(err)
+	}
+
+	const q = `
+		UPDATE public.drug_registration_sites
+		SET drug_registration_id = $2, site_id = $3, role = NULLIF($4, '')
+		WHERE id = $1
+		RETURNING id, drug_registration_id, site_id, COALESCE(role, '') AS role
+	`
+	var out models.DrugRegistrationSite
+	if err := pgxscan.Get(ctx, db, &out, q, in.ID, in.DrugRegistrationID, in.SiteID, in.Role); err != nil {
+		if pgxscan.NotFound(err) {
+			return models.DrugRegistrationSite{}, ErrNotFound
+		}
+		return models.DrugRegistrationSite{}, dbError(err, "link_exists")
+	}
+	return out, nil
+}
+
+// DeleteDrugRegistrationSite removes a registration/site link.
+func DeleteDrugRegistrationSite(ctx context.Context, db DBTX, id string) error {
+	return deleteByID(ctx, db, "public.drug_registration_sites", id)
+}
+
+// GetDrugRegistrationAuthHolder returns one registration/holder link by id.
+func GetDrugRegistrationAuthHolder(ctx context.Context, db DBTX, id string) (models.DrugRegistrationAuthHolder, error) {
+	const q = `SELECT id, drug_registration_id, auth_holder_id, COALESCE(role, '') AS role
+	           FROM public.drug_registration_auth_holders WHERE id = $1`
+	var out models.DrugRegistrationAuthHolder
+	if err := pgxscan.Get(ctx, db, &out, q, id); err != nil {
+		if pgxscan.NotFound(err) {
+			return models.DrugRegistrationAuthHolder{}, ErrNotFound
+		}
+		return models.DrugRegistrationAuthHolder{}, err
+	}
+	return out, nil
 }
 
-func ListDrugRegistrationSites(ctx context.Context, db *pgxpool.Pool) ([]models.DrugRegistrationSite, error) {
-	const q = `SELECT id, drug_registration_id, site_id, role
-	           FROM public.drug_registration_sites ORDER BY role NULLS LAST`
-	var out []models.DrugRegistrationSite
-	return out, pgxscan.Select(ctx, db, &out, q)
+// UpdateDrugRegistrationAuthHolder replaces a registration/holder link.
+func UpdateDrugRegistrationAuthHolder(ctx context.Context, db DBTX, id string, in models.DrugRegistrationAuthHolder) (models.DrugRegistrationAuthHolder, error) {
+	in.ID = id
+
+	if err := in.Validate(); err != nil {
+		return models.DrugRegistrationAuthHolder{}, models.ValidationError(err)
+	}
+
+	const q = `
+		UPDATE public.drug_registration_auth_holders
+		SET drug_registration_id = $2, auth_holder_id = $3, role = NULLIF($4, '')
+		WHERE id = $1
+		RETURNING id, drug_registration_id, auth_holder_id, COALESCE(role, '') AS role
+	`
+	var out models.DrugRegistrationAuthHolder
+	if err := pgxscan.Get(ctx, db, &out, q, in.ID, in.DrugRegistrationID, in.AuthHolderID, in.Role); err != nil {
+		if pgxscan.NotFound(err) {
+			return models.DrugRegistrationAuthHolder{}, ErrNotFound
+		}
+		return models.DrugRegistrationAuthHolder{}, dbError(err, "link_exists")
+	}
+	return out, nil
 }
 
-func ListDrugRegistrationAuthHolders(ctx context.Context, db *pgxpool.Pool) ([]models.DrugRegistrationAuthHolder, error) {
-	const q = `SELECT id, drug_registration_id, auth_holder_id, role
-	           FROM public.drug_registration_auth_holders ORDER BY role NULLS LAST`
-	var out []models.DrugRegistrationAuthHolder
-	return out, pgxscan.Select(ctx, db, &out, q)
+// DeleteDrugRegistrationAuthHolder removes a registration/holder link.
+func DeleteDrugRegistrationAuthHolder(ctx context.Context, db DBTX, id string) error {
+	return deleteByID(ctx, db, "public.drug_registration_auth_holders", id)
 }
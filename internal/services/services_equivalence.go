@@ -0,0 +1,144 @@
+package services
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"strings"
+
+	"moh/models"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+)
+
+// DrugEquivalents returns the marketed drugs interchangeable with drug id:
+// same route, same or same-group dosage form, an active registration in
+// force today and at least one released batch, and the same composition
+// by EquivalenceKey. SQL narrows the candidates to drugs sharing an
+// ingredient; compositions are compared in process.
+func DrugEquivalents(ctx context.Context, db DBTX, id string) (models.DrugEquivalents, error) {
+	drug, err := GetDrug(ctx, db, id)
+	if err != nil {
+		return models.DrugEquivalents{}, err
+	}
+
+	const q = `
+		SELECT d.id, d.brand_name, d.brand_names, d.dosage_form_id, d.route_id, d.strength_unit_id, d.dose, d.api_id,
+		       d.created_at, d.updated_at,
+		       r.id AS registration_id, COALESCE(r.registration_number, '') AS registration_number, b.released_batches
+		FROM public.drugs d
+		JOIN public.dosage_forms f ON f.id = d.dosage_form_id
+		CROSS JOIN LATERAL (
+			SELECT id, registration_number FROM public.drug_registrations
+			WHERE drug_id = d.id AND status = 'active' AND current_date BETWEEN valid_from AND valid_to
+			ORDER BY is_primary DESC, valid_to DESC, id
+			LIMIT 1
+		) r
+		CROSS JOIN LATERAL (
+			SELECT count(*) AS released_batches FROM public.batches
+			WHERE drug_id = d.id AND status = 'released'
+		) b
+		WHERE d.id <> $1 AND d.route_id = $2
+		  AND (d.dosage_form_id = $3 OR f.form_group = (SELECT form_group FROM public.dosage_forms WHERE id = $3))
+		  AND b.released_batches > 0
+		  AND EXISTS (
+			SELECT 1 FROM public.drug_ingredients i
+			JOIN public.drug_ingredients ri ON ri.api_id = i.api_id AND ri.drug_id = $1
+			WHERE i.drug_id = d.id
+		  )
+		ORDER BY lower(d.brand_name), d.id
+	`
+	var candidates []models.DrugEquivalent
+	if err := pgxscan.Select(ctx, db, &candidates, q, drug.ID, drug.RouteID, drug.DosageFormID); err != nil {
+		return models.DrugEquivalents{}, err
+	}
+
+	ids := []string{drug.ID}
+	for _, c := range candidates {
+		ids = append(ids, c.ID)
+	}
+	var ingredients []models.DrugIngredient
+	if err := pgxscan.Select(ctx, db, &ingredients,
+		`SELECT `+drugIngredientColumns+` FROM public.drug_ingredients WHERE drug_id = ANY($1)`, ids,
+	); err != nil {
+		return models.DrugEquivalents{}, err
+	}
+	var units []models.StrengthUnit
+	if err := pgxscan.Select(ctx, db, &units, `
+		SELECT `+strengthUnitColumns+` FROM public.strength_units
+		WHERE id IN (SELECT strength_unit_id FROM public.drug_ingredients WHERE drug_id = ANY($1)
+		             UNION SELECT per_unit_id FROM public.drug_ingredients WHERE drug_id = ANY($1))`, ids,
+	); err != nil {
+		return models.DrugEquivalents{}, err
+	}
+	return MatchEquivalents(drug.ID, candidates, ingredients, units), nil
+}
+
+// MatchEquivalents keeps the candidates whose EquivalenceKey equals that of
+// drug id, in their given order. ingredients and units must cover id and
+// every candidate.
+func MatchEquivalents(id string, candidates []models.DrugEquivalent, ingredients []models.DrugIngredient, units []models.StrengthUnit) models.DrugEquivalents {
+	byDrug := map[string][]models.DrugIngredient{}
+	for _, i := range ingredients {
+		byDrug[i.DrugID] = append(byDrug[i.DrugID], i)
+	}
+	unitByID := make(map[string]models.StrengthUnit, len(units))
+	for _, u := range units {
+		unitByID[u.ID] = u
+	}
+
+	out := models.DrugEquivalents{DrugID: id, Items: []models.DrugEquivalent{}}
+	want := EquivalenceKey(byDrug[id], unitByID)
+	if want == "" {
+		return out
+	}
+	for _, c := range candidates {
+		if EquivalenceKey(byDrug[c.ID], unitByID) == want {
+			out.Items = append(out.Items, c)
+		}
+	}
+	return out
+}
+
+// EquivalenceKey identifies a composition: each ingredient's API and
+// strength, converted to the base unit of its dimension (or to a
+// concentration when it is per a quantity), so 500 mg and 0.5 g, or
+// 250 mg/5 mL and 50 mg/mL, give the same key. A strength stated as a salt
+// also keys on the salt, since it is not comparable to the base. Strengths
+// in units without a dimension only match the same unit.
+func EquivalenceKey(ingredients []models.DrugIngredient, units map[string]models.StrengthUnit) string {
+	parts := make([]string, 0, len(ingredients))
+	for _, i := range ingredients {
+		amount, dim := measure(i.Strength, i.StrengthUnitID, units)
+		if i.PerQuantity != nil && i.PerQuantity.Sign() > 0 {
+			per, perDim := measure(*i.PerQuantity, i.PerUnitID, units)
+			r := amount.Rat()
+			amount = models.DecimalFromRat(r.Quo(r, per.Rat()), conversionPlaces)
+			dim += "/" + perDim
+		}
+		salt := ""
+		if i.Basis == models.BasisSalt {
+			salt = strings.ToLower(i.Salt)
+		}
+		parts = append(parts, strings.Join([]string{i.APIID, amount.String(), dim, salt}, "\x1f"))
+	}
+	slices.Sort(parts)
+	return strings.Join(parts, "\x1e")
+}
+
+// measure is v in unit id, in base units with the dimension's name if the
+// unit converts, or as given with the unit's id if not.
+func measure(v models.Decimal, id string, units map[string]models.StrengthUnit) (models.Decimal, string) {
+	u := units[id]
+	if base, ok := BaseStrength(v, u); ok {
+		return base, string(u.Dimension)
+	}
+	return v.Reduced(), id
+}
+
+// SortEquivalents orders drugs as DrugEquivalents does: by brand name, then id.
+func SortEquivalents(items []models.DrugEquivalent) {
+	slices.SortFunc(items, func(a, b models.DrugEquivalent) int {
+		return cmp.Or(cmp.Compare(strings.ToLower(a.BrandName), strings.ToLower(b.BrandName)), cmp.Compare(a.ID, b.ID))
+	})
+}
@@ -0,0 +1,66 @@
+package services
+
+import (
+	"context"
+
+	"moh/models"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// SchedulerActor is the changed_by recorded for transitions made by background jobs.
+const SchedulerActor = "system:scheduler"
+
+// ExpireBatches moves every batch whose expire_date has passed, and whose
+// status allows it, to expired and records the change in batch_status_history.
+// Rows locked by a concurrent transition are skipped and picked up next run.
+func ExpireBatches(ctx context.Context, tx pgx.Tx) (int64, error) {
+	var from []string
+	for _, s := range models.BatchStatusesInto(models.BatchExpired) {
+		from = append(from, string(s))
+	}
+	const q = `
+		WITH due AS (
+			SELECT id, status FROM public.batches
+			WHERE status = ANY($1) AND expire_date < current_date
+			FOR UPDATE SKIP LOCKED
+		), expired AS (
+			UPDATE public.batches b SET status = 'expired', updated_at = now()
+			FROM due WHERE b.id = due.id
+			RETURNING b.id, due.status AS from_status
+		)
+		INSERT INTO public.batch_status_history (id, batch_id, from_status, to_status, reason, changed_by)
+		SELECT gen_random_uuid(), id, from_status, 'expired', 'expire_date passed', $2
+		FROM expired
+	`
+	tag, err := tx.Exec(ctx, q, from, SchedulerActor)
+	if err != nil {
+		return 0, err
+	}
+	return tag.RowsAffected(), nil
+}
+
+// ExpireDrugRegistrations moves active and suspended registrations whose
+// valid_to has passed to expired and records the change in
+// drug_registration_status_history.
+func ExpireDrugRegistrations(ctx context.Context, tx pgx.Tx) (int64, error) {
+	const q = `
+		WITH due AS (
+			SELECT id, status FROM public.drug_registrations
+			WHERE status IN ('active', 'suspended') AND valid_to < current_date
+			FOR UPDATE SKIP LOCKED
+		), expired AS (
+			UPDATE public.drug_registrations r SET status = 'expired', updated_at = now()
+			FROM due WHERE r.id = due.id
+			RETURNING r.id, due.status AS from_status
+		)
+		INSERT INTO public.drug_registration_status_history (id, drug_registration_id, from_status, to_status, reason, changed_by)
+		SELECT gen_random_uuid(), id, from_status, 'expired', 'valid_to passed', $1
+		FROM expired
+	`
+	tag, err := tx.Exec(ctx, q, SchedulerActor)
+	if err != nil {
+		return 0, err
+	}
+	return tag.RowsAffected(), nil
+}
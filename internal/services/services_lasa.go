@@ -0,0 +1,272 @@
+package services
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"unicode"
+
+	"moh/models"
+	"moh/shared"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"golang.org/x/text/runes"
+	"golang.org/x/text/transform"
+	"golang.org/x/text/unicode/norm"
+)
+
+const defaultBrandMatches = 5
+
+// Weights of the look-alike/sound-alike score. Spelling counts most; the
+// phonetic key catches transliteration variants that spell differently,
+// and a shared beginning is what makes names easy to misread on a shelf.
+const (
+	brandEditWeight     = 0.5
+	brandPhoneticWeight = 0.3
+	brandPrefixWeight   = 0.2
+
+	// brandPrefixLetters shared leading letters score the full prefix weight.
+	brandPrefixLetters = 4
+)
+
+// BrandCandidate is an existing drug and the spellings of its brand name.
+type BrandCandidate struct {
+	DrugID     string               `db:"id"`
+	BrandName  string               `db:"brand_name"`
+	BrandNames models.LocalizedName `db:"brand_names"`
+}
+
+// SimilarBrands scores every registered brand against the names of in and
+// returns the closest, best first. Phonetic similarity cannot be indexed,
+// so all brands are read and scored in process.
+func SimilarBrands(ctx context.Context, db DBTX, in models.BrandCheck) ([]models.BrandMatch, error) {
+	in.Normalize()
+	if err := in.Validate(); err != nil {
+		return nil, models.QueryError(err)
+	}
+	var candidates []BrandCandidate
+	const q = `SELECT id, brand_name, brand_names FROM public.drugs WHERE id::text <> $1`
+	if err := pgxscan.Select(ctx, db, &candidates, q, in.ExcludeID); err != nil {
+		return nil, err
+	}
+	return RankBrands(in, candidates), nil
+}
+
+// CheckBrand fails with 409 similar_brand, naming the brands, if a spelling
+// of drug in's brand name scores at least threshold against a registered
+// one. override, a reviewer's decision to register the name anyway, and
+// threshold 0 skip the check. Input that will fail validation is left to
+// AddDrug to report.
+func CheckBrand(ctx context.Context, db DBTX, in models.Drug, threshold float64, override bool) error {
+	check, ok := DrugBrandCheck(in, threshold, override)
+	if !ok {
+		return nil
+	}
+	matches, err := SimilarBrands(ctx, db, check)
+	if err != nil {
+		return err
+	}
+	return SimilarBrandError(matches, threshold)
+}
+
+// DrugBrandCheck is the check CheckBrand runs for drug in: every spelling
+// of its brand name. ok is false when the check is skipped.
+func DrugBrandCheck(in models.Drug, threshold float64, override bool) (check models.BrandCheck, ok bool) {
+	if threshold <= 0 || override {
+		return check, false
+	}
+	in.Normalize()
+	if in.Validate() != nil {
+		return check, false
+	}
+	for _, lang := range models.Locales {
+		if v, ok := in.BrandNames[lang]; ok {
+			check.Names = append(check.Names, v)
+		}
+	}
+	return check, true
+}
+
+// SimilarBrandError is the 409 similar_brand naming the matches scoring at
+// least threshold, or nil if there are none.
+func SimilarBrandError(matches []models.BrandMatch, threshold float64) error {
+	similar := models.TooSimilar(matches, threshold)
+	if len(similar) == 0 {
+		return nil
+	}
+	names := make([]string, len(similar))
+	for i, m := range similar {
+		names[i] = fmt.Sprintf("%s (%.2f)", m.BrandName, m.Score)
+	}
+	return shared.Conflict("similar_brand", "similar_brand", strings.Join(names, ", "))
+}
+
+// RankBrands scores candidates against the names of in, keeping each
+// drug's best-scoring spelling, and returns the top in.Limit. in must be
+// normalized and valid.
+func RankBrands(in models.BrandCheck, candidates []BrandCandidate) []models.BrandMatch {
+	limit := in.Limit
+	if limit <= 0 {
+		limit = defaultBrandMatches
+	}
+	out := make([]models.BrandMatch, 0, len(candidates))
+	for _, c := range candidates {
+		if c.DrugID == in.ExcludeID {
+			continue
+		}
+		var best models.BrandMatch
+		for _, name := range in.Names {
+			for _, existing := range brandSpellings(c) {
+				if m := CompareBrands(name, existing); m.Score > best.Score {
+					best = m
+				}
+			}
+		}
+		best.DrugID, best.BrandName = c.DrugID, c.BrandName
+		out = append(out, best)
+	}
+	slices.SortFunc(out, func(a, b models.BrandMatch) int {
+		return cmp.Or(cmp.Compare(b.Score, a.Score), cmp.Compare(a.BrandName, b.BrandName), cmp.Compare(a.DrugID, b.DrugID))
+	})
+	return out[:min(len(out), limit)]
+}
+
+// brandSpellings is every translation of c's brand name, the default first.
+func brandSpellings(c BrandCandidate) []string {
+	out := []string{c.BrandName}
+	for _, lang := range models.Locales {
+		if v, ok := c.BrandNames[lang]; ok && lang != models.DefaultLocale {
+			out = append(out, v)
+		}
+	}
+	return out
+}
+
+// CompareBrands scores how confusable two brand names are, from 0 (nothing
+// alike) to 1 (the same name once case, accents and script are ignored).
+// Arabic names are transliterated first, so "بنادول" and "Panadol" compare
+// as close spellings and identical sounds.
+func CompareBrands(a, b string) models.BrandMatch {
+	la, lb := latinBrand(a), latinBrand(b)
+	m := models.BrandMatch{Name: b, EditDistance: levenshtein(la, lb)}
+	m.EditSimilarity = similarity(m.EditDistance, la, lb)
+	sa, sb := soundSpelling(la), soundSpelling(lb)
+	pa, pb := phoneticKey(sa), phoneticKey(sb)
+	m.PhoneticSimilarity = similarity(levenshtein(pa, pb), pa, pb)
+	for m.SharedPrefix < min(len(sa), len(sb)) && sa[m.SharedPrefix] == sb[m.SharedPrefix] {
+		m.SharedPrefix++
+	}
+	m.Score = brandEditWeight*m.EditSimilarity +
+		brandPhoneticWeight*m.PhoneticSimilarity +
+		brandPrefixWeight*float64(min(m.SharedPrefix, brandPrefixLetters))/brandPrefixLetters
+	return m
+}
+
+// similarity turns an edit distance between a and b into 1 - d/len.
+func similarity(d int, a, b string) float64 {
+	n := max(len(a), len(b))
+	if n == 0 {
+		return 0
+	}
+	return 1 - float64(d)/float64(n)
+}
+
+// arabicLatin transliterates Arabic letters the way brand names are usually
+// romanized on packs. Long vowels become vowels and hamza and ain vanish,
+// since romanizations rarely agree on them.
+var arabicLatin = map[rune]string{
+	'ا': "a", 'أ': "a", 'إ': "i", 'آ': "a", 'ى': "a", 'ة': "a", 'ء': "", 'ع': "",
+	'ب': "b", 'ت': "t", 'ث': "th", 'ج': "j", 'ح': "h", 'خ': "kh", 'د': "d",
+	'ذ': "dh", 'ر': "r", 'ز': "z", 'س': "s", 'ش': "sh", 'ص': "s", 'ض': "d",
+	'ط': "t", 'ظ': "z", 'غ': "gh", 'ف': "f", 'ق': "q", 'ك': "k", 'ل': "l",
+	'م': "m", 'ن': "n", 'ه': "h", 'و': "u", 'ي': "i", 'ئ': "i", 'ؤ': "u",
+	'پ': "p", 'ڤ': "v", 'گ': "g",
+}
+
+var stripMarks = transform.Chain(norm.NFD, runes.Remove(runes.In(unicode.Mn)), norm.NFC)
+
+// latinBrand lower-cases name, drops accents and Arabic vowel marks,
+// transliterates Arabic letters and keeps only a-z and 0-9.
+func latinBrand(name string) string {
+	s, _, _ := transform.String(stripMarks, strings.ToLower(name))
+	var b strings.Builder
+	for _, r := range s {
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9':
+			b.WriteRune(r)
+		default:
+			b.WriteString(arabicLatin[r])
+		}
+	}
+	return b.String()
+}
+
+// phoneticDigraphs are rewritten before single letters are classed.
+var phoneticDigraphs = strings.NewReplacer(
+	"ph", "f", "gh", "g", "kh", "k", "sh", "s", "ch", "k", "th", "t", "dh", "d",
+	"ck", "k", "qu", "k", "x", "ks", "ou", "u", "oo", "u", "ee", "i",
+)
+
+// phoneticClass merges letters that transliterations and mishearings swap:
+// Arabic has no p or v, ج is written j or g, and vowels are unreliable.
+var phoneticClass = map[byte]byte{
+	'c': 'k', 'q': 'k', 'g': 'j', 'z': 's', 'p': 'b', 'v': 'f', 'w': 'u',
+	'e': 'i', 'y': 'i', 'o': 'u',
+}
+
+// soundSpelling rewrites a latinBrand spelling letter by letter into the
+// phonetic classes, so that "panadol" and "banadul" read the same. A
+// leading x is said z, as in Xanax.
+func soundSpelling(s string) string {
+	if strings.HasPrefix(s, "x") {
+		s = "z" + s[1:]
+	}
+	b := []byte(phoneticDigraphs.Replace(s))
+	for i, c := range b {
+		if k, ok := phoneticClass[c]; ok {
+			b[i] = k
+		}
+	}
+	return string(b)
+}
+
+// phoneticKey reduces a soundSpelling to its consonant skeleton: the first
+// sound, then the consonants after it without h, with repeats collapsed.
+// "Mohammed" and "Muhammad" both become "md".
+func phoneticKey(s string) string {
+	var b []byte
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if i > 0 && strings.IndexByte("aiuh", c) >= 0 {
+			continue
+		}
+		if len(b) > 0 && b[len(b)-1] == c {
+			continue
+		}
+		b = append(b, c)
+	}
+	return string(b)
+}
+
+// levenshtein is the edit distance between two ASCII strings.
+func levenshtein(a, b string) int {
+	prev := make([]int, len(b)+1)
+	cur := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		cur[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			cur[j] = min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+		}
+		prev, cur = cur, prev
+	}
+	return prev[len(b)]
+}
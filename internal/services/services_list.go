@@ -0,0 +1,192 @@
+package services
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"moh/models"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+)
+
+const (
+	defaultListLimit = 50
+	maxListLimit     = 500
+)
+
+// sortField is one whitelisted sort key. expr must never be NULL so that
+// (expr, id) row comparisons stay total; cast is used to read cursors back.
+// A translatable name also sets column and names, its jsonb translations,
+// so that lists with a lang are ordered by the name in that language.
+type sortField struct {
+	expr string
+	cast string
+
+	column, names string
+}
+
+// nameSort orders case-insensitively by column, or by its translation in
+// names when the list has a lang (see sortField.in).
+func nameSort(column, names string) sortField {
+	return sortField{expr: "lower(" + column + ")", cast: "text", column: column, names: names}
+}
+
+// in returns f for a list in lang: translatable names are compared by the
+// translation, falling back to the default-locale column, with the ICU
+// collation of lang. Only known locales reach the SQL text.
+func (f sortField) in(lang string) sortField {
+	if f.names == "" || !models.IsLocale(lang) {
+		return f
+	}
+	f.expr = fmt.Sprintf(`lower(COALESCE(%s ->> '%s', %s)) COLLATE "%s-x-icu"`, f.names, lang, f.column, lang)
+	return f
+}
+
+// localizer is implemented by models with translatable names.
+type localizer interface{ Localize(lang string) }
+
+// listSpec describes how to page through one table.
+type listSpec struct {
+	table       string
+	columns     string
+	sorts       map[string]sortField
+	defaultSort string
+}
+
+// filterSet accumulates WHERE conditions. Each condition takes a single
+// argument; every "?" in it is rewritten to that argument's $n placeholder.
+type filterSet struct {
+	conds []string
+	args  []any
+}
+
+func (f *filterSet) add(cond string, arg any) {
+	f.args = append(f.args, arg)
+	f.conds = append(f.conds, strings.ReplaceAll(cond, "?", fmt.Sprintf("$%d", len(f.args))))
+}
+
+func (f *filterSet) where() string {
+	if len(f.conds) == 0 {
+		return ""
+	}
+	return " WHERE " + strings.Join(f.conds, " AND ")
+}
+
+// listCursor is the opaque position handed to clients as next_cursor.
+type listCursor struct {
+	Sort string `json:"s"`
+	Key  string `json:"k"`
+	ID   string `json:"id"`
+}
+
+func encodeCursor(c listCursor) string {
+	b, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func decodeCursor(s string) (listCursor, error) {
+	var c listCursor
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return c, InvalidQuery("malformed_cursor")
+	}
+	if err := json.Unmarshal(b, &c); err != nil || c.ID == "" {
+		return c, InvalidQuery("malformed_cursor")
+	}
+	return c, nil
+}
+
+// keyedRow carries the sort key and id of each row next to the row itself
+// so the next cursor can be built without knowing T's fields.
+type keyedRow[T any] struct {
+	Item     T      `db:""`
+	SortKey  string `db:"sort_key"`
+	CursorID string `db:"cursor_id"`
+}
+
+// listPage runs a keyset-paginated query: rows are ordered by (sort expr, id)
+// and a cursor resumes strictly after the last row of the previous page.
+func listPage[T any](ctx context.Context, db DBTX, spec listSpec, f filterSet, p models.ListParams) (models.Page[T], error) {
+	limit := p.Limit
+	if limit <= 0 {
+		limit = defaultListLimit
+	}
+	if limit > maxListLimit {
+		limit = maxListLimit
+	}
+
+	sort := strings.TrimSpace(p.Sort)
+	if sort == "" {
+		sort = spec.defaultSort
+	}
+	desc := strings.HasPrefix(sort, "-")
+	field, ok := spec.sorts[strings.TrimPrefix(sort, "-")]
+	if !ok {
+		return models.Page[T]{}, InvalidQuery("unknown_sort", strings.TrimPrefix(sort, "-"))
+	}
+	field = field.in(p.Lang)
+	if p.Lang != "" {
+		// The order depends on the language, so a cursor only resumes in it.
+		sort += "@" + p.Lang
+	}
+
+	var total int64
+	countQ := `SELECT count(*) FROM ` + spec.table + f.where()
+	if err := db.QueryRow(ctx, countQ, f.args...).Scan(&total); err != nil {
+		return models.Page[T]{}, err
+	}
+
+	dir, cmp := "ASC", ">"
+	if desc {
+		dir, cmp = "DESC", "<"
+	}
+	if p.Cursor != "" {
+		cur, err := decodeCursor(p.Cursor)
+		if err != nil {
+			return models.Page[T]{}, err
+		}
+		if cur.Sort != sort {
+			return models.Page[T]{}, InvalidQuery("cursor_sort", cur.Sort)
+		}
+		f.args = append(f.args, cur.Key, cur.ID)
+		f.conds = append(f.conds, fmt.Sprintf("(%s, id) %s ($%d::%s, $%d::uuid)",
+			field.expr, cmp, len(f.args)-1, field.cast, len(f.args)))
+	}
+
+	q := fmt.Sprintf(`SELECT %s, (%s)::text AS sort_key, id::text AS cursor_id FROM %s%s ORDER BY %s %s, id %s LIMIT %d`,
+		spec.columns, field.expr, spec.table, f.where(), field.expr, dir, dir, limit+1)
+
+	var rows []keyedRow[T]
+	if err := pgxscan.Select(ctx, db, &rows, q, f.args...); err != nil {
+		return models.Page[T]{}, err
+	}
+
+	page := models.Page[T]{Items: make([]T, 0, min(len(rows), limit)), Total: total, Limit: limit}
+	if len(rows) > limit {
+		rows = rows[:limit]
+		last := rows[len(rows)-1]
+		page.NextCursor = encodeCursor(listCursor{Sort: sort, Key: last.SortKey, ID: last.CursorID})
+	}
+	for _, r := range rows {
+		if l, ok := any(&r.Item).(localizer); ok && p.Lang != "" {
+			l.Localize(p.Lang)
+		}
+		page.Items = append(page.Items, r.Item)
+	}
+	return page, nil
+}
+
+// namesMatch is a filter condition matching any translation in the jsonb
+// column names against an ILIKE pattern.
+func namesMatch(names string) string {
+	return "EXISTS (SELECT 1 FROM jsonb_each_text(" + names + ") AS t(lang, value) WHERE t.value ILIKE ?)"
+}
+
+// likePattern escapes s for use inside an ILIKE '%...%' match.
+func likePattern(s string) string {
+	r := strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+	return "%" + r.Replace(strings.TrimSpace(s)) + "%"
+}
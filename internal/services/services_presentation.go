@@ -0,0 +1,170 @@
+package services
+
+import (
+	"context"
+	"strings"
+
+	"moh/models"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/google/uuid"
+)
+
+const presentationColumns = `id, drug_id, gtin, pack_size, COALESCE(pack_unit_id::text, '') AS pack_unit_id, container_type,
+	COALESCE(description, '') AS description, COALESCE(local_code, '') AS local_code, created_at, updated_at`
+
+// AddPresentation adds a pack of a drug under its GTIN.
+func AddPresentation(ctx context.Context, db DBTX, in models.Presentation) (models.Presentation, error) {
+	in.ID = uuid.NewString()
+	in.Normalize()
+
+	if err := in.Validate(); err != nil {
+		return models.Presentation{}, models.ValidationError(err)
+	}
+
+	const q = `
+		INSERT INTO public.presentations (id, drug_id, gtin, pack_size, pack_unit_id, container_type, description, local_code)
+		VALUES ($1, $2, $3, $4, NULLIF($5, '')::uuid, $6, NULLIF($7, ''), NULLIF($8, ''))
+		RETURNING ` + presentationColumns
+	var out models.Presentation
+	if err := pgxscan.Get(ctx, db, &out, q,
+		in.ID, in.DrugID, in.GTIN, in.PackSize, in.PackUnitID, in.ContainerType, in.Description, in.LocalCode,
+	); err != nil {
+		return models.Presentation{}, dbError(err, "presentation_exists")
+	}
+	return out, nil
+}
+
+// GetPresentation returns one presentation by id.
+func GetPresentation(ctx context.Context, db DBTX, id string) (models.Presentation, error) {
+	const q = `SELECT ` + presentationColumns + ` FROM public.presentations WHERE id = $1`
+	var out models.Presentation
+	if err := pgxscan.Get(ctx, db, &out, q, id); err != nil {
+		if pgxscan.NotFound(err) {
+			return models.Presentation{}, ErrNotFound
+		}
+		return models.Presentation{}, err
+	}
+	return out, nil
+}
+
+// UpdatePresentation replaces a presentation. Moving it to another drug
+// fails while batches of the old drug are packed in it.
+func UpdatePresentation(ctx context.Context, db DBTX, id string, in models.Presentation) (models.Presentation, error) {
+	in.ID = id
+	in.Normalize()
+
+	if err := in.Validate(); err != nil {
+		return models.Presentation{}, models.ValidationError(err)
+	}
+
+	const q = `
+		UPDATE public.presentations
+		SET drug_id = $2, gtin = $3, pack_size = $4, pack_unit_id = NULLIF($5, '')::uuid, container_type = $6,
+		    description = NULLIF($7, ''), local_code = NULLIF($8, ''), updated_at = now()
+		WHERE id = $1
+		RETURNING ` + presentationColumns
+	var out models.Presentation
+	if err := pgxscan.Get(ctx, db, &out, q,
+		in.ID, in.DrugID, in.GTIN, in.PackSize, in.PackUnitID, in.ContainerType, in.Description, in.LocalCode,
+	); err != nil {
+		if pgxscan.NotFound(err) {
+			return models.Presentation{}, ErrNotFound
+		}
+		if isConstraint(err, "batches_presentation_id_fkey") {
+			return models.Presentation{}, InUse("batches")
+		}
+		if isConstraint(err, "drug_prices_presentation_id_fkey") {
+			return models.Presentation{}, InUse("drug_prices")
+		}
+		return models.Presentation{}, dbError(err, "presentation_exists")
+	}
+	return out, nil
+}
+
+// DeletePresentation removes a presentation no batch is packed in.
+func DeletePresentation(ctx context.Context, db DBTX, id string) error {
+	return deleteByID(ctx, db, "public.presentations", id)
+}
+
+// ListPresentations pages through presentations, oldest first by default.
+func ListPresentations(ctx context.Context, db DBTX, f models.PresentationFilter, p models.ListParams) (models.Page[models.Presentation], error) {
+	spec := listSpec{
+		table:   "public.presentations",
+		columns: presentationColumns,
+		sorts: map[string]sortField{
+			"gtin":       {expr: "gtin", cast: "text"},
+			"pack_size":  {expr: "pack_size", cast: "numeric"},
+			"created_at": {expr: "created_at", cast: "timestamptz"},
+			"updated_at": {expr: "updated_at", cast: "timestamptz"},
+		},
+		defaultSort: "created_at",
+	}
+	var fs filterSet
+	if f.DrugID != "" {
+		fs.add("drug_id = ?", f.DrugID)
+	}
+	if f.GTIN != "" {
+		fs.add("gtin = ?", models.NormalizeGTIN(f.GTIN))
+	}
+	if f.LocalCode != "" {
+		fs.add("local_code = ?", strings.ToUpper(strings.TrimSpace(f.LocalCode)))
+	}
+	if f.ContainerType != "" {
+		fs.add("container_type = ?", f.ContainerType)
+	}
+	return listPage[models.Presentation](ctx, db, spec, fs, p)
+}
+
+// LookupGTIN finds the presentation a scanned GTIN-8, -12, -13 or -14
+// belongs to, with its drug and the registration in force today: the
+// primary one if it is, else the one valid longest. Registration is nil
+// when the drug has none in force.
+func LookupGTIN(ctx context.Context, db DBTX, gtin string) (models.GTINLookup, error) {
+	gtin = models.NormalizeGTIN(gtin)
+	if !models.ValidGTIN(gtin) {
+		return models.GTINLookup{}, InvalidQuery("invalid_gtin", gtin)
+	}
+
+	p, err := presentationByGTIN(ctx, db, gtin)
+	if err != nil {
+		return models.GTINLookup{}, err
+	}
+	out := models.GTINLookup{GTIN: gtin, Presentation: p}
+	drug, err := GetDrug(ctx, db, p.DrugID)
+	if err != nil {
+		return models.GTINLookup{}, err
+	}
+	out.Drug = drug
+
+	const q = `
+		SELECT id, drug_id, ma_id, COALESCE(registration_number, '') AS registration_number, status, valid_from, valid_to,
+		       is_primary, created_at, updated_at
+		FROM public.drug_registrations
+		WHERE drug_id = $1 AND status = 'active' AND current_date BETWEEN valid_from AND valid_to
+		ORDER BY is_primary DESC, valid_to DESC, id
+		LIMIT 1
+	`
+	var reg models.DrugRegistration
+	if err := pgxscan.Get(ctx, db, &reg, q, drug.ID); err != nil {
+		if !pgxscan.NotFound(err) {
+			return models.GTINLookup{}, err
+		}
+	} else {
+		out.Registration = &reg
+	}
+	return out, nil
+}
+
+// presentationByGTIN returns the presentation of a GTIN-14.
+func presentationByGTIN(ctx context.Context, db DBTX, gtin string) (models.Presentation, error) {
+	const q = `SELECT ` + presentationColumns + ` FROM public.presentations WHERE gtin = $1`
+	var out models.Presentation
+	if err := pgxscan.Get(ctx, db, &out, q, gtin); err != nil {
+		if pgxscan.NotFound(err) {
+			return models.Presentation{}, ErrNotFound
+		}
+		return models.Presentation{}, err
+	}
+	return out, nil
+}
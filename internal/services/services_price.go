@@ -0,0 +1,170 @@
+package services
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"moh/models"
+	"moh/shared"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/google/uuid"
+)
+
+const drugPriceColumns = `id, drug_id, COALESCE(presentation_id::text, '') AS presentation_id, currency, max_price, effective_from,
+	COALESCE(reference, '') AS reference, created_at, updated_at`
+
+// AddDrugPrice sets a ceiling price from its effective date on.
+func AddDrugPrice(ctx context.Context, db DBTX, in models.DrugPrice) (models.DrugPrice, error) {
+	in.ID = uuid.NewString()
+	in.Normalize()
+
+	if err := in.Validate(); err != nil {
+		return models.DrugPrice{}, models.ValidationError(err)
+	}
+
+	const q = `
+		INSERT INTO public.drug_prices (id, drug_id, presentation_id, currency, max_price, effective_from, reference)
+		VALUES ($1, $2, NULLIF($3, '')::uuid, $4, $5, $6, NULLIF($7, ''))
+		RETURNING ` + drugPriceColumns
+	var out models.DrugPrice
+	if err := pgxscan.Get(ctx, db, &out, q, in.ID, in.DrugID, in.PresentationID, in.Currency, in.MaxPrice, in.EffectiveFrom, in.Reference); err != nil {
+		return models.DrugPrice{}, dbError(err, "price_exists")
+	}
+	return out, nil
+}
+
+// GetDrugPrice returns one ceiling price by id.
+func GetDrugPrice(ctx context.Context, db DBTX, id string) (models.DrugPrice, error) {
+	const q = `SELECT ` + drugPriceColumns + ` FROM public.drug_prices WHERE id = $1`
+	var out models.DrugPrice
+	if err := pgxscan.Get(ctx, db, &out, q, id); err != nil {
+		if pgxscan.NotFound(err) {
+			return models.DrugPrice{}, ErrNotFound
+		}
+		return models.DrugPrice{}, err
+	}
+	return out, nil
+}
+
+// UpdateDrugPrice corrects a ceiling price. A new price for a later date is
+// added, not updated, so the history is kept.
+func UpdateDrugPrice(ctx context.Context, db DBTX, id string, in models.DrugPrice) (models.DrugPrice, error) {
+	in.ID = id
+	in.Normalize()
+
+	if err := in.Validate(); err != nil {
+		return models.DrugPrice{}, models.ValidationError(err)
+	}
+
+	const q = `
+		UPDATE public.drug_prices
+		SET drug_id = $2, presentation_id = NULLIF($3, '')::uuid, currency = $4, max_price = $5, effective_from = $6, reference = NULLIF($7, ''), updated_at = now()
+		WHERE id = $1
+		RETURNING ` + drugPriceColumns
+	var out models.DrugPrice
+	if err := pgxscan.Get(ctx, db, &out, q, in.ID, in.DrugID, in.PresentationID, in.Currency, in.MaxPrice, in.EffectiveFrom, in.Reference); err != nil {
+		if pgxscan.NotFound(err) {
+			return models.DrugPrice{}, ErrNotFound
+		}
+		return models.DrugPrice{}, dbError(err, "price_exists")
+	}
+	return out, nil
+}
+
+// DeleteDrugPrice removes a ceiling price entered by mistake.
+func DeleteDrugPrice(ctx context.Context, db DBTX, id string) error {
+	return deleteByID(ctx, db, "public.drug_prices", id)
+}
+
+// ListDrugPrices pages through the price history, oldest first by default.
+func ListDrugPrices(ctx context.Context, db DBTX, f models.DrugPriceFilter, p models.ListParams) (models.Page[models.DrugPrice], error) {
+	spec := listSpec{
+		table:   "public.drug_prices",
+		columns: drugPriceColumns,
+		sorts: map[string]sortField{
+			"effective_from": {expr: "effective_from", cast: "date"},
+			"max_price":      {expr: "max_price", cast: "numeric"},
+			"created_at":     {expr: "created_at", cast: "timestamptz"},
+		},
+		defaultSort: "effective_from",
+	}
+	var fs filterSet
+	if f.DrugID != "" {
+		fs.add("drug_id = ?", f.DrugID)
+	}
+	if f.PresentationID != "" {
+		fs.add("presentation_id = ?", f.PresentationID)
+	}
+	if f.Currency != "" {
+		fs.add("currency = ?", strings.ToUpper(f.Currency))
+	}
+	return listPage[models.DrugPrice](ctx, db, spec, fs, p)
+}
+
+// PriceCeiling returns the ceiling in force for batch b on its
+// manufacturing date: the latest one for its presentation if there is any,
+// else the latest drug-wide one. ok is false when b has no price or no ceiling
+// applies.
+func PriceCeiling(ctx context.Context, db DBTX, b models.Batch) (ceiling models.DrugPrice, ok bool, err error) {
+	b.Normalize()
+	if b.Price.IsZero() || b.Currency == "" {
+		return models.DrugPrice{}, false, nil
+	}
+	const q = `
+		SELECT ` + drugPriceColumns + ` FROM public.drug_prices
+		WHERE drug_id = $1 AND currency = $2 AND (presentation_id IS NULL OR presentation_id = NULLIF($3, '')::uuid)
+		  AND effective_from <= $4
+		ORDER BY presentation_id IS NOT NULL DESC, effective_from DESC
+		LIMIT 1
+	`
+	if err := pgxscan.Get(ctx, db, &ceiling, q, b.DrugID, b.Currency, b.PresentationID, b.MfgDate); err != nil {
+		if pgxscan.NotFound(err) {
+			return models.DrugPrice{}, false, nil
+		}
+		return models.DrugPrice{}, false, err
+	}
+	return ceiling, true, nil
+}
+
+// PriceAboveCeiling is the 409 refusing batch b, priced above ceiling.
+func PriceAboveCeiling(b models.Batch, ceiling models.DrugPrice) error {
+	return shared.Conflict("price_above_ceiling", "price_above_ceiling",
+		b.Price.String()+" "+b.Currency, ceiling.MaxPrice.String()+" "+ceiling.Currency, ceiling.EffectiveFrom.Format(time.DateOnly))
+}
+
+// checkPriceCeiling refuses b with PriceAboveCeiling if it costs more than
+// the ceiling in force on its mfg_date, unless policy is PriceCeilingFlag.
+func checkPriceCeiling(ctx context.Context, db DBTX, b models.Batch, policy models.PriceCeilingPolicy) error {
+	if policy == models.PriceCeilingFlag {
+		return nil
+	}
+	ceiling, ok, err := PriceCeiling(ctx, db, b)
+	if err != nil || !ok || b.Price.Cmp(ceiling.MaxPrice) <= 0 {
+		return err
+	}
+	return PriceAboveCeiling(b, ceiling)
+}
+
+// ListPriceViolations pages through the batches priced above the ceiling
+// in force on their manufacturing date, most recent first by default.
+func ListPriceViolations(ctx context.Context, db DBTX, f models.PriceViolationFilter, p models.ListParams) (models.Page[models.PriceViolation], error) {
+	spec := listSpec{
+		table:   "public.batch_price_violations",
+		columns: "id, drug_id, batch_number, presentation_id, mfg_date, price, currency, price_id, max_price, effective_from",
+		sorts: map[string]sortField{
+			"mfg_date":     {expr: "mfg_date", cast: "date"},
+			"batch_number": {expr: "batch_number", cast: "text"},
+		},
+		defaultSort: "-mfg_date",
+	}
+	var fs filterSet
+	if f.DrugID != "" {
+		fs.add("drug_id = ?", f.DrugID)
+	}
+	if f.Currency != "" {
+		fs.add("currency = ?", strings.ToUpper(f.Currency))
+	}
+	return listPage[models.PriceViolation](ctx, db, spec, fs, p)
+}
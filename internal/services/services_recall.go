@@ -0,0 +1,223 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	"moh/models"
+	"moh/shared"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+const recallColumns = `id, recall_number, COALESCE(class, '') AS class, COALESCE(level, '') AS level, reason_codes,
+	COALESCE(reason, '') AS reason, COALESCE(initiated_by, '') AS initiated_by, COALESCE(initiator_name, '') AS initiator_name,
+	started_on, closed_on, status, COALESCE(close_note, '') AS close_note,
+	ARRAY(SELECT rb.batch_id::text FROM public.recall_batches rb WHERE rb.recall_id = recalls.id ORDER BY rb.added_at, rb.batch_id) AS batch_ids,
+	legacy_details, created_by, created_at, updated_at`
+
+// OpenRecall creates a recall and moves every affected batch to recalled,
+// all in one transaction: if any batch cannot be recalled nothing is saved.
+func OpenRecall(ctx context.Context, db DBTX, in models.Recall, actor string) (models.Recall, error) {
+	in.ID = uuid.NewString()
+	in.Normalize()
+
+	if err := in.Validate(); err != nil {
+		return models.Recall{}, models.ValidationError(err)
+	}
+	var startedOn *time.Time
+	if !in.StartedOn.IsZero() {
+		startedOn = &in.StartedOn
+	}
+
+	var out models.Recall
+	err := pgx.BeginFunc(ctx, db, func(tx pgx.Tx) error {
+		const q = `
+			INSERT INTO public.recalls
+				(id, recall_number, class, level, reason_codes, reason, initiated_by, initiator_name, started_on, created_by)
+			VALUES ($1, 'RC-' || to_char(current_date, 'YYYY') || '-' || lpad(nextval('public.recall_number_seq')::text, 6, '0'),
+				$2, $3, $4, NULLIF($5, ''), $6, NULLIF($7, ''), COALESCE($8, current_date), $9)
+			RETURNING recall_number
+		`
+		var number string
+		if err := tx.QueryRow(ctx, q,
+			in.ID, in.Class, in.Level, in.ReasonCodes, in.Reason, in.InitiatedBy, in.InitiatorName, startedOn, actor,
+		).Scan(&number); err != nil {
+			return err
+		}
+		if err := addRecallBatchesTx(ctx, tx, in.ID, number, in.BatchIDs, in.Reason, actor); err != nil {
+			return err
+		}
+		var err error
+		out, err = GetRecall(ctx, tx, in.ID)
+		return err
+	})
+	return out, err
+}
+
+// ExtendRecall brings more batches under an open recall and recalls them.
+func ExtendRecall(ctx context.Context, db DBTX, id string, in models.RecallExtension, actor string) (models.Recall, error) {
+	in.Normalize()
+	if err := in.Validate(); err != nil {
+		return models.Recall{}, models.ValidationError(err)
+	}
+
+	var out models.Recall
+	err := pgx.BeginFunc(ctx, db, func(tx pgx.Tx) error {
+		number, err := lockOpenRecall(ctx, tx, id)
+		if err != nil {
+			return err
+		}
+		if err := addRecallBatchesTx(ctx, tx, id, number, in.BatchIDs, in.Reason, actor); err != nil {
+			return err
+		}
+		if _, err := tx.Exec(ctx, `UPDATE public.recalls SET updated_at = now() WHERE id = $1`, id); err != nil {
+			return err
+		}
+		out, err = GetRecall(ctx, tx, id)
+		return err
+	})
+	return out, err
+}
+
+// CloseRecall ends an open recall. Its batches stay recalled.
+func CloseRecall(ctx context.Context, db DBTX, id string, in models.RecallClosure) (models.Recall, error) {
+	in.Normalize()
+	if err := in.Validate(); err != nil {
+		return models.Recall{}, models.ValidationError(err)
+	}
+	var closedOn *time.Time
+	if !in.ClosedOn.IsZero() {
+		closedOn = &in.ClosedOn
+	}
+
+	var out models.Recall
+	err := pgx.BeginFunc(ctx, db, func(tx pgx.Tx) error {
+		if _, err := lockOpenRecall(ctx, tx, id); err != nil {
+			return err
+		}
+		const q = `
+			UPDATE public.recalls
+			SET status = 'closed', closed_on = COALESCE($2, current_date), close_note = $3, updated_at = now()
+			WHERE id = $1
+		`
+		if _, err := tx.Exec(ctx, q, id, closedOn, in.Note); err != nil {
+			var pgErr *pgconn.PgError
+			if errors.As(err, &pgErr) && pgErr.ConstraintName == "recalls_dates_check" {
+				return shared.Validation(shared.NewFieldError("closed_on", "gtefield", "started_on", ""))
+			}
+			return err
+		}
+		var err error
+		out, err = GetRecall(ctx, tx, id)
+		return err
+	})
+	return out, err
+}
+
+// lockOpenRecall locks a recall row and returns its number, failing unless
+// the recall is open.
+func lockOpenRecall(ctx context.Context, tx pgx.Tx, id string) (string, error) {
+	var number string
+	var status models.RecallStatus
+	err := tx.QueryRow(ctx, `SELECT recall_number, status FROM public.recalls WHERE id = $1 FOR UPDATE`, id).Scan(&number, &status)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return "", ErrNotFound
+		}
+		return "", err
+	}
+	if status != models.RecallOpen {
+		return "", IllegalTransition("recall_not_open", number, string(status))
+	}
+	return number, nil
+}
+
+// addRecallBatchesTx links batches to a recall and moves each one to recalled.
+// Batches already recalled (by another recall) are linked without a new
+// transition. Batches are locked in id order so concurrent recalls sharing
+// batches cannot deadlock.
+func addRecallBatchesTx(ctx context.Context, tx pgx.Tx, recallID, number string, batchIDs []string, reason, actor string) error {
+	ids := slices.Clone(batchIDs)
+	slices.Sort(ids)
+
+	note := "recall " + number
+	if reason != "" {
+		note += ": " + reason
+	}
+	if r := []rune(note); len(r) > 500 {
+		note = string(r[:500])
+	}
+
+	for _, batchID := range ids {
+		var status models.BatchStatus
+		err := tx.QueryRow(ctx, `SELECT status FROM public.batches WHERE id = $1 FOR UPDATE`, batchID).Scan(&status)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return shared.ForeignKey("batch_ids", "batch_missing", batchID)
+			}
+			return err
+		}
+		if status != models.BatchRecalled {
+			if _, err := transitionBatchTx(ctx, tx, batchID, models.BatchRecalled, note, actor); err != nil {
+				return shared.Within("batch "+batchID, err)
+			}
+		}
+		const q = `
+			INSERT INTO public.recall_batches (recall_id, batch_id, added_by)
+			VALUES ($1, $2, $3)
+			ON CONFLICT (recall_id, batch_id) DO NOTHING
+		`
+		if _, err := tx.Exec(ctx, q, recallID, batchID, actor); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetRecall returns one recall with its batch ids.
+func GetRecall(ctx context.Context, db DBTX, id string) (models.Recall, error) {
+	q := `SELECT ` + recallColumns + ` FROM public.recalls WHERE id = $1`
+	var out models.Recall
+	if err := pgxscan.Get(ctx, db, &out, q, id); err != nil {
+		if pgxscan.NotFound(err) {
+			return models.Recall{}, ErrNotFound
+		}
+		return models.Recall{}, err
+	}
+	return out, nil
+}
+
+func ListRecalls(ctx context.Context, db DBTX, f models.RecallFilter, p models.ListParams) (models.Page[models.Recall], error) {
+	spec := listSpec{
+		table:   "public.recalls",
+		columns: recallColumns,
+		sorts: map[string]sortField{
+			"started_on":    {expr: "started_on", cast: "date"},
+			"recall_number": {expr: "recall_number", cast: "text"},
+			"created_at":    {expr: "created_at", cast: "timestamptz"},
+			"updated_at":    {expr: "updated_at", cast: "timestamptz"},
+		},
+		defaultSort: "-started_on",
+	}
+	var fs filterSet
+	if f.Status != "" {
+		fs.add("status = ?", f.Status)
+	}
+	if f.Class != "" {
+		fs.add("class = ?", f.Class)
+	}
+	if f.BatchID != "" {
+		fs.add("EXISTS (SELECT 1 FROM public.recall_batches rb WHERE rb.recall_id = recalls.id AND rb.batch_id = ?)", f.BatchID)
+	}
+	if f.DrugID != "" {
+		fs.add(`EXISTS (SELECT 1 FROM public.recall_batches rb JOIN public.batches b ON b.id = rb.batch_id
+		        WHERE rb.recall_id = recalls.id AND b.drug_id = ?)`, f.DrugID)
+	}
+	return listPage[models.Recall](ctx, db, spec, fs, p)
+}
@@ -3,93 +3,203 @@ package services
 
 import (
 	"context"
-	"errors"
-	"strings"
 
 	"github.com/georgysavva/scany/v2/pgxscan"
 	"github.com/google/uuid"
-	"github.com/jackc/pgx/v5/pgconn"
-	"github.com/jackc/pgx/v5/pgxpool"
 	"moh/models"
 )
 
 // AddAuthHolder creates an authorization holder.
-func AddAuthHolder(ctx context.Context, db *pgxpool.Pool, in models.AuthHolder) (models.AuthHolder, error) {
+func AddAuthHolder(ctx context.Context, db DBTX, in models.AuthHolder) (models.AuthHolder, error) {
 	in.ID = uuid.NewString()
-	in.Name = strings.TrimSpace(in.Name)
-	in.RegistrationNumber = strings.TrimSpace(in.RegistrationNumber)
+	in.Normalize()
 
 	if err := in.Validate(); err != nil {
-		msg, _ := models.FirstError(err)
-		return models.AuthHolder{}, errors.New(msg)
+		return models.AuthHolder{}, models.ValidationError(err)
 	}
 
 	const q = `
-		INSERT INTO auth_holder (id, name, registration_number)
+		INSERT INTO public.auth_holders (id, name, registration_number)
 		VALUES ($1, $2, NULLIF($3, ''))
-		RETURNING id, name, registration_number, created_at, updated_at
+		RETURNING id, name, COALESCE(registration_number, '') AS registration_number, created_at, updated_at
 	`
 	var out models.AuthHolder
 	if err := pgxscan.Get(ctx, db, &out, q, in.ID, in.Name, in.RegistrationNumber); err != nil {
-		var pgErr *pgconn.PgError
-		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
-			return models.AuthHolder{}, errors.New("auth holder already exists")
-		}
-		return models.AuthHolder{}, err
+		return models.AuthHolder{}, dbError(err, "auth_holder_exists")
 	}
 	return out, nil
 }
 
 // AddMarketingAuthorization creates a marketing authorization (MA).
-func AddMarketingAuthorization(ctx context.Context, db *pgxpool.Pool, in models.MarketingAuthorization) (models.MarketingAuthorization, error) {
+func AddMarketingAuthorization(ctx context.Context, db DBTX, in models.MarketingAuthorization) (models.MarketingAuthorization, error) {
 	in.ID = uuid.NewString()
-	in.Name = strings.TrimSpace(in.Name)
-	in.Country = strings.ToUpper(strings.TrimSpace(in.Country))
+	in.Normalize()
 
 	if err := in.Validate(); err != nil {
-		msg, _ := models.FirstError(err)
-		return models.MarketingAuthorization{}, errors.New(msg)
+		return models.MarketingAuthorization{}, models.ValidationError(err)
 	}
 
 	const q = `
-		INSERT INTO marketing_authorization (id, name, country)
+		INSERT INTO public.marketing_authorizations (id, name, country)
 		VALUES ($1, $2, $3)
 		RETURNING id, name, country, created_at, updated_at
 	`
 	var out models.MarketingAuthorization
 	if err := pgxscan.Get(ctx, db, &out, q, in.ID, in.Name, in.Country); err != nil {
-		var pgErr *pgconn.PgError
-		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
-			return models.MarketingAuthorization{}, errors.New("marketing authorization already exists")
-		}
-		return models.MarketingAuthorization{}, err
+		return models.MarketingAuthorization{}, dbError(err, "marketing_authorization_exists")
 	}
 	return out, nil
 }
 
 // AddManufacturingSite creates a manufacturing site.
-func AddManufacturingSite(ctx context.Context, db *pgxpool.Pool, in models.ManufacturingSite) (models.ManufacturingSite, error) {
+func AddManufacturingSite(ctx context.Context, db DBTX, in models.ManufacturingSite) (models.ManufacturingSite, error) {
 	in.ID = uuid.NewString()
-	in.Name = strings.TrimSpace(in.Name)
-	in.Country = strings.ToUpper(strings.TrimSpace(in.Country))
+	in.Normalize()
 
 	if err := in.Validate(); err != nil {
-		msg, _ := models.FirstError(err)
-		return models.ManufacturingSite{}, errors.New(msg)
+		return models.ManufacturingSite{}, models.ValidationError(err)
 	}
 
 	const q = `
-		INSERT INTO manufacturing_site (id, name, country)
-		VALUES ($1, $2, $3)
-		RETURNING id, name, country, created_at, updated_at
+		INSERT INTO public.manufacturing_sites (id, name, names, country)
+		VALUES ($1, $2, $3, $4)
+		RETURNING id, name, names, country, created_at, updated_at
 	`
 	var out models.ManufacturingSite
+	if err := pgxscan.Get(ctx, db, &out, q, in.ID, in.Name, in.Names, in.Country); err != nil {
+		return models.ManufacturingSite{}, dbError(err, "manufacturing_site_exists")
+	}
+	return out, nil
+}
+
+// GetAuthHolder returns one authorization holder by id.
+func GetAuthHolder(ctx context.Context, db DBTX, id string) (models.AuthHolder, error) {
+	const q = `SELECT id, name, COALESCE(registration_number, '') AS registration_number, created_at, updated_at
+	           FROM public.auth_holders WHERE id = $1`
+	var out models.AuthHolder
+	if err := pgxscan.Get(ctx, db, &out, q, id); err != nil {
+		if pgxscan.NotFound(err) {
+			return models.AuthHolder{}, ErrNotFound
+		}
+		return models.AuthHolder{}, err
+	}
+	return out, nil
+}
+
+// UpdateAuthHolder replaces an authorization holder's name and registration number.
+func UpdateAuthHolder(ctx context.Context, db DBTX, id string, in models.AuthHolder) (models.AuthHolder, error) {
+	in.ID = id
+	in.Normalize()
+
+	if err := in.Validate(); err != nil {
+		return models.AuthHolder{}, models.ValidationError(err)
+	}
+
+	const q = `
+		UPDATE public.auth_holders
+		SET name = $2, registration_number = NULLIF($3, ''), updated_at = now()
+		WHERE id = $1
+		RETURNING id, name, COALESCE(registration_number, '') AS registration_number, created_at, updated_at
+	`
+	var out models.AuthHolder
+	if err := pgxscan.Get(ctx, db, &out, q, in.ID, in.Name, in.RegistrationNumber); err != nil {
+		if pgxscan.NotFound(err) {
+			return models.AuthHolder{}, ErrNotFound
+		}
+		return models.AuthHolder{}, dbError(err, "auth_holder_exists")
+	}
+	return out, nil
+}
+
+// DeleteAuthHolder removes an authorization holder not linked to any registration.
+func DeleteAuthHolder(ctx context.Context, db DBTX, id string) error {
+	return deleteByID(ctx, db, "public.auth_holders", id)
+}
+
+// GetMarketingAuthorization returns one marketing authorization by id.
+func GetMarketingAuthorization(ctx context.Context, db DBTX, id string) (models.MarketingAuthorization, error) {
+	const q = `SELECT id, name, country, created_at, updated_at
+	           FROM public.marketing_authorizations WHERE id = $1`
+	var out models.MarketingAuthorization
+	if err := pgxscan.Get(ctx, db, &out, q, id); err != nil {
+		if pgxscan.NotFound(err) {
+			return models.MarketingAuthorization{}, ErrNotFound
+		}
+		return models.MarketingAuthorization{}, err
+	}
+	return out, nil
+}
+
+// UpdateMarketingAuthorization replaces an MA's name and country.
+func UpdateMarketingAuthorization(ctx context.Context, db DBTX, id string, in models.MarketingAuthorization) (models.MarketingAuthorization, error) {
+	in.ID = id
+	in.Normalize()
+
+	if err := in.Validate(); err != nil {
+		return models.MarketingAuthorization{}, models.ValidationError(err)
+	}
+
+	const q = `
+		UPDATE public.marketing_authorizations
+		SET name = $2, country = $3, updated_at = now()
+		WHERE id = $1
+		RETURNING id, name, country, created_at, updated_at
+	`
+	var out models.MarketingAuthorization
 	if err := pgxscan.Get(ctx, db, &out, q, in.ID, in.Name, in.Country); err != nil {
-		var pgErr *pgconn.PgError
-		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
-			return models.ManufacturingSite{}, errors.New("manufacturing site already exists")
+		if pgxscan.NotFound(err) {
+			return models.MarketingAuthorization{}, ErrNotFound
+		}
+		return models.MarketingAuthorization{}, dbError(err, "marketing_authorization_exists")
+	}
+	return out, nil
+}
+
+// DeleteMarketingAuthorization removes an MA that no registration references.
+func DeleteMarketingAuthorization(ctx context.Context, db DBTX, id string) error {
+	return deleteByID(ctx, db, "public.marketing_authorizations", id)
+}
+
+// GetManufacturingSite returns one manufacturing site by id.
+func GetManufacturingSite(ctx context.Context, db DBTX, id string) (models.ManufacturingSite, error) {
+	const q = `SELECT id, name, names, country, created_at, updated_at
+	           FROM public.manufacturing_sites WHERE id = $1`
+	var out models.ManufacturingSite
+	if err := pgxscan.Get(ctx, db, &out, q, id); err != nil {
+		if pgxscan.NotFound(err) {
+			return models.ManufacturingSite{}, ErrNotFound
 		}
 		return models.ManufacturingSite{}, err
 	}
 	return out, nil
 }
+
+// UpdateManufacturingSite replaces a site's name and country.
+func UpdateManufacturingSite(ctx context.Context, db DBTX, id string, in models.ManufacturingSite) (models.ManufacturingSite, error) {
+	in.ID = id
+	in.Normalize()
+
+	if err := in.Validate(); err != nil {
+		return models.ManufacturingSite{}, models.ValidationError(err)
+	}
+
+	const q = `
+		UPDATE public.manufacturing_sites
+		SET name = $2, names = $3, country = $4, updated_at = now()
+		WHERE id = $1
+		RETURNING id, name, names, country, created_at, updated_at
+	`
+	var out models.ManufacturingSite
+	if err := pgxscan.Get(ctx, db, &out, q, in.ID, in.Name, in.Names, in.Country); err != nil {
+		if pgxscan.NotFound(err) {
+			return models.ManufacturingSite{}, ErrNotFound
+		}
+		return models.ManufacturingSite{}, dbError(err, "manufacturing_site_exists")
+	}
+	return out, nil
+}
+
+// DeleteManufacturingSite removes a site not linked to any registration.
+func DeleteManufacturingSite(ctx context.Context, db DBTX, id string) error {
+	return deleteByID(ctx, db, "public.manufacturing_sites", id)
+}
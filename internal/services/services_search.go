@@ -0,0 +1,222 @@
+package services
+
+import (
+	"context"
+	"html"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"moh/models"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/jackc/pgx/v5"
+)
+
+const (
+	defaultSearchLimit = 20
+
+	// SearchSimilarity is the least pg_trgm word similarity a fuzzy match
+	// needs. It is lower than the extension's default of 0.6 so that brand
+	// names spelled from memory ("amoxicilin", "panadool") still match.
+	SearchSimilarity = 0.4
+)
+
+// searchQuery finds the candidates of every entity type through the indexes
+// of migration 0007, scores each searchable value (every translation of a
+// name) and keeps the best value per entity. A value scores 1 for a
+// full-text match plus its word similarity to the query, so exact words
+// rank above near misses. $1 is the query, $2 its prefix tsquery, $3 the
+// types to search in tie-break order and $4 the limit.
+const searchQuery = `
+WITH candidates (type, id, title, field, text) AS (
+	SELECT 'drug', d.id, d.brand_name, 'brand_name', n.value
+	FROM public.drugs d CROSS JOIN LATERAL jsonb_each_text(d.brand_names) AS n(lang, value)
+	WHERE 'drug' = ANY($3::text[])
+	  AND (to_tsvector('simple', public.names_text(d.brand_names)) @@ to_tsquery('simple', $2)
+	       OR $1 <% public.names_text(d.brand_names))
+	UNION ALL
+	SELECT 'api', a.id, a.name, 'name', n.value
+	FROM public.apis a CROSS JOIN LATERAL jsonb_each_text(a.names) AS n(lang, value)
+	WHERE 'api' = ANY($3::text[])
+	  AND (to_tsvector('simple', public.names_text(a.names)) @@ to_tsquery('simple', $2)
+	       OR $1 <% public.names_text(a.names))
+	UNION ALL
+	SELECT 'auth_holder', h.id, h.name, 'name', h.name
+	FROM public.auth_holders h
+	WHERE 'auth_holder' = ANY($3::text[])
+	  AND (to_tsvector('simple', h.name) @@ to_tsquery('simple', $2) OR $1 <% h.name)
+	UNION ALL
+	SELECT 'auth_holder', h.id, h.name, 'registration_number', h.registration_number
+	FROM public.auth_holders h
+	WHERE 'auth_holder' = ANY($3::text[])
+	  AND (to_tsvector('simple', h.registration_number) @@ to_tsquery('simple', $2) OR $1 <% h.registration_number)
+	UNION ALL
+	SELECT 'registration', r.id, r.registration_number, 'registration_number', r.registration_number
+	FROM public.drug_registrations r
+	WHERE 'registration' = ANY($3::text[])
+	  AND (to_tsvector('simple', r.registration_number) @@ to_tsquery('simple', $2) OR $1 <% r.registration_number)
+), scored AS (
+	SELECT DISTINCT ON (type, id) type, id::text AS id, title, field, text AS highlight,
+		((to_tsvector('simple', text) @@ to_tsquery('simple', $2))::int + word_similarity($1, text))::float8 AS score
+	FROM candidates
+	WHERE to_tsvector('simple', text) @@ to_tsquery('simple', $2) OR $1 <% text
+	ORDER BY type, id, score DESC
+)
+SELECT type, id, title, field, highlight, score
+FROM scored
+ORDER BY score DESC, array_position($3::text[], type), title, id
+LIMIT $4`
+
+// Search finds drugs by brand name, APIs by INN, auth holders by name or
+// registration number and registrations by number, best match first.
+// Misspelt queries still find what they are close to; see SearchScore.
+func Search(ctx context.Context, db DBTX, p models.SearchParams) (models.SearchResult, error) {
+	p.Normalize()
+	if err := p.Validate(); err != nil {
+		return models.SearchResult{}, models.QueryError(err)
+	}
+	limit := p.Limit
+	if limit <= 0 {
+		limit = defaultSearchLimit
+	}
+
+	var hits []models.SearchHit
+	err := pgx.BeginFunc(ctx, db, func(tx pgx.Tx) error {
+		if _, err := tx.Exec(ctx, `SELECT set_config('pg_trgm.word_similarity_threshold', $1, true)`, strconv.FormatFloat(SearchSimilarity, 'f', -1, 64)); err != nil {
+			return err
+		}
+		return pgxscan.Select(ctx, tx, &hits, searchQuery, p.Q, prefixQuery(p.Q), searchTypes(p.Types), limit)
+	})
+	if err != nil {
+		return models.SearchResult{}, err
+	}
+	if hits == nil {
+		hits = []models.SearchHit{}
+	}
+	for i := range hits {
+		hits[i].Highlight = Highlight(hits[i].Highlight, p.Q)
+	}
+	return models.SearchResult{Query: p.Q, Hits: hits}, nil
+}
+
+// searchTypes is the requested types in models.SearchTypes order, or all
+// of them.
+func searchTypes(types []models.SearchType) []string {
+	out := make([]string, 0, len(models.SearchTypes))
+	for _, t := range models.SearchTypes {
+		if len(types) == 0 || slices.Contains(types, t) {
+			out = append(out, string(t))
+		}
+	}
+	return out
+}
+
+// prefixQuery is the to_tsquery text matching documents that contain every
+// word of q, the last one possibly still being typed: "amox tab" becomes
+// "amox:* & tab:*". Words are letters and digits only, so nothing in q can
+// reach the tsquery syntax.
+func prefixQuery(q string) string {
+	words := searchWords(q)
+	for i, w := range words {
+		words[i] = w + ":*"
+	}
+	return strings.Join(words, " & ")
+}
+
+// searchWords splits s into lower-case runs of letters and digits, the way
+// the 'simple' text search configuration does.
+func searchWords(s string) []string {
+	return strings.FieldsFunc(strings.ToLower(s), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+// SearchScore scores text against q as searchQuery does, for stores that
+// cannot run it: 1 if every word of q starts a word of text, plus the word
+// similarity of q to text. ok is false if text does not match at all.
+func SearchScore(q, text string) (score float64, ok bool) {
+	qw, tw := searchWords(q), searchWords(text)
+	fts := len(qw) > 0
+	for _, w := range qw {
+		fts = fts && slices.ContainsFunc(tw, func(t string) bool { return strings.HasPrefix(t, w) })
+	}
+	sim := WordSimilarity(q, text)
+	if !fts && sim < SearchSimilarity {
+		return 0, false
+	}
+	if fts {
+		score = 1
+	}
+	return score + sim, true
+}
+
+// WordSimilarity approximates pg_trgm's word_similarity(q, text): the
+// share of the trigrams of q found in the best run of as many consecutive
+// words of text as q has.
+func WordSimilarity(q, text string) float64 {
+	qw, tw := searchWords(q), searchWords(text)
+	want := trigrams(qw...)
+	if len(want) == 0 {
+		return 0
+	}
+	best := 0
+	for i := range tw {
+		for j := i + 1; j <= min(len(tw), i+len(qw)); j++ {
+			got := trigrams(tw[i:j]...)
+			n := 0
+			for t := range want {
+				if got[t] {
+					n++
+				}
+			}
+			best = max(best, n)
+		}
+	}
+	return float64(best) / float64(len(want))
+}
+
+// trigrams returns the pg_trgm trigrams of words, each padded with two
+// spaces in front and one behind.
+func trigrams(words ...string) map[string]bool {
+	out := map[string]bool{}
+	for _, w := range words {
+		r := []rune("  " + w + " ")
+		for i := 0; i+3 <= len(r); i++ {
+			out[string(r[i:i+3])] = true
+		}
+	}
+	return out
+}
+
+// Highlight returns text, HTML-escaped, with every word that matches a word
+// of q wrapped in <mark></mark>: words it starts with, and words similar
+// enough to count as a misspelling of it.
+func Highlight(text, q string) string {
+	qw := searchWords(q)
+	matches := func(word string) bool {
+		w := strings.ToLower(word)
+		return slices.ContainsFunc(qw, func(q string) bool {
+			return strings.HasPrefix(w, q) || WordSimilarity(q, w) >= SearchSimilarity
+		})
+	}
+
+	var b strings.Builder
+	rs := []rune(text)
+	for i := 0; i < len(rs); {
+		j := i
+		isWord := unicode.IsLetter(rs[i]) || unicode.IsDigit(rs[i])
+		for j < len(rs) && (unicode.IsLetter(rs[j]) || unicode.IsDigit(rs[j])) == isWord {
+			j++
+		}
+		part := string(rs[i:j])
+		if isWord && matches(part) {
+			b.WriteString("<mark>" + html.EscapeString(part) + "</mark>")
+		} else {
+			b.WriteString(html.EscapeString(part))
+		}
+		i = j
+	}
+	return b.String()
+}
@@ -0,0 +1,221 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"moh/models"
+	"moh/shared"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+const serialColumns = `id, batch_id, gtin, serial, status, created_at, updated_at`
+
+const serialEventColumns = `e.id, e.serial_id, s.gtin, s.serial, e.event_type, e.location, COALESCE(e.reason, '') AS reason,
+	e.actor, e.occurred_at, e.recorded_at, COALESCE(e.anomaly, '') AS anomaly`
+
+// maxReportedSerials caps the duplicates named in a refused upload.
+const maxReportedSerials = 10
+
+// CommissionSerials registers the serial numbers of packs of a batch under
+// the GTIN of the batch's presentation, each with its commission event.
+// The upload is all or nothing: if any serial is already registered under
+// that GTIN, none is, and the error names the duplicates.
+func CommissionSerials(ctx context.Context, db DBTX, batchID string, in models.SerialUpload, actor string) (models.SerialUploadResult, error) {
+	in.Normalize()
+	if err := in.Validate(); err != nil {
+		return models.SerialUploadResult{}, models.ValidationError(err)
+	}
+	var occurredAt *time.Time
+	if !in.OccurredAt.IsZero() {
+		occurredAt = &in.OccurredAt
+	}
+
+	out := models.SerialUploadResult{BatchID: batchID}
+	err := pgx.BeginFunc(ctx, db, func(tx pgx.Tx) error {
+		// FOR SHARE keeps the batch, and so its presentation, in place
+		// until the serials are in.
+		const batchQ = `
+			SELECT b.batch_number, COALESCE(p.gtin, '')
+			FROM public.batches b
+			LEFT JOIN public.presentations p ON p.id = b.presentation_id
+			WHERE b.id = $1
+			FOR SHARE OF b
+		`
+		var number string
+		if err := tx.QueryRow(ctx, batchQ, batchID).Scan(&number, &out.GTIN); err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return ErrNotFound
+			}
+			return err
+		}
+		if out.GTIN == "" {
+			return shared.Conflict("no_presentation", "batch_no_presentation", number)
+		}
+
+		const dupQ = `SELECT serial FROM public.serials WHERE gtin = $1 AND serial = ANY($2) ORDER BY serial LIMIT $3`
+		rows, err := tx.Query(ctx, dupQ, out.GTIN, in.Serials, maxReportedSerials)
+		if err != nil {
+			return err
+		}
+		dups, err := pgx.CollectRows(rows, pgx.RowTo[string])
+		if err != nil {
+			return err
+		}
+		if len(dups) > 0 {
+			return shared.Conflict("already_exists", "serials_exist", out.GTIN, strings.Join(dups, ", "))
+		}
+
+		const q = `
+			WITH s AS (
+				INSERT INTO public.serials (id, batch_id, gtin, serial)
+				SELECT gen_random_uuid(), $1, $2, serial FROM unnest($3::text[]) AS serial
+				RETURNING id
+			)
+			INSERT INTO public.serial_events (id, serial_id, event_type, location, actor, occurred_at)
+			SELECT gen_random_uuid(), id, 'commission', $4, $5, COALESCE($6, now()) FROM s
+		`
+		tag, err := tx.Exec(ctx, q, batchID, out.GTIN, in.Serials, in.Location, actor, occurredAt)
+		if err != nil {
+			// Another upload got some of the serials in first.
+			return dbError(err, "serial_exists")
+		}
+		out.Commissioned = int(tag.RowsAffected())
+		return nil
+	})
+	if err != nil {
+		return models.SerialUploadResult{}, err
+	}
+	return out, nil
+}
+
+// RecordSerialEvent adds an event to a pack's chain of custody and moves
+// the pack to the status it leads to. The serial row is locked so that
+// concurrent reports of the same pack are judged one after the other: of
+// two dispenses, the second is recorded as already_dispensed.
+func RecordSerialEvent(ctx context.Context, db DBTX, in models.SerialEvent, actor string) (models.SerialEvent, error) {
+	in.ID = uuid.NewString()
+	in.Normalize()
+	if err := in.Validate(); err != nil {
+		return models.SerialEvent{}, models.ValidationError(err)
+	}
+	in.Actor = actor
+	var occurredAt *time.Time
+	if !in.OccurredAt.IsZero() {
+		occurredAt = &in.OccurredAt
+	}
+
+	err := pgx.BeginFunc(ctx, db, func(tx pgx.Tx) error {
+		var status models.SerialStatus
+		const lockQ = `SELECT id, status FROM public.serials WHERE gtin = $1 AND serial = $2 FOR UPDATE`
+		if err := tx.QueryRow(ctx, lockQ, in.GTIN, in.Serial).Scan(&in.SerialID, &status); err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return shared.NotFound("serial_unknown", in.GTIN, in.Serial)
+			}
+			return err
+		}
+		next, anomaly := status.Apply(in.Type)
+		in.Anomaly = anomaly
+
+		const q = `
+			INSERT INTO public.serial_events (id, serial_id, event_type, location, actor, reason, anomaly, occurred_at)
+			VALUES ($1, $2, $3, $4, $5, NULLIF($6, ''), NULLIF($7, ''), COALESCE($8, now()))
+			RETURNING occurred_at, recorded_at
+		`
+		if err := tx.QueryRow(ctx, q,
+			in.ID, in.SerialID, in.Type, in.Location, in.Actor, in.Reason, in.Anomaly, occurredAt,
+		).Scan(&in.OccurredAt, &in.RecordedAt); err != nil {
+			return err
+		}
+		if next == status {
+			return nil
+		}
+		_, err := tx.Exec(ctx, `UPDATE public.serials SET status = $2, updated_at = now() WHERE id = $1`, in.SerialID, next)
+		return err
+	})
+	if err != nil {
+		return models.SerialEvent{}, err
+	}
+	return in, nil
+}
+
+// SerialCustody returns a pack and its events, in the order they occurred.
+func SerialCustody(ctx context.Context, db DBTX, key models.SerialKey) (models.SerialCustody, error) {
+	key.Normalize()
+	if err := key.Validate(); err != nil {
+		return models.SerialCustody{}, models.QueryError(err)
+	}
+
+	var out models.SerialCustody
+	const q = `SELECT ` + serialColumns + ` FROM public.serials WHERE gtin = $1 AND serial = $2`
+	if err := pgxscan.Get(ctx, db, &out.Serial, q, key.GTIN, key.Serial); err != nil {
+		if pgxscan.NotFound(err) {
+			return models.SerialCustody{}, shared.NotFound("serial_unknown", key.GTIN, key.Serial)
+		}
+		return models.SerialCustody{}, err
+	}
+	const eventsQ = `
+		SELECT ` + serialEventColumns + `
+		FROM public.serial_events e
+		JOIN public.serials s ON s.id = e.serial_id
+		WHERE e.serial_id = $1
+		ORDER BY e.occurred_at, e.recorded_at, e.id
+	`
+	out.Events = []models.SerialEvent{}
+	if err := pgxscan.Select(ctx, db, &out.Events, eventsQ, out.Serial.ID); err != nil {
+		return models.SerialCustody{}, err
+	}
+	return out, nil
+}
+
+// ListSerials pages through serialized packs, by serial number by default.
+func ListSerials(ctx context.Context, db DBTX, f models.SerialFilter, p models.ListParams) (models.Page[models.Serial], error) {
+	spec := listSpec{
+		table:   "public.serials",
+		columns: serialColumns,
+		sorts: map[string]sortField{
+			"serial":     {expr: "serial", cast: "text"},
+			"created_at": {expr: "created_at", cast: "timestamptz"},
+			"updated_at": {expr: "updated_at", cast: "timestamptz"},
+		},
+		defaultSort: "serial",
+	}
+	var fs filterSet
+	if f.BatchID != "" {
+		fs.add("batch_id = ?", f.BatchID)
+	}
+	if f.GTIN != "" {
+		fs.add("gtin = ?", models.NormalizeGTIN(f.GTIN))
+	}
+	if f.Status != "" {
+		fs.add("status = ?", f.Status)
+	}
+	return listPage[models.Serial](ctx, db, spec, fs, p)
+}
+
+// ListSerialClones pages through the serials dispensed more than once,
+// most recently dispensed first by default.
+func ListSerialClones(ctx context.Context, db DBTX, f models.SerialCloneFilter, p models.ListParams) (models.Page[models.SerialClone], error) {
+	spec := listSpec{
+		table:   "public.serial_clones",
+		columns: "id, batch_id, gtin, serial, dispenses, first_dispensed_at, last_dispensed_at",
+		sorts: map[string]sortField{
+			"last_dispensed_at": {expr: "last_dispensed_at", cast: "timestamptz"},
+			"dispenses":         {expr: "dispenses", cast: "bigint"},
+		},
+		defaultSort: "-last_dispensed_at",
+	}
+	var fs filterSet
+	if f.BatchID != "" {
+		fs.add("batch_id = ?", f.BatchID)
+	}
+	if f.GTIN != "" {
+		fs.add("gtin = ?", models.NormalizeGTIN(f.GTIN))
+	}
+	return listPage[models.SerialClone](ctx, db, spec, fs, p)
+}
@@ -0,0 +1,162 @@
+package services
+
+import (
+	"context"
+	"strconv"
+
+	"moh/models"
+	"moh/shared"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+const stockMovementColumns = `id, batch_id, movement_type, quantity, balance_after, COALESCE(reference, '') AS reference,
+	COALESCE(counterparty, '') AS counterparty, COALESCE(note, '') AS note, created_by, created_at`
+
+// History notes of the status changes the stock ledger makes.
+const (
+	SoldOutReason   = "stock balance reached zero"
+	RestockedReason = "returned stock brought the balance above zero"
+)
+
+// StockBalance is b's remaining quantity after movement m, or why m cannot
+// be made: units leave a batch only while it is released (destruction
+// excepted, as recalled stock is destroyed), never more than remain, and
+// no more can come back than the ledger has issued, the sum of its
+// movements' Issued.
+func StockBalance(b models.Batch, issued int64, m models.StockMovement) (int64, error) {
+	qty := strconv.FormatInt(m.Quantity, 10)
+	if m.Type.Inbound() {
+		if m.Quantity > issued {
+			return 0, shared.Conflict("invalid_return", "return_exceeds_issued", qty, strconv.FormatInt(issued, 10), b.BatchNumber)
+		}
+		return b.QtyRemaining + m.Quantity, nil
+	}
+	if m.Type != models.MovementDestruction && b.Status != models.BatchReleased {
+		return 0, shared.Conflict("not_released", "stock_not_released", b.BatchNumber, string(b.Status))
+	}
+	if m.Quantity > b.QtyRemaining {
+		return 0, shared.Conflict("insufficient_stock", "insufficient_stock", b.BatchNumber, strconv.FormatInt(b.QtyRemaining, 10), qty)
+	}
+	return b.QtyRemaining - m.Quantity, nil
+}
+
+// StockStatus is the status the ledger moves a batch in status s to once
+// its balance is balance: a released batch that runs out is sold out, and
+// a sold-out one that returns restock is released again. ok is false when
+// the status stays.
+func StockStatus(s models.BatchStatus, balance int64) (to models.BatchStatus, reason string, ok bool) {
+	switch {
+	case s == models.BatchReleased && balance == 0:
+		return models.BatchSoldOut, SoldOutReason, true
+	case s == models.BatchSoldOut && balance > 0:
+		return models.BatchReleased, RestockedReason, true
+	}
+	return s, "", false
+}
+
+// BatchQtyRemaining is b's remaining quantity once its qty_in_batch is
+// qty, or why qty_in_batch cannot change: once the ledger has movements
+// (moved), it alone accounts for the batch's units, and an edit cannot
+// make the change of status StockStatus leaves to the ledger.
+func BatchQtyRemaining(b models.Batch, qty int64, moved bool) (int64, error) {
+	if qty == b.QtyInBatch {
+		return b.QtyRemaining, nil
+	}
+	if moved {
+		return 0, shared.Conflict("stock_moved", "qty_fixed_by_ledger", b.BatchNumber)
+	}
+	remaining := b.QtyRemaining + qty - b.QtyInBatch
+	if remaining < 0 {
+		return 0, shared.Conflict("insufficient_stock", "qty_below_moved")
+	}
+	if to, _, ok := StockStatus(b.Status, remaining); ok {
+		return 0, shared.Conflict("stock_status", "qty_changes_status", b.BatchNumber, string(to))
+	}
+	return remaining, nil
+}
+
+// RecordStockMovement appends a movement to a batch's ledger and updates
+// its remaining quantity. The batch row is locked for the whole
+// transaction, so concurrent movements are applied one at a time against
+// the balance the previous one left. The batch's status follows its
+// balance as StockStatus says.
+func RecordStockMovement(ctx context.Context, db DBTX, batchID string, in models.StockMovement, actor string) (models.StockMovement, error) {
+	in.ID = uuid.NewString()
+	in.Normalize()
+	if err := in.Validate(); err != nil {
+		return models.StockMovement{}, models.ValidationError(err)
+	}
+	in.BatchID, in.CreatedBy = batchID, actor
+
+	err := pgx.BeginFunc(ctx, db, func(tx pgx.Tx) error {
+		var b models.Batch
+		q := `SELECT ` + batchColumns + ` FROM public.batches WHERE id = $1 FOR UPDATE`
+		if err := pgxscan.Get(ctx, tx, &b, q, batchID); err != nil {
+			if pgxscan.NotFound(err) {
+				return ErrNotFound
+			}
+			return err
+		}
+		var issued int64
+		if in.Type.Inbound() {
+			const issuedQ = `
+				SELECT COALESCE(sum(CASE movement_type WHEN 'return' THEN -quantity WHEN 'destruction' THEN 0 ELSE quantity END), 0)
+				FROM public.stock_movements WHERE batch_id = $1
+			`
+			if err := tx.QueryRow(ctx, issuedQ, batchID).Scan(&issued); err != nil {
+				return err
+			}
+		}
+		balance, err := StockBalance(b, issued, in)
+		if err != nil {
+			return err
+		}
+		in.BalanceAfter, in.BatchStatus = balance, b.Status
+
+		// clock_timestamp, not now(): the time the lock was held, so the
+		// ledger's order is the order balances were computed in.
+		const ins = `
+			INSERT INTO public.stock_movements (id, batch_id, movement_type, quantity, balance_after, reference, counterparty, note, created_by, created_at)
+			VALUES ($1, $2, $3, $4, $5, NULLIF($6, ''), NULLIF($7, ''), NULLIF($8, ''), $9, clock_timestamp())
+			RETURNING created_at
+		`
+		if err := tx.QueryRow(ctx, ins,
+			in.ID, in.BatchID, in.Type, in.Quantity, in.BalanceAfter, in.Reference, in.Counterparty, in.Note, in.CreatedBy,
+		).Scan(&in.CreatedAt); err != nil {
+			return err
+		}
+		const upd = `UPDATE public.batches SET qty_remaining = $2, updated_at = now() WHERE id = $1`
+		if _, err := tx.Exec(ctx, upd, batchID, balance); err != nil {
+			return err
+		}
+		if to, reason, ok := StockStatus(b.Status, balance); ok {
+			if _, err := transitionBatchTx(ctx, tx, batchID, to, reason, actor); err != nil {
+				return err
+			}
+			in.BatchStatus = to
+		}
+		return nil
+	})
+	if err != nil {
+		return models.StockMovement{}, err
+	}
+	return in, nil
+}
+
+// GetStockLedger returns a batch's quantities and its movements, oldest
+// first.
+func GetStockLedger(ctx context.Context, db DBTX, batchID string) (models.StockLedger, error) {
+	b, err := GetBatch(ctx, db, batchID)
+	if err != nil {
+		return models.StockLedger{}, err
+	}
+	out := models.StockLedger{BatchID: b.ID, QtyInBatch: b.QtyInBatch, QtyRemaining: b.QtyRemaining, Movements: []models.StockMovement{}}
+	const q = `SELECT ` + stockMovementColumns + ` FROM public.stock_movements WHERE batch_id = $1 ORDER BY created_at, id`
+	if err := pgxscan.Select(ctx, db, &out.Movements, q, batchID); err != nil {
+		return models.StockLedger{}, err
+	}
+	return out, nil
+}
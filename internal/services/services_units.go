@@ -0,0 +1,66 @@
+package services
+
+import (
+	"context"
+	"errors"
+
+	"moh/models"
+)
+
+// conversionPlaces is the precision of a converted strength. Conversions
+// between metric units are exact long before it.
+const conversionPlaces = 12
+
+// ConvertStrength converts in.Value from unit in.From to unit in.To.
+func ConvertStrength(ctx context.Context, db DBTX, in models.UnitConversion) (models.UnitConversion, error) {
+	if err := in.Validate(); err != nil {
+		return models.UnitConversion{}, models.QueryError(err)
+	}
+	from, err := GetStrengthUnit(ctx, db, in.From)
+	if errors.Is(err, ErrNotFound) {
+		return models.UnitConversion{}, InvalidQuery("unknown_unit", in.From)
+	}
+	if err != nil {
+		return models.UnitConversion{}, err
+	}
+	to, err := GetStrengthUnit(ctx, db, in.To)
+	if errors.Is(err, ErrNotFound) {
+		return models.UnitConversion{}, InvalidQuery("unknown_unit", in.To)
+	}
+	if err != nil {
+		return models.UnitConversion{}, err
+	}
+	return ConvertUnits(in.Value, from, to)
+}
+
+// ConvertUnits converts v from unit from to unit to: v × from.Factor ÷
+// to.Factor, rounded to conversionPlaces. Both units must have the same
+// dimension.
+func ConvertUnits(v models.Decimal, from, to models.StrengthUnit) (models.UnitConversion, error) {
+	out := models.UnitConversion{Value: v, From: from.ID, To: to.ID, FromCode: from.Code, ToCode: to.Code}
+	if from.ID == to.ID {
+		out.Result = v
+		return out, nil
+	}
+	for _, u := range []models.StrengthUnit{from, to} {
+		if u.Dimension == "" || u.Factor == nil {
+			return models.UnitConversion{}, InvalidQuery("unit_not_convertible", u.Code)
+		}
+	}
+	if from.Dimension != to.Dimension {
+		return models.UnitConversion{}, InvalidQuery("incompatible_units", from.Code, string(from.Dimension), to.Code, string(to.Dimension))
+	}
+	r := v.Mul(*from.Factor).Rat()
+	out.Result = models.DecimalFromRat(r.Quo(r, to.Factor.Rat()), conversionPlaces)
+	return out, nil
+}
+
+// BaseStrength is v in unit u expressed in the base unit of u's dimension
+// (g, mL, IU or mol), so strengths entered in different units compare
+// directly. ok is false for units without a dimension.
+func BaseStrength(v models.Decimal, u models.StrengthUnit) (base models.Decimal, ok bool) {
+	if u.Dimension == "" || u.Factor == nil {
+		return models.Decimal{}, false
+	}
+	return v.Mul(*u.Factor).Reduced(), true
+}
@@ -0,0 +1,180 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"moh/models"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/jackc/pgx/v5"
+)
+
+// PackMatch is what the registry holds for a scanned pack: the
+// presentation of its GTIN and its drug's brand, the batch of that drug
+// with its batch number and, for a recalled batch, the recall. What was
+// not found is left nil or empty.
+type PackMatch struct {
+	Presentation *models.Presentation
+	BrandName    string
+	Batch        *models.Batch
+	RecallNumber string
+}
+
+// VerifyPack decodes the GS1 payload of a pack's barcode, finds its batch
+// by GTIN and batch number, and judges it with JudgePack.
+func VerifyPack(ctx context.Context, db DBTX, in models.VerifyRequest) (models.Verification, error) {
+	scan, err := ScanPack(in)
+	if err != nil {
+		return models.Verification{}, err
+	}
+
+	var m PackMatch
+	gtin, _ := scan.Get(models.AIGTIN)
+	number, _ := scan.Get(models.AIBatch)
+	if gtin == "" {
+		return JudgePack(scan, m, time.Now()), nil
+	}
+	p, err := presentationByGTIN(ctx, db, gtin)
+	if errors.Is(err, ErrNotFound) {
+		return JudgePack(scan, m, time.Now()), nil
+	}
+	if err != nil {
+		return models.Verification{}, err
+	}
+	m.Presentation = &p
+	if err := db.QueryRow(ctx, `SELECT brand_name FROM public.drugs WHERE id = $1`, p.DrugID).Scan(&m.BrandName); err != nil {
+		return models.Verification{}, err
+	}
+	if number == "" {
+		return JudgePack(scan, m, time.Now()), nil
+	}
+
+	var b models.Batch
+	if err := pgxscan.Get(ctx, db, &b,
+		`SELECT `+batchColumns+` FROM public.batches WHERE drug_id = $1 AND batch_number = $2`, p.DrugID, number,
+	); err != nil {
+		if !pgxscan.NotFound(err) {
+			return models.Verification{}, err
+		}
+		return JudgePack(scan, m, time.Now()), nil
+	}
+	m.Batch = &b
+	if b.Status == models.BatchRecalled {
+		const q = `
+			SELECT r.recall_number FROM public.recalls r
+			JOIN public.recall_batches rb ON rb.recall_id = r.id
+			WHERE rb.batch_id = $1
+			ORDER BY r.status = 'open' DESC, r.started_on DESC
+			LIMIT 1
+		`
+		if err := db.QueryRow(ctx, q, b.ID).Scan(&m.RecallNumber); err != nil && !errors.Is(err, pgx.ErrNoRows) {
+			return models.Verification{}, err
+		}
+	}
+	return JudgePack(scan, m, time.Now()), nil
+}
+
+// ScanPack validates a verification request and decodes its payload.
+func ScanPack(in models.VerifyRequest) (models.GS1Data, error) {
+	in.Normalize()
+	if err := in.Validate(); err != nil {
+		return nil, models.ValidationError(err)
+	}
+	return models.ParseGS1(in.Payload)
+}
+
+// JudgePack gives the verdict on scanned pack scan, given what the
+// registry holds for it, on the day of now:
+//
+//   - unknown when the code lacks a GTIN, batch number or expiry, when the
+//     GTIN or batch is not registered, when the batch was packed in another
+//     presentation, or when the printed expiry is not the registered one:
+//     the registry cannot vouch for such a pack, and it may be counterfeit;
+//   - otherwise recalled, expired, or unknown for a batch not released
+//     (planned, on hold, inactive), by the batch's status and expiry;
+//   - otherwise genuine.
+//
+// Every finding is given as a reason, including the batch's status when
+// the pack is suspect.
+func JudgePack(scan models.GS1Data, m PackMatch, now time.Time) models.Verification {
+	v := models.Verification{Reasons: []models.VerifyReason{}}
+	v.GTIN, _ = scan.Get(models.AIGTIN)
+	v.BatchNumber, _ = scan.Get(models.AIBatch)
+	v.Serial, _ = scan.Get(models.AISerial)
+	printed, monthOnly, hasExpiry := scan.Expiry()
+	if hasExpiry {
+		v.Expiry = &printed
+	}
+	reason := func(code string, args ...string) {
+		v.Reasons = append(v.Reasons, models.NewVerifyReason(code, args...))
+	}
+
+	switch {
+	case v.GTIN == "":
+		reason("verify_no_gtin")
+	case m.Presentation == nil:
+		reason("verify_unknown_gtin", v.GTIN)
+	default:
+		v.BrandName, v.Pack = m.BrandName, m.Presentation.Description
+	}
+	if v.BatchNumber == "" {
+		reason("verify_no_batch")
+	} else if m.Presentation != nil && m.Batch == nil {
+		reason("verify_unknown_batch", v.BatchNumber)
+	}
+	if !hasExpiry {
+		reason("verify_no_expiry")
+	}
+
+	b := m.Batch
+	if b == nil {
+		v.Result = models.VerifyUnknown
+		return v
+	}
+	expires := b.ExpireDate
+	v.BatchID, v.ExpireDate = b.ID, &expires
+	if b.PresentationID != "" && b.PresentationID != m.Presentation.ID {
+		reason("verify_wrong_pack", b.BatchNumber)
+	}
+	if hasExpiry && !sameExpiry(printed, monthOnly, expires) {
+		shown := printed.Format(time.DateOnly)
+		if monthOnly {
+			shown = printed.Format("2006-01")
+		}
+		reason("verify_expiry_mismatch", shown, expires.Format(time.DateOnly))
+	}
+	suspect := len(v.Reasons) > 0
+
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	switch {
+	case b.Status == models.BatchRecalled:
+		v.Result, v.RecallNumber = models.VerifyRecalled, m.RecallNumber
+		reason("verify_recalled", b.BatchNumber)
+	case b.Status == models.BatchExpired || expires.Before(today):
+		v.Result = models.VerifyExpired
+		reason("verify_expired", b.BatchNumber, expires.Format(time.DateOnly))
+	case b.Status == models.BatchPlanned || b.Status == models.BatchOnHold || b.Status == models.BatchInactive:
+		v.Result = models.VerifyUnknown
+		reason("verify_not_released", b.BatchNumber, string(b.Status))
+	default:
+		v.Result = models.VerifyGenuine
+		if !suspect {
+			reason("verify_genuine", b.BatchNumber, v.BrandName)
+		}
+	}
+	if suspect {
+		v.Result = models.VerifyUnknown
+	}
+	return v
+}
+
+// sameExpiry compares a printed expiry with the registered date, by month
+// alone when the code gives no day.
+func sameExpiry(printed time.Time, monthOnly bool, registered time.Time) bool {
+	if monthOnly {
+		return printed.Year() == registered.Year() && printed.Month() == registered.Month()
+	}
+	return printed.Year() == registered.Year() && printed.YearDay() == registered.YearDay()
+}
@@ -1,14 +1,34 @@
 
 package models
 
-import "time"
+import (
+    "strings"
+    "time"
+)
 
 type API struct {
-    ID        string     `json:"id" db:"id" validate:"omitempty,uuid4"`
-    Name      string     `json:"name" db:"name" validate:"required,notblank,max=200"`
-    Status    APIStatus  `json:"status" db:"status" validate:"required,oneof=active inactive withdrawn banned"`
-    CreatedAt *time.Time `json:"created_at,omitempty" db:"created_at"`
-    UpdatedAt *time.Time `json:"updated_at,omitempty" db:"updated_at"`
+    ID        string        `json:"id" db:"id" validate:"omitempty,uuid4"`
+    Name      string        `json:"name" db:"name"` // Names[DefaultLocale], set by Normalize
+    Names     LocalizedName `json:"names" db:"names" validate:"default_locale,dive,keys,oneof=en ar fr,endkeys,notblank,max=200"`
+    Status    APIStatus     `json:"status" db:"status" validate:"required,oneof=active inactive withdrawn banned"`
+    CreatedAt *time.Time    `json:"created_at,omitempty" db:"created_at"`
+    UpdatedAt *time.Time    `json:"updated_at,omitempty" db:"updated_at"`
 }
 
 func (m *API) Validate() error { return validate.Struct(m) }
+
+// Normalize trims the input and lower-cases the status, defaulting it to active.
+func (m *API) Normalize() {
+    normalizeName(&m.Name, &m.Names)
+    if s := strings.ToLower(strings.TrimSpace(string(m.Status))); s == "" {
+        m.Status = APIStatusActive
+    } else {
+        m.Status = APIStatus(s)
+    }
+}
+
+// Localize sets Name to the name in lang, falling back to DefaultLocale.
+func (m *API) Localize(lang string) { m.Name = m.Names.Get(lang) }
+
+// PreparePatch drops Names[DefaultLocale], which Normalize refills from Name.
+func (m *API) PreparePatch() { m.Names = m.Names.withoutDefault() }
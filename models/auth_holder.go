@@ -1,7 +1,10 @@
 
 package models
 
-import "time"
+import (
+    "strings"
+    "time"
+)
 
 type AuthHolder struct {
     ID                 string     `json:"id" db:"id" validate:"omitempty,uuid4"`
@@ -12,3 +15,9 @@ type AuthHolder struct {
 }
 
 func (m *AuthHolder) Validate() error { return validate.Struct(m) }
+
+// Normalize trims the input.
+func (m *AuthHolder) Normalize() {
+    m.Name = strings.TrimSpace(m.Name)
+    m.RegistrationNumber = strings.TrimSpace(m.RegistrationNumber)
+}
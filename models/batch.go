@@ -2,23 +2,57 @@
 package models
 
 import (
-    "encoding/json"
+    "strings"
     "time"
 )
 
 type Batch struct {
-    ID                 string          `json:"id" db:"id" validate:"omitempty,uuid4"`
-    DrugID             string          `json:"drug_id" db:"drug_id" validate:"required,uuid4"`
-    DrugRegistrationID string          `json:"drug_registration_id,omitempty" db:"drug_registration_id" validate:"omitempty,uuid4"`
-    BatchNumber        string          `json:"batch_number" db:"batch_number" validate:"required,notblank,max=120"`
-    MfgDate            time.Time       `json:"mfg_date" db:"mfg_date" validate:"required"`
-    ExpireDate         time.Time       `json:"expire_date" db:"expire_date" validate:"required"`
-    QtyInBatch         int64           `json:"qty_in_batch" db:"qty_in_batch" validate:"gte=0"`
-    Status             BatchStatus     `json:"status" db:"status" validate:"required,oneof=planned released on_hold recalled expired sold_out inactive"`
-    Price              float64         `json:"price" db:"price" validate:"gte=0"`
-    RecallReason       json.RawMessage `json:"recall_reason,omitempty" db:"recall_reason" validate:"omitempty,json"`
-    CreatedAt          *time.Time      `json:"created_at,omitempty" db:"created_at"`
-    UpdatedAt          *time.Time      `json:"updated_at,omitempty" db:"updated_at"`
+    ID                 string      `json:"id" db:"id" validate:"omitempty,uuid4"`
+    DrugID             string      `json:"drug_id" db:"drug_id" validate:"required,uuid4"`
+    DrugRegistrationID string      `json:"drug_registration_id,omitempty" db:"drug_registration_id" validate:"omitempty,uuid4"`
+    PresentationID     string      `json:"presentation_id,omitempty" db:"presentation_id" validate:"omitempty,uuid4"` // one of the drug's presentations
+    BatchNumber        string      `json:"batch_number" db:"batch_number" validate:"required,notblank,max=120"`
+    MfgDate            time.Time   `json:"mfg_date" db:"mfg_date" validate:"required"`
+    ExpireDate         time.Time   `json:"expire_date" db:"expire_date" validate:"required"`
+    QtyInBatch         int64       `json:"qty_in_batch" db:"qty_in_batch" validate:"gte=0"`
+    QtyRemaining       int64       `json:"qty_remaining" db:"qty_remaining"` // read-only: qty_in_batch less the stock ledger's net outflow
+    Status             BatchStatus `json:"status" db:"status" validate:"required,oneof=planned released on_hold recalled expired sold_out inactive"`
+    Price              Decimal     `json:"price" db:"price" validate:"gte=0"`
+    Currency           string      `json:"currency,omitempty" db:"currency" validate:"omitempty,len=3,alpha,uppercase"` // ISO 4217, required with a price
+    CreatedAt          *time.Time  `json:"created_at,omitempty" db:"created_at"`
+    UpdatedAt          *time.Time  `json:"updated_at,omitempty" db:"updated_at"`
 }
 
 func (m *Batch) Validate() error { return validate.Struct(m) }
+
+// Normalize trims the input and upper-cases the currency.
+func (m *Batch) Normalize() {
+    m.Currency = strings.ToUpper(strings.TrimSpace(m.Currency))
+}
+
+// MaxPriceScale is the most decimal places a price may have, as in the
+// three-decimal dinars.
+const MaxPriceScale = 3
+
+// BatchTransition is the body of POST /batch/:id/{release,hold,...}.
+// Reason is required for hold and deactivate. Batches are recalled through
+// a Recall, never directly.
+type BatchTransition struct {
+    Reason string `json:"reason,omitempty" validate:"omitempty,max=500"`
+}
+
+func (m *BatchTransition) Validate() error { return validate.Struct(m) }
+
+// Normalize trims the input.
+func (m *BatchTransition) Normalize() { m.Reason = strings.TrimSpace(m.Reason) }
+
+// BatchStatusChange is one row of a batch's status history.
+type BatchStatusChange struct {
+    ID         string      `json:"id" db:"id"`
+    BatchID    string      `json:"batch_id" db:"batch_id"`
+    FromStatus BatchStatus `json:"from_status" db:"from_status"`
+    ToStatus   BatchStatus `json:"to_status" db:"to_status"`
+    Reason     string      `json:"reason,omitempty" db:"reason"`
+    ChangedBy  string      `json:"changed_by" db:"changed_by"`
+    ChangedAt  time.Time   `json:"changed_at" db:"changed_at"`
+}
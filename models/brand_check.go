@@ -0,0 +1,59 @@
+package models
+
+import "strings"
+
+// BrandCheck asks which existing brands a candidate brand name could be
+// confused with. Names are the candidate's spellings (e.g. its English and
+// Arabic brand names); ExcludeID leaves out a drug being renamed.
+type BrandCheck struct {
+    Names     []string `form:"name" json:"names" validate:"required,min=1,max=3,dive,notblank,max=200"`
+    ExcludeID string   `form:"exclude_id" json:"exclude_id,omitempty" validate:"omitempty,uuid4"`
+    Limit     int      `form:"limit" json:"limit,omitempty" validate:"omitempty,min=1,max=50"`
+}
+
+func (m *BrandCheck) Validate() error { return validate.Struct(m) }
+
+// Normalize trims the names.
+func (m *BrandCheck) Normalize() {
+    for i, n := range m.Names {
+        m.Names[i] = strings.TrimSpace(n)
+    }
+}
+
+// BrandMatch is an existing brand scored against a candidate, with the
+// measures the score combines: the similarity of the spellings (1 minus the
+// normalized edit distance), of their phonetic keys, and the number of
+// leading letters they share. Name is the spelling that scored best.
+type BrandMatch struct {
+    DrugID             string  `json:"drug_id" db:"id"`
+    BrandName          string  `json:"brand_name" db:"brand_name"`
+    Name               string  `json:"matched_name"`
+    Score              float64 `json:"score"`
+    EditDistance       int     `json:"edit_distance"`
+    EditSimilarity     float64 `json:"edit_similarity"`
+    PhoneticSimilarity float64 `json:"phonetic_similarity"`
+    SharedPrefix       int     `json:"shared_prefix"`
+}
+
+// TooSimilar is the leading matches, best first, scoring at least
+// threshold; none when the check is off.
+func TooSimilar(matches []BrandMatch, threshold float64) []BrandMatch {
+    if threshold <= 0 {
+        return nil
+    }
+    n := 0
+    for n < len(matches) && matches[n].Score >= threshold {
+        n++
+    }
+    return matches[:n]
+}
+
+// BrandCheckResult is the response of GET /drug/similar-brands: the closest
+// brands, best first, and whether the best reaches the threshold at which
+// adding a drug asks for an override.
+type BrandCheckResult struct {
+    Names     []string     `json:"names"`
+    Threshold float64      `json:"threshold"`
+    Exceeded  bool         `json:"exceeded"`
+    Matches   []BrandMatch `json:"matches"`
+}
@@ -0,0 +1,211 @@
+package models
+
+import (
+    "database/sql/driver"
+    "errors"
+    "fmt"
+    "math/big"
+    "strconv"
+    "strings"
+)
+
+// Decimal is an exact base-10 number, stored in Postgres as numeric and
+// written to JSON as a plain number literal ("0.3", never
+// 0.30000000000000004). It keeps the scale it was given, so a price of
+// "12.50" reads back as 12.50; Cmp ignores scale. The zero value is 0.
+type Decimal struct {
+    coef  *big.Int // value = coef × 10^-scale; nil means 0
+    scale int32    // digits after the point, >= 0
+}
+
+var errDecimalSyntax = errors.New("invalid decimal number")
+
+// maxDecimalScale bounds the exponents ParseDecimal accepts, so "1e999999999"
+// cannot make it allocate a billion digits.
+const maxDecimalScale = 1000
+
+// NewDecimal returns coef × 10^-scale, e.g. NewDecimal(1250, 2) is 12.50.
+func NewDecimal(coef int64, scale int32) Decimal {
+    if scale < 0 {
+        return Decimal{coef: new(big.Int).Mul(big.NewInt(coef), pow10(-scale))}
+    }
+    return Decimal{coef: big.NewInt(coef), scale: scale}
+}
+
+// ParseDecimal reads a decimal number such as "500", "-0.25" or "1e-6".
+func ParseDecimal(s string) (Decimal, error) {
+    s = strings.TrimSpace(s)
+    mant, exp := s, int64(0)
+    if i := strings.IndexAny(s, "eE"); i >= 0 {
+        e, err := strconv.ParseInt(s[i+1:], 10, 32)
+        if err != nil {
+            return Decimal{}, errDecimalSyntax
+        }
+        mant, exp = s[:i], e
+    }
+    neg := strings.HasPrefix(mant, "-")
+    if neg || strings.HasPrefix(mant, "+") {
+        mant = mant[1:]
+    }
+    intPart, frac, _ := strings.Cut(mant, ".")
+    digits := intPart + frac
+    if digits == "" || strings.Trim(digits, "0123456789") != "" {
+        return Decimal{}, errDecimalSyntax
+    }
+    coef, _ := new(big.Int).SetString(digits, 10)
+    if neg {
+        coef.Neg(coef)
+    }
+    scale := int64(len(frac)) - exp
+    if scale < -maxDecimalScale || scale > maxDecimalScale {
+        return Decimal{}, errDecimalSyntax
+    }
+    if scale < 0 {
+        return Decimal{coef: coef.Mul(coef, pow10(int32(-scale)))}, nil
+    }
+    return Decimal{coef: coef, scale: int32(scale)}, nil
+}
+
+// MustParseDecimal is ParseDecimal for constants; it panics on bad input.
+func MustParseDecimal(s string) Decimal {
+    d, err := ParseDecimal(s)
+    if err != nil {
+        panic(fmt.Sprintf("models: %q: %v", s, err))
+    }
+    return d
+}
+
+// DecimalFromFloat is the shortest decimal that reads back as f, so 0.3
+// becomes exactly 0.3.
+func DecimalFromFloat(f float64) Decimal {
+    d, _ := ParseDecimal(strconv.FormatFloat(f, 'f', -1, 64))
+    return d
+}
+
+func pow10(n int32) *big.Int { return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil) }
+
+func (d Decimal) int() *big.Int {
+    if d.coef == nil {
+        return new(big.Int)
+    }
+    return d.coef
+}
+
+// String formats d without an exponent, keeping its scale.
+func (d Decimal) String() string {
+    s := d.int().String()
+    neg := strings.HasPrefix(s, "-")
+    s = strings.TrimPrefix(s, "-")
+    if d.scale > 0 {
+        if pad := int(d.scale) + 1 - len(s); pad > 0 {
+            s = strings.Repeat("0", pad) + s
+        }
+        s = s[:len(s)-int(d.scale)] + "." + s[len(s)-int(d.scale):]
+    }
+    if neg {
+        s = "-" + s
+    }
+    return s
+}
+
+// Scale is the number of digits after the decimal point.
+func (d Decimal) Scale() int32 { return d.scale }
+
+func (d Decimal) Sign() int    { return d.int().Sign() }
+func (d Decimal) IsZero() bool { return d.Sign() == 0 }
+
+// Cmp compares d and o by value: -1, 0 or +1.
+func (d Decimal) Cmp(o Decimal) int { return d.Rat().Cmp(o.Rat()) }
+
+// Reduced is d without trailing zeros after the point: 12.50 becomes 12.5.
+// Equal values have equal Reduced strings.
+func (d Decimal) Reduced() Decimal {
+    coef, scale := new(big.Int).Set(d.int()), d.scale
+    ten, r := big.NewInt(10), new(big.Int)
+    for scale > 0 {
+        q, _ := new(big.Int).QuoRem(coef, ten, r)
+        if r.Sign() != 0 {
+            break
+        }
+        coef, scale = q, scale-1
+    }
+    return Decimal{coef: coef, scale: scale}
+}
+
+// Mul returns d × o, exactly.
+func (d Decimal) Mul(o Decimal) Decimal {
+    return Decimal{coef: new(big.Int).Mul(d.int(), o.int()), scale: d.scale + o.scale}
+}
+
+// Rat is d as an exact fraction.
+func (d Decimal) Rat() *big.Rat { return new(big.Rat).SetFrac(d.int(), pow10(d.scale)) }
+
+// DecimalFromRat rounds r half away from zero to at most places digits
+// after the point and drops trailing zeros.
+func DecimalFromRat(r *big.Rat, places int32) Decimal {
+    num := new(big.Int).Mul(r.Num(), pow10(places))
+    q, m := new(big.Int).QuoRem(num, r.Denom(), new(big.Int))
+    if m.Abs(m).Lsh(m, 1).Cmp(r.Denom()) >= 0 {
+        q.Add(q, big.NewInt(int64(r.Sign())))
+    }
+    return Decimal{coef: q, scale: places}.Reduced()
+}
+
+// Float64 is the nearest float64 to d, for callers that only need an
+// approximation.
+func (d Decimal) Float64() float64 {
+    f, _ := d.Rat().Float64()
+    return f
+}
+
+func (d Decimal) MarshalJSON() ([]byte, error) { return []byte(d.String()), nil }
+
+// UnmarshalJSON accepts a JSON number or a string holding one.
+func (d *Decimal) UnmarshalJSON(b []byte) error {
+    s := string(b)
+    if s == "null" {
+        return nil
+    }
+    if unq, err := strconv.Unquote(s); err == nil {
+        s = unq
+    }
+    v, err := ParseDecimal(s)
+    if err != nil {
+        return fmt.Errorf("%q: %w", s, err)
+    }
+    *d = v
+    return nil
+}
+
+// UnmarshalParam reads d from a query parameter.
+func (d *Decimal) UnmarshalParam(s string) error {
+    v, err := ParseDecimal(s)
+    if err != nil {
+        return fmt.Errorf("%q: %w", s, err)
+    }
+    *d = v
+    return nil
+}
+
+// Value writes d to a numeric column as text, so no precision is lost.
+func (d Decimal) Value() (driver.Value, error) { return d.String(), nil }
+
+// Scan reads a numeric column.
+func (d *Decimal) Scan(src any) error {
+    var err error
+    switch v := src.(type) {
+    case nil:
+        *d = Decimal{}
+    case string:
+        *d, err = ParseDecimal(v)
+    case []byte:
+        *d, err = ParseDecimal(string(v))
+    case int64:
+        *d = NewDecimal(v, 0)
+    case float64:
+        *d = DecimalFromFloat(v)
+    default:
+        err = fmt.Errorf("cannot scan %T into Decimal", src)
+    }
+    return err
+}
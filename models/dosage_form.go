@@ -1,14 +1,35 @@
 
 package models
 
-import "time"
+import (
+    "strings"
+    "time"
+)
 
+// DosageForm is a pharmaceutical form. Forms sharing a Group (e.g. TAB and
+// FCT in ORAL-SOLID) are interchangeable when looking for equivalent drugs;
+// a form without a group only matches itself.
 type DosageForm struct {
-    ID        string     `json:"id" db:"id" validate:"omitempty,uuid4"`
-    Code      string     `json:"code" db:"code" validate:"required,notblank,max=32"`
-    Name      string     `json:"name" db:"name" validate:"required,notblank,max=120"`
-    CreatedAt *time.Time `json:"created_at,omitempty" db:"created_at"`
-    UpdatedAt *time.Time `json:"updated_at,omitempty" db:"updated_at"`
+    ID        string        `json:"id" db:"id" validate:"omitempty,uuid4"`
+    Code      string        `json:"code" db:"code" validate:"required,notblank,max=32"`
+    Name      string        `json:"name" db:"name"` // Names[DefaultLocale], set by Normalize
+    Names     LocalizedName `json:"names" db:"names" validate:"default_locale,dive,keys,oneof=en ar fr,endkeys,notblank,max=120"`
+    Group     string        `json:"group,omitempty" db:"form_group" validate:"omitempty,max=32"`
+    CreatedAt *time.Time    `json:"created_at,omitempty" db:"created_at"`
+    UpdatedAt *time.Time    `json:"updated_at,omitempty" db:"updated_at"`
 }
 
 func (m *DosageForm) Validate() error { return validate.Struct(m) }
+
+// Normalize trims the input and upper-cases the code and group, as stored.
+func (m *DosageForm) Normalize() {
+    m.Code = strings.ToUpper(strings.TrimSpace(m.Code))
+    m.Group = strings.ToUpper(strings.TrimSpace(m.Group))
+    normalizeName(&m.Name, &m.Names)
+}
+
+// Localize sets Name to the name in lang, falling back to DefaultLocale.
+func (m *DosageForm) Localize(lang string) { m.Name = m.Names.Get(lang) }
+
+// PreparePatch drops Names[DefaultLocale], which Normalize refills from Name.
+func (m *DosageForm) PreparePatch() { m.Names = m.Names.withoutDefault() }
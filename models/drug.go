@@ -1,18 +1,30 @@
 // internal/domain/models/drug.go
 package models
 
-import "time"
+import (
+	"time"
+)
 
 type Drug struct {
-	ID             string     `json:"id" db:"id" validate:"omitempty,uuid4"`
-	BrandName      string     `json:"brand_name" db:"brand_name" validate:"required,notblank,max=200"`
-	DosageFormID   string     `json:"dosage_form_id" db:"dosage_form_id" validate:"required,uuid4"`
-	RouteID        string     `json:"route_id" db:"route_id" validate:"required,uuid4"`
-	StrengthUnitID string     `json:"strength_unit_id" db:"strength_unit_id" validate:"required,uuid4"`
-	Dose           float64    `json:"dose" db:"dose" validate:"required,gt=0"`
-	APIID          string     `json:"api_id" db:"api_id" validate:"required,uuid4"` // NEW
-	CreatedAt      *time.Time `json:"created_at,omitempty" db:"created_at"`
-	UpdatedAt      *time.Time `json:"updated_at,omitempty" db:"updated_at"`
+	ID             string        `json:"id" db:"id" validate:"omitempty,uuid4"`
+	BrandName      string        `json:"brand_name" db:"brand_name"` // BrandNames[DefaultLocale], set by Normalize
+	BrandNames     LocalizedName `json:"brand_names" db:"brand_names" validate:"default_locale,dive,keys,oneof=en ar fr,endkeys,notblank,max=200"`
+	DosageFormID   string        `json:"dosage_form_id" db:"dosage_form_id" validate:"required,uuid4"`
+	RouteID        string        `json:"route_id" db:"route_id" validate:"required,uuid4"`
+	StrengthUnitID string        `json:"strength_unit_id" db:"strength_unit_id" validate:"required,uuid4"`
+	Dose           Decimal       `json:"dose" db:"dose" validate:"required,gt=0"`
+	APIID          string        `json:"api_id" db:"api_id" validate:"required,uuid4"` // with StrengthUnitID and Dose, the first DrugIngredient
+	CreatedAt      *time.Time    `json:"created_at,omitempty" db:"created_at"`
+	UpdatedAt      *time.Time    `json:"updated_at,omitempty" db:"updated_at"`
 }
 
 func (m *Drug) Validate() error { return validate.Struct(m) }
+
+// Normalize trims the input.
+func (m *Drug) Normalize() { normalizeName(&m.BrandName, &m.BrandNames) }
+
+// Localize sets BrandName to the name in lang, falling back to DefaultLocale.
+func (m *Drug) Localize(lang string) { m.BrandName = m.BrandNames.Get(lang) }
+
+// PreparePatch drops BrandNames[DefaultLocale], which Normalize refills from BrandName.
+func (m *Drug) PreparePatch() { m.BrandNames = m.BrandNames.withoutDefault() }
@@ -0,0 +1,20 @@
+package models
+
+// DrugEquivalent is a drug interchangeable with another: the same active
+// ingredients at the same strength once converted to base units, the same
+// route and a compatible dosage form. It is on the market, so it carries
+// the active registration it is sold under and its count of released
+// batches.
+type DrugEquivalent struct {
+    Drug
+    RegistrationID     string `json:"registration_id" db:"registration_id"`
+    RegistrationNumber string `json:"registration_number,omitempty" db:"registration_number"`
+    ReleasedBatches    int64  `json:"released_batches" db:"released_batches"`
+}
+
+// DrugEquivalents is the response of GET /drug/:id/equivalents, ordered by
+// brand name.
+type DrugEquivalents struct {
+    DrugID string           `json:"drug_id"`
+    Items  []DrugEquivalent `json:"items"`
+}
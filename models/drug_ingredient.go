@@ -0,0 +1,45 @@
+package models
+
+import (
+    "strings"
+    "time"
+)
+
+// StrengthBasis says whether an ingredient's strength is of the active
+// moiety (base) or of the salt it is formulated as, e.g. amoxicillin 500 mg
+// as the trihydrate vs. 574 mg of amoxicillin trihydrate.
+type StrengthBasis string
+const (
+    BasisBase StrengthBasis = "base"
+    BasisSalt StrengthBasis = "salt"
+)
+
+// DrugIngredient is one active ingredient of a drug and its strength:
+// Strength StrengthUnitID, optionally per PerQuantity PerUnitID ("250 mg
+// per 5 mL"). A fixed-dose combination has one row per API. Salt names the
+// salt form and is required when the strength is of the salt.
+//
+// Every drug has its first ingredient from when it was created, mirroring
+// Drug.APIID, StrengthUnitID and Dose; rows migrated from single-API drugs
+// have no Basis until someone sets it.
+type DrugIngredient struct {
+    ID             string        `json:"id" db:"id" validate:"omitempty,uuid4"`
+    DrugID         string        `json:"drug_id" db:"drug_id" validate:"required,uuid4"`
+    APIID          string        `json:"api_id" db:"api_id" validate:"required,uuid4"`
+    Strength       Decimal       `json:"strength" db:"strength" validate:"required,gt=0"`
+    StrengthUnitID string        `json:"strength_unit_id" db:"strength_unit_id" validate:"required,uuid4"`
+    PerQuantity    *Decimal      `json:"per_quantity,omitempty" db:"per_quantity" validate:"omitempty,gt=0"`
+    PerUnitID      string        `json:"per_unit_id,omitempty" db:"per_unit_id" validate:"omitempty,uuid4"`
+    Basis          StrengthBasis `json:"basis,omitempty" db:"basis" validate:"required,oneof=base salt"`
+    Salt           string        `json:"salt,omitempty" db:"salt" validate:"omitempty,max=200"`
+    CreatedAt      *time.Time    `json:"created_at,omitempty" db:"created_at"`
+    UpdatedAt      *time.Time    `json:"updated_at,omitempty" db:"updated_at"`
+}
+
+func (m *DrugIngredient) Validate() error { return validate.Struct(m) }
+
+// Normalize trims the input.
+func (m *DrugIngredient) Normalize() {
+    m.Basis = StrengthBasis(strings.ToLower(strings.TrimSpace(string(m.Basis))))
+    m.Salt = strings.TrimSpace(m.Salt)
+}
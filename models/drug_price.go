@@ -0,0 +1,63 @@
+package models
+
+import (
+    "strings"
+    "time"
+)
+
+// DrugPrice is a maximum price set by the pricing committee: MaxPrice in
+// Currency for DrugID, from EffectiveFrom until a later DrugPrice for the
+// same drug, presentation and currency takes over. PresentationID names
+// the pack it covers, one of the drug's presentations; empty covers every
+// pack without its own ceiling.
+// Reference is the committee decision it was set by.
+type DrugPrice struct {
+    ID             string     `json:"id" db:"id" validate:"omitempty,uuid4"`
+    DrugID         string     `json:"drug_id" db:"drug_id" validate:"required,uuid4"`
+    PresentationID string     `json:"presentation_id,omitempty" db:"presentation_id" validate:"omitempty,uuid4"`
+    Currency       string     `json:"currency" db:"currency" validate:"required,len=3,alpha,uppercase"`
+    MaxPrice       Decimal    `json:"max_price" db:"max_price" validate:"required,gt=0"`
+    EffectiveFrom  time.Time  `json:"effective_from" db:"effective_from" validate:"required"`
+    Reference      string     `json:"reference,omitempty" db:"reference" validate:"omitempty,max=200"`
+    CreatedAt      *time.Time `json:"created_at,omitempty" db:"created_at"`
+    UpdatedAt      *time.Time `json:"updated_at,omitempty" db:"updated_at"`
+}
+
+func (m *DrugPrice) Validate() error { return validate.Struct(m) }
+
+// Normalize trims the input and upper-cases the currency.
+func (m *DrugPrice) Normalize() {
+    m.Currency = strings.ToUpper(strings.TrimSpace(m.Currency))
+    m.Reference = strings.TrimSpace(m.Reference)
+}
+
+// Covers reports whether the ceiling applies to batch b on its
+// manufacturing date, ignoring whether a later ceiling replaced it.
+func (m DrugPrice) Covers(b Batch) bool {
+    return m.DrugID == b.DrugID && m.Currency == b.Currency &&
+        (m.PresentationID == "" || m.PresentationID == b.PresentationID) && !m.EffectiveFrom.After(b.MfgDate)
+}
+
+// PriceCeilingPolicy is what adding or updating a batch does with a price
+// above the ceiling in force: refuse the batch, or store it and let it show
+// up among the PriceViolations.
+type PriceCeilingPolicy string
+const (
+    PriceCeilingReject PriceCeilingPolicy = "reject"
+    PriceCeilingFlag   PriceCeilingPolicy = "flag"
+)
+
+// PriceViolation is a batch priced above the ceiling in force on its
+// manufacturing date, with that ceiling.
+type PriceViolation struct {
+    BatchID        string    `json:"batch_id" db:"id"`
+    DrugID         string    `json:"drug_id" db:"drug_id"`
+    BatchNumber    string    `json:"batch_number" db:"batch_number"`
+    PresentationID string    `json:"presentation_id,omitempty" db:"presentation_id"`
+    MfgDate        time.Time `json:"mfg_date" db:"mfg_date"`
+    Price          Decimal   `json:"price" db:"price"`
+    Currency       string    `json:"currency" db:"currency"`
+    PriceID        string    `json:"price_id" db:"price_id"`
+    MaxPrice       Decimal   `json:"max_price" db:"max_price"`
+    EffectiveFrom  time.Time `json:"effective_from" db:"effective_from"`
+}
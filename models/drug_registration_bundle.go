@@ -0,0 +1,12 @@
+package models
+
+// DrugRegistrationBundle is a registration together with its site and auth
+// holder links, created in one go. drug_registration_id on the links is
+// filled in from the new registration and must be left out.
+type DrugRegistrationBundle struct {
+    Registration DrugRegistration             `json:"registration"`
+    Sites        []DrugRegistrationSite       `json:"sites" validate:"required,min=1"`
+    AuthHolders  []DrugRegistrationAuthHolder `json:"auth_holders"`
+}
+
+func (m *DrugRegistrationBundle) Validate() error { return validate.Struct(m) }
@@ -0,0 +1,262 @@
+package models
+
+import (
+    "regexp"
+    "strconv"
+    "strings"
+    "time"
+
+    "moh/shared"
+)
+
+// GS1 application identifiers printed on medicine packs.
+const (
+    AIGTIN   = "01"
+    AIBatch  = "10"
+    AIExpiry = "17"
+    AISerial = "21"
+)
+
+// GS1GroupSeparator is the ASCII GS a scanner sends for FNC1 after a
+// variable-length element.
+const GS1GroupSeparator = '\x1d'
+
+// gs1AI is the data format of one application identifier: a fixed length,
+// or up to max characters ended by a separator.
+type gs1AI struct {
+    fixed, max int
+    numeric    bool
+    date       bool // YYMMDD
+    gtin       bool
+}
+
+// gs1AIs are the application identifiers this parser knows. Element
+// strings carrying any other are refused, since its length is unknown.
+var gs1AIs = map[string]gs1AI{
+    "00":  {fixed: 18, numeric: true},             // SSCC
+    "01":  {fixed: 14, numeric: true, gtin: true},
+    "02":  {fixed: 14, numeric: true, gtin: true}, // GTIN of contained items
+    "10":  {max: 20},                              // batch or lot
+    "11":  {fixed: 6, date: true},                 // production date
+    "12":  {fixed: 6, date: true},                 // due date
+    "13":  {fixed: 6, date: true},                 // packaging date
+    "15":  {fixed: 6, date: true},                 // best before
+    "16":  {fixed: 6, date: true},                 // sell by
+    "17":  {fixed: 6, date: true},                 // expiry
+    "20":  {fixed: 2, numeric: true},              // variant
+    "21":  {max: 20},                              // serial number
+    "22":  {max: 20},                              // consumer product variant
+    "30":  {max: 8, numeric: true},                // count
+    "37":  {max: 8, numeric: true},                // count of trade items
+    "240": {max: 30},                              // additional product id
+    "241": {max: 30},                              // customer part number
+    "250": {max: 30},                              // secondary serial number
+    "400": {max: 30},                              // customer order number
+    "710": {max: 20},                              // national healthcare reimbursement numbers
+    "711": {max: 20},
+    "712": {max: 20},
+    "713": {max: 20},
+    "714": {max: 20},
+    "715": {max: 20},
+}
+
+// GS1Element is one application identifier and its data.
+type GS1Element struct {
+    AI    string `json:"ai"`
+    Value string `json:"value"`
+}
+
+// GS1Data is a decoded GS1 element string, in the order it was encoded.
+type GS1Data []GS1Element
+
+// Get returns the data of ai, if present.
+func (d GS1Data) Get(ai string) (string, bool) {
+    for _, e := range d {
+        if e.AI == ai {
+            return e.Value, true
+        }
+    }
+    return "", false
+}
+
+// Expiry is the expiry date (17). A day of 00 means the end of the month,
+// and monthOnly reports that only the year and month were given.
+func (d GS1Data) Expiry() (date time.Time, monthOnly, ok bool) {
+    v, ok := d.Get(AIExpiry)
+    if !ok {
+        return time.Time{}, false, false
+    }
+    date, _ = gs1Date(v, time.Now())
+    return date, v[4:] == "00", true
+}
+
+var (
+    symbologyID = regexp.MustCompile(`^\][A-Za-z][0-9]`)
+    bracketedAI = regexp.MustCompile(`\(([0-9]{2,4})\)`)
+)
+
+// ParseGS1 decodes a GS1 element string, either as scanned (an optional
+// symbology identifier such as "]d2", with GS separating variable-length
+// elements) or in the human-readable form with parenthesised AIs, e.g.
+// "(01)09506000134352(17)281231(10)A1B2(21)X9". It checks lengths,
+// character sets, GTIN check digits and dates, and that no AI repeats.
+func ParseGS1(payload string) (GS1Data, error) {
+    s := strings.TrimSpace(payload)
+    if s == "" {
+        return nil, shared.BadRequest("invalid_payload", "gs1_empty")
+    }
+    var (
+        out GS1Data
+        err error
+    )
+    if strings.HasPrefix(s, "(") {
+        out, err = parseBracketedGS1(s)
+    } else {
+        out, err = parseScannedGS1(symbologyID.ReplaceAllString(s, ""))
+    }
+    if err != nil {
+        return nil, err
+    }
+
+    seen := map[string]bool{}
+    for _, e := range out {
+        if seen[e.AI] {
+            return nil, shared.BadRequest("invalid_payload", "gs1_repeated", e.AI)
+        }
+        seen[e.AI] = true
+        if err := checkGS1(e); err != nil {
+            return nil, err
+        }
+    }
+    return out, nil
+}
+
+func parseBracketedGS1(s string) (GS1Data, error) {
+    locs := bracketedAI.FindAllStringSubmatchIndex(s, -1)
+    if len(locs) == 0 || locs[0][0] != 0 {
+        return nil, shared.BadRequest("invalid_payload", "gs1_unknown_ai", truncate(s, 8))
+    }
+    var out GS1Data
+    for i, loc := range locs {
+        end := len(s)
+        if i+1 < len(locs) {
+            end = locs[i+1][0]
+        }
+        ai := s[loc[2]:loc[3]]
+        if _, ok := gs1AIs[ai]; !ok {
+            return nil, shared.BadRequest("invalid_payload", "gs1_unknown_ai", ai)
+        }
+        out = append(out, GS1Element{AI: ai, Value: s[loc[1]:end]})
+    }
+    return out, nil
+}
+
+func parseScannedGS1(s string) (GS1Data, error) {
+    var out GS1Data
+    for _, field := range strings.Split(s, string(GS1GroupSeparator)) {
+        for field != "" {
+            ai, spec, ok := lookupAI(field)
+            if !ok {
+                return nil, shared.BadRequest("invalid_payload", "gs1_unknown_ai", truncate(field, 4))
+            }
+            field = field[len(ai):]
+            n := len(field)
+            if spec.fixed > 0 {
+                if n < spec.fixed {
+                    return nil, shared.BadRequest("invalid_payload", "gs1_length", ai, strconv.Itoa(spec.fixed))
+                }
+                n = spec.fixed
+            }
+            out = append(out, GS1Element{AI: ai, Value: field[:n]})
+            field = field[n:]
+        }
+    }
+    return out, nil
+}
+
+// lookupAI finds the AI s starts with. No known AI is a prefix of another,
+// so the shortest match is the only one.
+func lookupAI(s string) (string, gs1AI, bool) {
+    for n := 2; n <= 4 && n <= len(s); n++ {
+        if spec, ok := gs1AIs[s[:n]]; ok {
+            return s[:n], spec, true
+        }
+    }
+    return "", gs1AI{}, false
+}
+
+// checkGS1 validates the data of one element against its AI's format.
+func checkGS1(e GS1Element) error {
+    spec := gs1AIs[e.AI]
+    if spec.fixed > 0 && len(e.Value) != spec.fixed {
+        return shared.BadRequest("invalid_payload", "gs1_length", e.AI, strconv.Itoa(spec.fixed))
+    }
+    if spec.max > 0 && (e.Value == "" || len(e.Value) > spec.max) {
+        return shared.BadRequest("invalid_payload", "gs1_max_length", e.AI, strconv.Itoa(spec.max))
+    }
+    valid := true
+    switch {
+    case spec.gtin:
+        valid = ValidGTIN(e.Value)
+    case spec.date:
+        _, valid = gs1Date(e.Value, time.Now())
+    case spec.numeric:
+        valid = allDigits(e.Value)
+    default:
+        valid = CSET82(e.Value)
+    }
+    if !valid {
+        return shared.BadRequest("invalid_payload", "gs1_invalid", e.AI, e.Value)
+    }
+    return nil
+}
+
+// CSET82 reports whether s is in GS1 character set 82, printable ASCII
+// without the space, which alphanumeric AIs such as batch and serial
+// numbers are written in.
+func CSET82(s string) bool {
+    for i := 0; i < len(s); i++ {
+        if s[i] <= ' ' || s[i] > '~' {
+            return false
+        }
+    }
+    return true
+}
+
+// gs1Date reads a YYMMDD date. The century is the one that puts the year
+// within 49 years ahead or 50 behind now, and day 00 is the last of the
+// month, as the GS1 General Specifications define.
+func gs1Date(v string, now time.Time) (time.Time, bool) {
+    if len(v) != 6 || !allDigits(v) {
+        return time.Time{}, false
+    }
+    yy, _ := strconv.Atoi(v[0:2])
+    mm, _ := strconv.Atoi(v[2:4])
+    dd, _ := strconv.Atoi(v[4:6])
+    if mm < 1 || mm > 12 {
+        return time.Time{}, false
+    }
+    century := now.Year() / 100 * 100
+    switch diff := yy - now.Year()%100; {
+    case diff >= 51:
+        century -= 100
+    case diff <= -50:
+        century += 100
+    }
+    year := century + yy
+    last := time.Date(year, time.Month(mm)+1, 0, 0, 0, 0, 0, time.UTC).Day()
+    if dd == 0 {
+        dd = last
+    }
+    if dd > last {
+        return time.Time{}, false
+    }
+    return time.Date(year, time.Month(mm), dd, 0, 0, 0, 0, time.UTC), true
+}
+
+func truncate(s string, n int) string {
+    if len(s) > n {
+        return s[:n]
+    }
+    return s
+}
@@ -0,0 +1,55 @@
+package models
+
+import "strings"
+
+// GTINLength is the length of a GTIN-14. Shorter GTINs (GTIN-8, -12 and
+// -13) are stored and looked up zero-padded to it, as GS1 prescribes.
+const GTINLength = 14
+
+// NormalizeGTIN trims s and zero-pads a GTIN-8, -12 or -13 to 14 digits.
+// Anything else comes back trimmed only, for ValidGTIN to reject.
+func NormalizeGTIN(s string) string {
+    s = strings.TrimSpace(s)
+    switch len(s) {
+    case 8, 12, 13:
+        if allDigits(s) {
+            return strings.Repeat("0", GTINLength-len(s)) + s
+        }
+    }
+    return s
+}
+
+// ValidGTIN reports whether s is a GTIN-8, -12, -13 or -14 whose last digit
+// is the GS1 check digit of the others.
+func ValidGTIN(s string) bool {
+    switch len(s) {
+    case 8, 12, 13, 14:
+    default:
+        return false
+    }
+    return allDigits(s) && GTINCheckDigit(s[:len(s)-1]) == s[len(s)-1]
+}
+
+// GTINCheckDigit is the GS1 mod-10 check digit of digits: weighting them
+// 3, 1, 3, ... from the right, the amount that takes their sum to a
+// multiple of ten.
+func GTINCheckDigit(digits string) byte {
+    sum := 0
+    for i := len(digits) - 1; i >= 0; i-- {
+        d := int(digits[i] - '0')
+        if (len(digits)-1-i)%2 == 0 {
+            d *= 3
+        }
+        sum += d
+    }
+    return byte('0' + (10-sum%10)%10)
+}
+
+func allDigits(s string) bool {
+    for i := 0; i < len(s); i++ {
+        if s[i] < '0' || s[i] > '9' {
+            return false
+        }
+    }
+    return s != ""
+}
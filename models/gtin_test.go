@@ -0,0 +1,74 @@
+package models
+
+import "testing"
+
+func TestValidGTIN(t *testing.T) {
+    for _, tc := range []struct {
+        in   string
+        want bool
+    }{
+        {"96385074", true},       // GTIN-8
+        {"036000291452", true},   // GTIN-12 (UPC-A)
+        {"4006381333931", true},  // GTIN-13 (EAN-13)
+        {"10012345678902", true}, // GTIN-14
+        {"00000000000000", true},
+        {"96385075", false},
+        {"036000291453", false},
+        {"4006381333932", false},
+        {"10012345678901", false},
+        {"9638507A", false},
+        {"4006381 33931", false},
+        {"-006381333931", false},
+        {"", false},
+        {"1234567", false},
+        {"963850745", false},
+        {"40063813339310", false},
+        {"400638133393100", false},
+        {" 4006381333931", false}, // not trimmed: callers normalize first
+    } {
+        if got := ValidGTIN(tc.in); got != tc.want {
+            t.Errorf("ValidGTIN(%q) = %v, want %v", tc.in, got, tc.want)
+        }
+    }
+}
+
+func TestNormalizeGTIN(t *testing.T) {
+    for _, tc := range []struct{ in, want string }{
+        {"96385074", "00000096385074"},
+        {"036000291452", "00036000291452"},
+        {"4006381333931", "04006381333931"},
+        {" 4006381333931\n", "04006381333931"},
+        {"10012345678902", "10012345678902"},
+        // Not a GTIN: trimmed, never padded, for ValidGTIN to reject.
+        {"9638507A", "9638507A"},
+        {"1234567", "1234567"},
+        {"963850745", "963850745"},
+        {" abc ", "abc"},
+        {"", ""},
+    } {
+        got := NormalizeGTIN(tc.in)
+        if got != tc.want {
+            t.Errorf("NormalizeGTIN(%q) = %q, want %q", tc.in, got, tc.want)
+        }
+        if len(tc.want) == GTINLength && !ValidGTIN(got) {
+            t.Errorf("NormalizeGTIN(%q) = %q, which ValidGTIN rejects", tc.in, got)
+        }
+    }
+}
+
+func TestGTINCheckDigit(t *testing.T) {
+    for _, tc := range []struct {
+        digits string
+        want   byte
+    }{
+        {"9638507", '4'},
+        {"03600029145", '2'},
+        {"400638133393", '1'},
+        {"1001234567890", '2'},
+        {"0000000000000", '0'},
+    } {
+        if got := GTINCheckDigit(tc.digits); got != tc.want {
+            t.Errorf("GTINCheckDigit(%q) = %c, want %c", tc.digits, got, tc.want)
+        }
+    }
+}
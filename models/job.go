@@ -0,0 +1,19 @@
+package models
+
+import "time"
+
+// JobStatus describes a background job: its schedule on this instance and
+// its last run on any instance.
+type JobStatus struct {
+    Name           string     `json:"name" db:"job_name"`
+    Interval       string     `json:"interval" db:"-"`
+    NextRunAt      *time.Time `json:"next_run_at,omitempty" db:"-"` // on this instance; empty when the scheduler is off
+    LastStartedAt  *time.Time `json:"last_started_at,omitempty" db:"last_started_at"`
+    LastFinishedAt *time.Time `json:"last_finished_at,omitempty" db:"last_finished_at"`
+    LastStatus     string     `json:"last_status,omitempty" db:"last_status"` // ok or error
+    LastError      string     `json:"last_error,omitempty" db:"last_error"`
+    LastAffected   int64      `json:"last_affected" db:"last_affected"`
+    LastInstance   string     `json:"last_instance,omitempty" db:"last_instance"`
+    RunCount       int64      `json:"run_count" db:"run_count"`
+    ErrorCount     int64      `json:"error_count" db:"error_count"`
+}
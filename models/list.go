@@ -0,0 +1,162 @@
+package models
+
+import "time"
+
+// ListParams are the paging and sorting options accepted by every list endpoint.
+// Sort is a whitelisted field name, prefixed with "-" for descending order.
+// Lang picks the translation returned as the plain name of entities with
+// translatable names, and orders them by it; empty means DefaultLocale.
+type ListParams struct {
+	Limit  int    `form:"limit" json:"limit" validate:"omitempty,min=1,max=500"`
+	Cursor string `form:"cursor" json:"cursor,omitempty" validate:"omitempty,max=512"`
+	Sort   string `form:"sort" json:"sort,omitempty" validate:"omitempty,max=64"`
+	Lang   string `form:"lang" json:"lang,omitempty" validate:"omitempty,oneof=en ar fr"`
+}
+
+func (m *ListParams) Validate() error { return validate.Struct(m) }
+
+// Page is the response envelope of every list endpoint.
+// NextCursor is empty on the last page; Total counts all rows matching the filters.
+type Page[T any] struct {
+	Items      []T    `json:"items"`
+	NextCursor string `json:"next_cursor,omitempty"`
+	Total      int64  `json:"total"`
+	Limit      int    `json:"limit"`
+}
+
+// ===== Catalog filters =====
+
+// CodeNameFilter filters dosage forms, strength units and routes.
+type CodeNameFilter struct {
+	Code string `form:"code" validate:"omitempty,max=32"`
+	Q    string `form:"q" validate:"omitempty,max=120"` // substring of code or name
+}
+
+func (m *CodeNameFilter) Validate() error { return validate.Struct(m) }
+
+type APIFilter struct {
+	Status APIStatus `form:"status" validate:"omitempty,oneof=active inactive withdrawn banned"`
+	Q      string    `form:"q" validate:"omitempty,max=200"`
+}
+
+func (m *APIFilter) Validate() error { return validate.Struct(m) }
+
+// ===== Registry filters =====
+
+type AuthHolderFilter struct {
+	RegistrationNumber string `form:"registration_number" validate:"omitempty,max=100"`
+	Q                  string `form:"q" validate:"omitempty,max=200"`
+}
+
+func (m *AuthHolderFilter) Validate() error { return validate.Struct(m) }
+
+// CountryFilter filters marketing authorizations and manufacturing sites.
+type CountryFilter struct {
+	Country string `form:"country" validate:"omitempty,alpha,len=2"`
+	Q       string `form:"q" validate:"omitempty,max=200"`
+}
+
+func (m *CountryFilter) Validate() error { return validate.Struct(m) }
+
+// ===== Domain filters =====
+
+type DrugFilter struct {
+	APIID          string `form:"api_id" validate:"omitempty,uuid4"` // any ingredient
+	DosageFormID   string `form:"dosage_form_id" validate:"omitempty,uuid4"`
+	RouteID        string `form:"route_id" validate:"omitempty,uuid4"`
+	StrengthUnitID string `form:"strength_unit_id" validate:"omitempty,uuid4"`
+	Q              string `form:"q" validate:"omitempty,max=200"` // substring of brand name
+}
+
+func (m *DrugFilter) Validate() error { return validate.Struct(m) }
+
+type BatchFilter struct {
+	Status             BatchStatus `form:"status" validate:"omitempty,oneof=planned released on_hold recalled expired sold_out inactive"`
+	DrugID             string      `form:"drug_id" validate:"omitempty,uuid4"`
+	DrugRegistrationID string      `form:"drug_registration_id" validate:"omitempty,uuid4"`
+	PresentationID     string      `form:"presentation_id" validate:"omitempty,uuid4"`
+	BatchNumber        string      `form:"batch_number" validate:"omitempty,max=120"`
+	ExpireBefore       time.Time   `form:"expire_before" time_format:"2006-01-02"`
+	ExpireAfter        time.Time   `form:"expire_after" time_format:"2006-01-02"`
+}
+
+func (m *BatchFilter) Validate() error { return validate.Struct(m) }
+
+type PresentationFilter struct {
+	DrugID        string        `form:"drug_id" validate:"omitempty,uuid4"`
+	GTIN          string        `form:"gtin" validate:"omitempty,max=14"`
+	LocalCode     string        `form:"local_code" validate:"omitempty,max=20"`
+	ContainerType ContainerType `form:"container_type" validate:"omitempty,oneof=blister bottle vial ampoule tube sachet box jar syringe inhaler bag other"`
+}
+
+func (m *PresentationFilter) Validate() error { return validate.Struct(m) }
+
+type SerialFilter struct {
+	BatchID string       `form:"batch_id" validate:"omitempty,uuid4"`
+	GTIN    string       `form:"gtin" validate:"omitempty,max=14"`
+	Status  SerialStatus `form:"status" validate:"omitempty,oneof=commissioned shipped received dispensed decommissioned"`
+}
+
+func (m *SerialFilter) Validate() error { return validate.Struct(m) }
+
+type SerialCloneFilter struct {
+	BatchID string `form:"batch_id" validate:"omitempty,uuid4"`
+	GTIN    string `form:"gtin" validate:"omitempty,max=14"`
+}
+
+func (m *SerialCloneFilter) Validate() error { return validate.Struct(m) }
+
+type DrugPriceFilter struct {
+	DrugID         string `form:"drug_id" validate:"omitempty,uuid4"`
+	PresentationID string `form:"presentation_id" validate:"omitempty,uuid4"`
+	Currency       string `form:"currency" validate:"omitempty,len=3,alpha"`
+}
+
+func (m *DrugPriceFilter) Validate() error { return validate.Struct(m) }
+
+type PriceViolationFilter struct {
+	DrugID   string `form:"drug_id" validate:"omitempty,uuid4"`
+	Currency string `form:"currency" validate:"omitempty,len=3,alpha"`
+}
+
+func (m *PriceViolationFilter) Validate() error { return validate.Struct(m) }
+
+type DrugRegistrationFilter struct {
+	Status    RegistrationStatus `form:"status" validate:"omitempty,oneof=active suspended expired withdrawn"`
+	DrugID    string             `form:"drug_id" validate:"omitempty,uuid4"`
+	MAID      string             `form:"ma_id" validate:"omitempty,uuid4"`
+	ValidOn   time.Time          `form:"valid_on" time_format:"2006-01-02"` // valid_from <= d <= valid_to
+	IsPrimary *bool              `form:"is_primary"`
+}
+
+func (m *DrugRegistrationFilter) Validate() error { return validate.Struct(m) }
+
+type DrugIngredientFilter struct {
+	DrugID string `form:"drug_id" validate:"omitempty,uuid4"`
+	APIID  string `form:"api_id" validate:"omitempty,uuid4"`
+}
+
+func (m *DrugIngredientFilter) Validate() error { return validate.Struct(m) }
+
+type DrugRegistrationSiteFilter struct {
+	DrugRegistrationID string `form:"drug_registration_id" validate:"omitempty,uuid4"`
+	SiteID             string `form:"site_id" validate:"omitempty,uuid4"`
+}
+
+func (m *DrugRegistrationSiteFilter) Validate() error { return validate.Struct(m) }
+
+type DrugRegistrationAuthHolderFilter struct {
+	DrugRegistrationID string `form:"drug_registration_id" validate:"omitempty,uuid4"`
+	AuthHolderID       string `form:"auth_holder_id" validate:"omitempty,uuid4"`
+}
+
+func (m *DrugRegistrationAuthHolderFilter) Validate() error { return validate.Struct(m) }
+
+type RecallFilter struct {
+	Status  RecallStatus `form:"status" validate:"omitempty,oneof=open closed"`
+	Class   RecallClass  `form:"class" validate:"omitempty,oneof=I II III"`
+	BatchID string       `form:"batch_id" validate:"omitempty,uuid4"`
+	DrugID  string       `form:"drug_id" validate:"omitempty,uuid4"`
+}
+
+func (m *RecallFilter) Validate() error { return validate.Struct(m) }
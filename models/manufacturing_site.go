@@ -1,14 +1,30 @@
 
 package models
 
-import "time"
+import (
+    "strings"
+    "time"
+)
 
 type ManufacturingSite struct {
-    ID        string     `json:"id" db:"id" validate:"omitempty,uuid4"`
-    Name      string     `json:"name" db:"name" validate:"required,notblank,max=200"`
-    Country   string     `json:"country" db:"country" validate:"required,alpha,uppercase,len=2"`
-    CreatedAt *time.Time `json:"created_at,omitempty" db:"created_at"`
-    UpdatedAt *time.Time `json:"updated_at,omitempty" db:"updated_at"`
+    ID        string        `json:"id" db:"id" validate:"omitempty,uuid4"`
+    Name      string        `json:"name" db:"name"` // Names[DefaultLocale], set by Normalize
+    Names     LocalizedName `json:"names" db:"names" validate:"default_locale,dive,keys,oneof=en ar fr,endkeys,notblank,max=200"`
+    Country   string        `json:"country" db:"country" validate:"required,alpha,uppercase,len=2"`
+    CreatedAt *time.Time    `json:"created_at,omitempty" db:"created_at"`
+    UpdatedAt *time.Time    `json:"updated_at,omitempty" db:"updated_at"`
 }
 
 func (m *ManufacturingSite) Validate() error { return validate.Struct(m) }
+
+// Normalize trims the input and upper-cases the country code.
+func (m *ManufacturingSite) Normalize() {
+    normalizeName(&m.Name, &m.Names)
+    m.Country = strings.ToUpper(strings.TrimSpace(m.Country))
+}
+
+// Localize sets Name to the name in lang, falling back to DefaultLocale.
+func (m *ManufacturingSite) Localize(lang string) { m.Name = m.Names.Get(lang) }
+
+// PreparePatch drops Names[DefaultLocale], which Normalize refills from Name.
+func (m *ManufacturingSite) PreparePatch() { m.Names = m.Names.withoutDefault() }
@@ -1,7 +1,10 @@
 
 package models
 
-import "time"
+import (
+    "strings"
+    "time"
+)
 
 type MarketingAuthorization struct {
     ID        string     `json:"id" db:"id" validate:"omitempty,uuid4"`
@@ -12,3 +15,9 @@ type MarketingAuthorization struct {
 }
 
 func (m *MarketingAuthorization) Validate() error { return validate.Struct(m) }
+
+// Normalize trims the input and upper-cases the country code.
+func (m *MarketingAuthorization) Normalize() {
+    m.Name = strings.TrimSpace(m.Name)
+    m.Country = strings.ToUpper(strings.TrimSpace(m.Country))
+}
@@ -0,0 +1,60 @@
+package models
+
+import (
+	"slices"
+	"strings"
+)
+
+// DefaultLocale is the language every translatable name must be given in.
+// Its value is also kept in the entity's plain name field, which the
+// uniqueness rules and existing clients use.
+const DefaultLocale = "en"
+
+// Locales are the languages a name may be given in, and the values of the
+// lang list parameter.
+var Locales = []string{"en", "ar", "fr"}
+
+// IsLocale reports whether lang is one of Locales.
+func IsLocale(lang string) bool { return slices.Contains(Locales, lang) }
+
+// LocalizedName is a name by locale, e.g. {"en": "Tablet", "ar": "قرص"}.
+// It is stored as a jsonb column next to the plain name.
+type LocalizedName map[string]string
+
+// Get returns the name in lang, or in DefaultLocale when there is none.
+func (n LocalizedName) Get(lang string) string {
+	if v, ok := n[lang]; ok && v != "" {
+		return v
+	}
+	return n[DefaultLocale]
+}
+
+// withoutDefault returns a copy of n without the DefaultLocale entry.
+func (n LocalizedName) withoutDefault() LocalizedName {
+	out := make(LocalizedName, len(n))
+	for lang, v := range n {
+		if lang != DefaultLocale {
+			out[lang] = v
+		}
+	}
+	return out
+}
+
+// normalizeName trims every translation and drops the empty ones. A plain
+// name sent without a default-locale translation becomes that translation,
+// which then wins: afterwards *name always equals (*names)[DefaultLocale].
+func normalizeName(name *string, names *LocalizedName) {
+	out := make(LocalizedName, len(*names)+1)
+	for lang, v := range *names {
+		if v = strings.TrimSpace(v); v != "" {
+			out[strings.ToLower(strings.TrimSpace(lang))] = v
+		}
+	}
+	if _, ok := out[DefaultLocale]; !ok {
+		if v := strings.TrimSpace(*name); v != "" {
+			out[DefaultLocale] = v
+		}
+	}
+	*names = out
+	*name = out[DefaultLocale]
+}
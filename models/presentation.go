@@ -0,0 +1,62 @@
+package models
+
+import (
+    "strings"
+    "time"
+)
+
+// ContainerType is the immediate container of a presentation.
+type ContainerType string
+const (
+    ContainerBlister ContainerType = "blister"
+    ContainerBottle  ContainerType = "bottle"
+    ContainerVial    ContainerType = "vial"
+    ContainerAmpoule ContainerType = "ampoule"
+    ContainerTube    ContainerType = "tube"
+    ContainerSachet  ContainerType = "sachet"
+    ContainerBox     ContainerType = "box"
+    ContainerJar     ContainerType = "jar"
+    ContainerSyringe ContainerType = "syringe"
+    ContainerInhaler ContainerType = "inhaler"
+    ContainerBag     ContainerType = "bag"
+    ContainerOther   ContainerType = "other"
+)
+
+// Presentation is one pack a drug is marketed in, e.g. 10x10 tablets in
+// blisters or a 100 mL bottle: PackSize in PackUnitID (in dosage units,
+// such as tablets, when empty) in a ContainerType. Each has its own GTIN,
+// kept as a GTIN-14, and may have a national LocalCode such as an
+// NDC-style package code.
+type Presentation struct {
+    ID            string        `json:"id" db:"id" validate:"omitempty,uuid4"`
+    DrugID        string        `json:"drug_id" db:"drug_id" validate:"required,uuid4"`
+    GTIN          string        `json:"gtin" db:"gtin" validate:"required,gtin"`
+    PackSize      Decimal       `json:"pack_size" db:"pack_size" validate:"required,gt=0"`
+    PackUnitID    string        `json:"pack_unit_id,omitempty" db:"pack_unit_id" validate:"omitempty,uuid4"`
+    ContainerType ContainerType `json:"container_type" db:"container_type" validate:"required,oneof=blister bottle vial ampoule tube sachet box jar syringe inhaler bag other"`
+    Description   string        `json:"description,omitempty" db:"description" validate:"omitempty,max=100"` // e.g. "10 blisters of 10"
+    LocalCode     string        `json:"local_code,omitempty" db:"local_code" validate:"omitempty,max=20,local_code"`
+    CreatedAt     *time.Time    `json:"created_at,omitempty" db:"created_at"`
+    UpdatedAt     *time.Time    `json:"updated_at,omitempty" db:"updated_at"`
+}
+
+func (m *Presentation) Validate() error { return validate.Struct(m) }
+
+// Normalize trims the input, pads the GTIN to 14 digits and fixes the case
+// of the container type and local code.
+func (m *Presentation) Normalize() {
+    m.GTIN = NormalizeGTIN(m.GTIN)
+    m.ContainerType = ContainerType(strings.ToLower(strings.TrimSpace(string(m.ContainerType))))
+    m.Description = strings.TrimSpace(m.Description)
+    m.LocalCode = strings.ToUpper(strings.TrimSpace(m.LocalCode))
+}
+
+// GTINLookup is what a GTIN identifies: its presentation, the drug, and
+// the registration the drug is marketed under today, if it has one in
+// force.
+type GTINLookup struct {
+    GTIN         string            `json:"gtin"`
+    Presentation Presentation      `json:"presentation"`
+    Drug         Drug              `json:"drug"`
+    Registration *DrugRegistration `json:"registration,omitempty"`
+}
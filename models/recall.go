@@ -0,0 +1,62 @@
+package models
+
+import (
+    "encoding/json"
+    "strings"
+    "time"
+)
+
+// Recall withdraws one or more batches, possibly of different drugs, from
+// the market. Opening or extending a recall moves its batches to recalled.
+// Recalls migrated from the old free-form batch recall_reason are unclassified
+// and keep that JSON in LegacyDetails.
+type Recall struct {
+    ID            string          `json:"id" db:"id"`
+    RecallNumber  string          `json:"recall_number" db:"recall_number"` // assigned on open, e.g. RC-2025-000042
+    Class         RecallClass     `json:"class" db:"class" validate:"required,oneof=I II III"`
+    Level         RecallLevel     `json:"level" db:"level" validate:"required,oneof=wholesale retail patient"`
+    ReasonCodes   []string        `json:"reason_codes" db:"reason_codes" validate:"required,min=1,max=10,unique,dive,oneof=contamination sterility potency impurity dissolution labelling packaging stability counterfeit gmp_deviation adverse_events other"`
+    Reason        string          `json:"reason,omitempty" db:"reason" validate:"omitempty,max=2000"`
+    InitiatedBy   RecallInitiator `json:"initiated_by" db:"initiated_by" validate:"required,oneof=manufacturer auth_holder regulator"`
+    InitiatorName string          `json:"initiator_name,omitempty" db:"initiator_name" validate:"omitempty,max=200"`
+    StartedOn     time.Time       `json:"started_on" db:"started_on"` // defaults to today
+    ClosedOn      *time.Time      `json:"closed_on,omitempty" db:"closed_on"`
+    Status        RecallStatus    `json:"status" db:"status"`
+    CloseNote     string          `json:"close_note,omitempty" db:"close_note"`
+    BatchIDs      []string        `json:"batch_ids" db:"batch_ids" validate:"required,min=1,max=1000,unique,dive,uuid4"`
+    LegacyDetails json.RawMessage `json:"legacy_details,omitempty" db:"legacy_details"`
+    CreatedBy     string          `json:"created_by" db:"created_by"`
+    CreatedAt     *time.Time      `json:"created_at,omitempty" db:"created_at"`
+    UpdatedAt     *time.Time      `json:"updated_at,omitempty" db:"updated_at"`
+}
+
+func (m *Recall) Validate() error { return validate.Struct(m) }
+
+// Normalize trims the input.
+func (m *Recall) Normalize() {
+    m.Reason = strings.TrimSpace(m.Reason)
+    m.InitiatorName = strings.TrimSpace(m.InitiatorName)
+}
+
+// RecallExtension is the body of POST /recall/:id/extend: more batches to
+// bring under an open recall.
+type RecallExtension struct {
+    BatchIDs []string `json:"batch_ids" validate:"required,min=1,max=1000,unique,dive,uuid4"`
+    Reason   string   `json:"reason,omitempty" validate:"omitempty,max=500"`
+}
+
+func (m *RecallExtension) Validate() error { return validate.Struct(m) }
+
+// Normalize trims the input.
+func (m *RecallExtension) Normalize() { m.Reason = strings.TrimSpace(m.Reason) }
+
+// RecallClosure is the body of POST /recall/:id/close.
+type RecallClosure struct {
+    Note     string    `json:"note" validate:"required,notblank,max=2000"`
+    ClosedOn time.Time `json:"closed_on"` // defaults to today
+}
+
+func (m *RecallClosure) Validate() error { return validate.Struct(m) }
+
+// Normalize trims the input.
+func (m *RecallClosure) Normalize() { m.Note = strings.TrimSpace(m.Note) }
@@ -1,14 +1,30 @@
 
 package models
 
-import "time"
+import (
+    "strings"
+    "time"
+)
 
 type RouteOfAdmin struct {
-    ID        string     `json:"id" db:"id" validate:"omitempty,uuid4"`
-    Code      string     `json:"code" db:"code" validate:"required,notblank,max=32"`
-    Name      string     `json:"name" db:"name" validate:"required,notblank,max=120"`
-    CreatedAt *time.Time `json:"created_at,omitempty" db:"created_at"`
-    UpdatedAt *time.Time `json:"updated_at,omitempty" db:"updated_at"`
+    ID        string        `json:"id" db:"id" validate:"omitempty,uuid4"`
+    Code      string        `json:"code" db:"code" validate:"required,notblank,max=32"`
+    Name      string        `json:"name" db:"name"` // Names[DefaultLocale], set by Normalize
+    Names     LocalizedName `json:"names" db:"names" validate:"default_locale,dive,keys,oneof=en ar fr,endkeys,notblank,max=120"`
+    CreatedAt *time.Time    `json:"created_at,omitempty" db:"created_at"`
+    UpdatedAt *time.Time    `json:"updated_at,omitempty" db:"updated_at"`
 }
 
 func (m *RouteOfAdmin) Validate() error { return validate.Struct(m) }
+
+// Normalize trims the input and upper-cases the code, as stored.
+func (m *RouteOfAdmin) Normalize() {
+    m.Code = strings.ToUpper(strings.TrimSpace(m.Code))
+    normalizeName(&m.Name, &m.Names)
+}
+
+// Localize sets Name to the name in lang, falling back to DefaultLocale.
+func (m *RouteOfAdmin) Localize(lang string) { m.Name = m.Names.Get(lang) }
+
+// PreparePatch drops Names[DefaultLocale], which Normalize refills from Name.
+func (m *RouteOfAdmin) PreparePatch() { m.Names = m.Names.withoutDefault() }
@@ -0,0 +1,53 @@
+package models
+
+import "strings"
+
+// SearchType is the kind of entity a search hit points to.
+type SearchType string
+const (
+    SearchDrug         SearchType = "drug"
+    SearchAPI          SearchType = "api"
+    SearchAuthHolder   SearchType = "auth_holder"
+    SearchRegistration SearchType = "registration"
+)
+
+// SearchTypes lists every SearchType, in the order ties are broken.
+var SearchTypes = []SearchType{SearchDrug, SearchAPI, SearchAuthHolder, SearchRegistration}
+
+// SearchParams are the query of GET /search. Types restricts the hits to
+// some entity types (repeat ?type=); empty means all of them.
+type SearchParams struct {
+    Q     string       `form:"q" json:"q" validate:"required,notblank,min=2,max=200"`
+    Types []SearchType `form:"type" json:"type,omitempty" validate:"omitempty,max=4,unique,dive,oneof=drug api auth_holder registration"`
+    Limit int          `form:"limit" json:"limit" validate:"omitempty,min=1,max=100"`
+}
+
+func (m *SearchParams) Validate() error { return validate.Struct(m) }
+
+// Normalize trims the query and lower-cases the types.
+func (m *SearchParams) Normalize() {
+    m.Q = strings.TrimSpace(m.Q)
+    for i, t := range m.Types {
+        m.Types[i] = SearchType(strings.ToLower(strings.TrimSpace(string(t))))
+    }
+}
+
+// SearchHit is one matching entity. Field names what matched (brand_name,
+// name or registration_number) and Highlight is that text with the matched
+// words wrapped in <mark></mark>. Score orders the hits: full-text matches
+// score above 1, fuzzy (trigram) matches below.
+type SearchHit struct {
+    Type      SearchType `json:"type" db:"type"`
+    ID        string     `json:"id" db:"id"`
+    Title     string     `json:"title" db:"title"`
+    Field     string     `json:"field" db:"field"`
+    Highlight string     `json:"highlight" db:"highlight"`
+    Score     float64    `json:"score" db:"score"`
+    Href      string     `json:"href,omitempty" db:"-"` // set by the HTTP handler
+}
+
+// SearchResult is the response of GET /search, best hit first.
+type SearchResult struct {
+    Query string      `json:"query"`
+    Hits  []SearchHit `json:"hits"`
+}
@@ -0,0 +1,157 @@
+package models
+
+import (
+    "strings"
+    "time"
+)
+
+// SerialStatus is where a serialized pack is, after the latest event that
+// moved it.
+type SerialStatus string
+const (
+    SerialCommissioned   SerialStatus = "commissioned"
+    SerialShipped        SerialStatus = "shipped"
+    SerialReceived       SerialStatus = "received"
+    SerialDispensed      SerialStatus = "dispensed"
+    SerialDecommissioned SerialStatus = "decommissioned"
+)
+
+// SerialEventType is an EPCIS-style business step on a pack.
+type SerialEventType string
+const (
+    EventCommission   SerialEventType = "commission"
+    EventShip         SerialEventType = "ship"
+    EventReceive      SerialEventType = "receive"
+    EventDispense     SerialEventType = "dispense"
+    EventDecommission SerialEventType = "decommission"
+)
+
+// serialMoves are the events each status allows and where they lead.
+// Dispensed and decommissioned packs are out of the supply chain.
+var serialMoves = map[SerialStatus]map[SerialEventType]SerialStatus{
+    SerialCommissioned: {EventShip: SerialShipped, EventDispense: SerialDispensed, EventDecommission: SerialDecommissioned},
+    SerialShipped:      {EventReceive: SerialReceived, EventDecommission: SerialDecommissioned},
+    SerialReceived:     {EventShip: SerialShipped, EventDispense: SerialDispensed, EventDecommission: SerialDecommissioned},
+}
+
+// Apply is the status after event e, or the anomaly e is from s: a
+// second dispense ("already_dispensed", the mark of a cloned serial),
+// any event after decommissioning ("decommissioned"), or an event out of
+// order ("out_of_sequence").
+func (s SerialStatus) Apply(e SerialEventType) (next SerialStatus, anomaly string) {
+    if next, ok := serialMoves[s][e]; ok {
+        return next, ""
+    }
+    switch {
+    case s == SerialDispensed && e == EventDispense:
+        return s, "already_dispensed"
+    case s == SerialDecommissioned:
+        return s, "decommissioned"
+    default:
+        return s, "out_of_sequence"
+    }
+}
+
+// Serial is one pack of a batch, identified by its GTIN and serial
+// number, as printed in its DataMatrix. The GTIN is that of the batch's
+// presentation when the serial was commissioned; serial numbers are
+// unique per GTIN.
+type Serial struct {
+    ID        string       `json:"id" db:"id"`
+    BatchID   string       `json:"batch_id" db:"batch_id"`
+    GTIN      string       `json:"gtin" db:"gtin"`
+    Serial    string       `json:"serial" db:"serial"`
+    Status    SerialStatus `json:"status" db:"status"`
+    CreatedAt *time.Time   `json:"created_at,omitempty" db:"created_at"`
+    UpdatedAt *time.Time   `json:"updated_at,omitempty" db:"updated_at"`
+}
+
+// SerialUpload is the body of POST /batch/:id/serials: serial numbers to
+// commission in the batch, all at Location (a GLN or site name), at
+// OccurredAt or now.
+type SerialUpload struct {
+    Serials    []string  `json:"serials" validate:"required,min=1,max=10000,unique,dive,required,max=20,gs1_chars"`
+    Location   string    `json:"location" validate:"required,notblank,max=100"`
+    OccurredAt time.Time `json:"occurred_at"`
+}
+
+func (m *SerialUpload) Validate() error { return validate.Struct(m) }
+
+// Normalize trims the input.
+func (m *SerialUpload) Normalize() {
+    for i, s := range m.Serials {
+        m.Serials[i] = strings.TrimSpace(s)
+    }
+    m.Location = strings.TrimSpace(m.Location)
+}
+
+// SerialUploadResult reports a commissioned upload.
+type SerialUploadResult struct {
+    BatchID      string `json:"batch_id"`
+    GTIN         string `json:"gtin"`
+    Commissioned int    `json:"commissioned"`
+}
+
+// SerialEvent is one step in a pack's chain of custody: who (Actor, the
+// token subject) did what (Type) where (Location) and when. Packs are
+// commissioned by upload; the other events are reported one by one. An
+// event the pack's status does not allow is still recorded, as evidence,
+// with its Anomaly, and leaves the status as it was.
+type SerialEvent struct {
+    ID         string          `json:"id" db:"id"`
+    SerialID   string          `json:"serial_id" db:"serial_id"`
+    GTIN       string          `json:"gtin" db:"gtin" validate:"required,gtin"`
+    Serial     string          `json:"serial" db:"serial" validate:"required,max=20"`
+    Type       SerialEventType `json:"type" db:"event_type" validate:"required,oneof=ship receive dispense decommission"`
+    Location   string          `json:"location" db:"location" validate:"required,notblank,max=100"`
+    Reason     string          `json:"reason,omitempty" db:"reason" validate:"omitempty,max=500"` // why a pack was decommissioned
+    Actor      string          `json:"actor" db:"actor"`
+    OccurredAt time.Time       `json:"occurred_at" db:"occurred_at"` // defaults to now
+    RecordedAt *time.Time      `json:"recorded_at,omitempty" db:"recorded_at"`
+    Anomaly    string          `json:"anomaly,omitempty" db:"anomaly"`
+}
+
+func (m *SerialEvent) Validate() error { return validate.Struct(m) }
+
+// Normalize trims the input and pads the GTIN to 14 digits.
+func (m *SerialEvent) Normalize() {
+    m.GTIN = NormalizeGTIN(m.GTIN)
+    m.Serial = strings.TrimSpace(m.Serial)
+    m.Type = SerialEventType(strings.ToLower(strings.TrimSpace(string(m.Type))))
+    m.Location = strings.TrimSpace(m.Location)
+    m.Reason = strings.TrimSpace(m.Reason)
+}
+
+// SerialKey names a pack by GTIN and serial number, as in GET
+// /serial/custody?gtin=&serial=.
+type SerialKey struct {
+    GTIN   string `form:"gtin" validate:"required,gtin"`
+    Serial string `form:"serial" validate:"required,max=20"`
+}
+
+func (m *SerialKey) Validate() error { return validate.Struct(m) }
+
+// Normalize trims the input and pads the GTIN to 14 digits.
+func (m *SerialKey) Normalize() {
+    m.GTIN = NormalizeGTIN(m.GTIN)
+    m.Serial = strings.TrimSpace(m.Serial)
+}
+
+// SerialCustody is a pack's chain of custody: the serial and every event
+// recorded for it, in the order they occurred.
+type SerialCustody struct {
+    Serial Serial        `json:"serial"`
+    Events []SerialEvent `json:"events"`
+}
+
+// SerialClone is a serial dispensed more than once: the same code on
+// several packs, at least one of them counterfeit.
+type SerialClone struct {
+    SerialID         string    `json:"serial_id" db:"id"`
+    BatchID          string    `json:"batch_id" db:"batch_id"`
+    GTIN             string    `json:"gtin" db:"gtin"`
+    Serial           string    `json:"serial" db:"serial"`
+    Dispenses        int64     `json:"dispenses" db:"dispenses"`
+    FirstDispensedAt time.Time `json:"first_dispensed_at" db:"first_dispensed_at"`
+    LastDispensedAt  time.Time `json:"last_dispensed_at" db:"last_dispensed_at"`
+}
@@ -0,0 +1,69 @@
+package models
+
+import (
+    "strings"
+    "time"
+)
+
+// StockMovementType is what a movement did with units of a batch.
+type StockMovementType string
+const (
+    MovementRelease      StockMovementType = "release"      // released to the market
+    MovementDistribution StockMovementType = "distribution" // shipped to a distributor
+    MovementReturn       StockMovementType = "return"       // returned into stock
+    MovementDestruction  StockMovementType = "destruction"  // destroyed, e.g. after a recall
+)
+
+// Inbound reports whether t adds units to the batch's balance.
+func (t StockMovementType) Inbound() bool { return t == MovementReturn }
+
+// Issued is how many units m put on the market net of returns, the units
+// that may come back. Destroyed units never can.
+func (m StockMovement) Issued() int64 {
+    switch m.Type {
+    case MovementRelease, MovementDistribution:
+        return m.Quantity
+    case MovementReturn:
+        return -m.Quantity
+    }
+    return 0
+}
+
+// StockMovement is one entry of a batch's stock ledger, the body of POST
+// /batch/:id/stock. Entries are never edited: a mistake is corrected by a
+// further movement. BalanceAfter is the batch's remaining quantity once
+// the movement is applied, and BatchStatus its status: sold_out when the
+// movement used the last units up, released again when a return restocked
+// a sold-out batch.
+type StockMovement struct {
+    ID           string            `json:"id" db:"id"`
+    BatchID      string            `json:"batch_id" db:"batch_id"`
+    Type         StockMovementType `json:"type" db:"movement_type" validate:"required,oneof=release distribution return destruction"`
+    Quantity     int64             `json:"quantity" db:"quantity" validate:"required,gt=0"`
+    Reference    string            `json:"reference,omitempty" db:"reference" validate:"omitempty,max=100"` // invoice, delivery note or destruction certificate
+    Counterparty string            `json:"counterparty,omitempty" db:"counterparty" validate:"omitempty,max=200"`
+    Note         string            `json:"note,omitempty" db:"note" validate:"omitempty,max=500"`
+    BalanceAfter int64             `json:"balance_after" db:"balance_after"`
+    BatchStatus  BatchStatus       `json:"batch_status,omitempty" db:"-"`
+    CreatedBy    string            `json:"created_by" db:"created_by"`
+    CreatedAt    *time.Time        `json:"created_at,omitempty" db:"created_at"`
+}
+
+func (m *StockMovement) Validate() error { return validate.Struct(m) }
+
+// Normalize trims the input.
+func (m *StockMovement) Normalize() {
+    m.Type = StockMovementType(strings.ToLower(strings.TrimSpace(string(m.Type))))
+    m.Reference = strings.TrimSpace(m.Reference)
+    m.Counterparty = strings.TrimSpace(m.Counterparty)
+    m.Note = strings.TrimSpace(m.Note)
+}
+
+// StockLedger is a batch's stock: the quantity made, what remains, and
+// every movement in between, oldest first.
+type StockLedger struct {
+    BatchID      string          `json:"batch_id"`
+    QtyInBatch   int64           `json:"qty_in_batch"`
+    QtyRemaining int64           `json:"qty_remaining"`
+    Movements    []StockMovement `json:"movements"`
+}
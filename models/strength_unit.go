@@ -1,14 +1,60 @@
 
 package models
 
-import "time"
+import (
+    "strings"
+    "time"
+)
 
+// UnitDimension is what a strength unit measures. Units of the same
+// dimension convert into each other through their Factor; international
+// units are substance-specific and convert only into other IU multiples.
+type UnitDimension string
+const (
+    DimensionMass     UnitDimension = "mass"     // base unit g
+    DimensionVolume   UnitDimension = "volume"   // base unit mL
+    DimensionActivity UnitDimension = "activity" // base unit IU
+    DimensionAmount   UnitDimension = "amount"   // base unit mol
+)
+
+// StrengthUnit is a unit strengths are entered in. Factor is the size of
+// one unit in the base unit of its Dimension (mg: mass, 0.001); units
+// without a dimension, such as %, cannot be converted.
 type StrengthUnit struct {
-    ID        string     `json:"id" db:"id" validate:"omitempty,uuid4"`
-    Code      string     `json:"code" db:"code" validate:"required,notblank,max=32"`
-    Name      string     `json:"name" db:"name" validate:"required,notblank,max=120"`
-    CreatedAt *time.Time `json:"created_at,omitempty" db:"created_at"`
-    UpdatedAt *time.Time `json:"updated_at,omitempty" db:"updated_at"`
+    ID        string        `json:"id" db:"id" validate:"omitempty,uuid4"`
+    Code      string        `json:"code" db:"code" validate:"required,notblank,max=32"`
+    Name      string        `json:"name" db:"name"` // Names[DefaultLocale], set by Normalize
+    Names     LocalizedName `json:"names" db:"names" validate:"default_locale,dive,keys,oneof=en ar fr,endkeys,notblank,max=120"`
+    Dimension UnitDimension `json:"dimension,omitempty" db:"dimension" validate:"omitempty,oneof=mass volume activity amount"`
+    Factor    *Decimal      `json:"factor,omitempty" db:"factor" validate:"omitempty,gt=0"`
+    CreatedAt *time.Time    `json:"created_at,omitempty" db:"created_at"`
+    UpdatedAt *time.Time    `json:"updated_at,omitempty" db:"updated_at"`
 }
 
 func (m *StrengthUnit) Validate() error { return validate.Struct(m) }
+
+// Normalize trims the input and upper-cases the code, as stored.
+func (m *StrengthUnit) Normalize() {
+    m.Code = strings.ToUpper(strings.TrimSpace(m.Code))
+    m.Dimension = UnitDimension(strings.ToLower(strings.TrimSpace(string(m.Dimension))))
+    normalizeName(&m.Name, &m.Names)
+}
+
+// Localize sets Name to the name in lang, falling back to DefaultLocale.
+func (m *StrengthUnit) Localize(lang string) { m.Name = m.Names.Get(lang) }
+
+// PreparePatch drops Names[DefaultLocale], which Normalize refills from Name.
+func (m *StrengthUnit) PreparePatch() { m.Names = m.Names.withoutDefault() }
+
+// UnitConversion is the query and response of GET /strength/convert:
+// Value in unit From, and Result, the same quantity in unit To.
+type UnitConversion struct {
+    Value    Decimal `form:"value" json:"value" validate:"required"`
+    From     string  `form:"from" json:"from" validate:"required,uuid4"`
+    To       string  `form:"to" json:"to" validate:"required,uuid4"`
+    FromCode string  `json:"from_code"`
+    ToCode   string  `json:"to_code"`
+    Result   Decimal `json:"result"`
+}
+
+func (m *UnitConversion) Validate() error { return validate.Struct(m) }
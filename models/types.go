@@ -27,3 +27,69 @@ const (
     BatchSoldOut  BatchStatus = "sold_out"
     BatchInactive BatchStatus = "inactive"
 )
+
+// batchTransitions lists the statuses each batch status may move to.
+// recalled and inactive are final apart from retiring a recalled batch.
+// A sold-out batch goes back to released when returns restock it.
+var batchTransitions = map[BatchStatus][]BatchStatus{
+    BatchPlanned:  {BatchReleased, BatchOnHold, BatchInactive},
+    BatchReleased: {BatchOnHold, BatchRecalled, BatchExpired, BatchSoldOut, BatchInactive},
+    BatchOnHold:   {BatchReleased, BatchRecalled, BatchExpired, BatchInactive},
+    BatchExpired:  {BatchRecalled, BatchInactive},
+    BatchSoldOut:  {BatchReleased, BatchRecalled, BatchExpired, BatchInactive},
+    BatchRecalled: {BatchInactive},
+    BatchInactive: {},
+}
+
+// CanTransitionTo reports whether a batch in status s may move to to.
+func (s BatchStatus) CanTransitionTo(to BatchStatus) bool {
+    for _, next := range batchTransitions[s] {
+        if next == to {
+            return true
+        }
+    }
+    return false
+}
+
+// IsInitial reports whether a new batch may be created in status s.
+func (s BatchStatus) IsInitial() bool { return s == BatchPlanned || s == BatchReleased }
+
+// BatchStatusesInto returns every status from which a batch may move to to.
+func BatchStatusesInto(to BatchStatus) []BatchStatus {
+    var out []BatchStatus
+    for from := range batchTransitions {
+        if from.CanTransitionTo(to) {
+            out = append(out, from)
+        }
+    }
+    return out
+}
+
+type RecallClass string
+const (
+    RecallClassI   RecallClass = "I"   // reasonable probability of serious harm or death
+    RecallClassII  RecallClass = "II"  // may cause temporary or reversible harm
+    RecallClassIII RecallClass = "III" // unlikely to cause harm
+)
+
+// RecallLevel is how far down the supply chain a recall reaches.
+type RecallLevel string
+const (
+    RecallWholesale RecallLevel = "wholesale"
+    RecallRetail    RecallLevel = "retail"
+    RecallPatient   RecallLevel = "patient"
+)
+
+// RecallInitiator is the party that started a recall.
+type RecallInitiator string
+const (
+    RecallByManufacturer RecallInitiator = "manufacturer"
+    RecallByAuthHolder   RecallInitiator = "auth_holder"
+    RecallByRegulator    RecallInitiator = "regulator"
+)
+
+type RecallStatus string
+const (
+    RecallOpen   RecallStatus = "open"
+    RecallClosed RecallStatus = "closed"
+)
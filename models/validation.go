@@ -3,69 +3,109 @@ package models
 import (
 	"encoding/json"
 	"fmt"
+	"reflect"
 	"regexp"
+	"strconv"
 	"strings"
 	"time"
 
+	"moh/shared"
+
 	"github.com/go-playground/validator/v10"
 )
 
 // Shared validator instance
 var validate = validator.New()
 
-// FirstError returns first validation error in a friendly format.
-func FirstError(err error) (string, bool) {
-	if err == nil {
-		return "", true
-	}
+// FieldErrors lists every failed rule in err, the result of a Validate
+// method, by JSON path, e.g. "expire_date" or "registration.drug_id".
+func FieldErrors(err error) []shared.FieldError {
 	verrs, ok := err.(validator.ValidationErrors)
-	if !ok || len(verrs) == 0 {
-		return err.Error(), false
+	if !ok {
+		return nil
+	}
+	out := make([]shared.FieldError, len(verrs))
+	for i, fe := range verrs {
+		out[i] = shared.NewFieldError(fieldPath(fe), fe.Tag(), fe.Param(), messageKey(fe))
+	}
+	return out
+}
+
+// ValidationError turns the error of a Validate method into a
+// shared.Validation error reporting every failing field.
+func ValidationError(err error) error {
+	fields := FieldErrors(err)
+	if len(fields) == 0 {
+		// Not a rule failure: validate was handed something it cannot check.
+		return shared.Internal(err)
 	}
-	fe := verrs[0]
-
-	switch fe.Tag() {
-	case "required":
-		return fmt.Sprintf("%s is required", fe.Field()), false
-	case "min":
-		return fmt.Sprintf("%s must be at least %s characters", fe.Field(), fe.Param()), false
-	case "max":
-		return fmt.Sprintf("%s must be at most %s characters", fe.Field(), fe.Param()), false
-	case "email":
-		return "Invalid email format", false
-	case "alpha":
-		return fmt.Sprintf("%s must contain only letters (no spaces)", fe.Field()), false
-	case "alphanum":
-		return fmt.Sprintf("%s must be alphanumeric (no spaces)", fe.Field()), false
-	case "alpha_space":
-		return fmt.Sprintf("%s must contain only letters and spaces", fe.Field()), false
-	case "alphanum_space":
-		return fmt.Sprintf("%s must be letters, numbers, and spaces", fe.Field()), false
-	case "required_with":
-		return fmt.Sprintf("%s is required when %s is present", fe.Field(), fe.Param()), false
-	case "required_without":
-		return fmt.Sprintf("%s is required when %s is missing", fe.Field()), false
-	case "email_domain":
-		return "Email must be a @school.edu address", false
-	case "uuid", "uuid4", "uuid_opt":
-		return fmt.Sprintf("%s must be a valid UUID", fe.Field()), false
-	case "json":
-		return fmt.Sprintf("%s must be valid JSON", fe.Field()), false
-	case "oneof":
-		return fmt.Sprintf("%s must be one of %s", fe.Field(), fe.Param()), false
-	case "gt", "gte", "lt", "lte":
-		return fmt.Sprintf("%s must satisfy %s %s", fe.Field(), fe.Tag(), fe.Param()), false
-	case "gt_mfg":
-		return "ExpireDate must be after MfgDate", false
-	case "gt_valid_from":
-		return "ValidTo must be after ValidFrom", false
+	return shared.Validation(fields...)
+}
+
+// QueryError is ValidationError for the options of a list request, which
+// are reported as a bad query rather than invalid input.
+func QueryError(err error) error {
+	e := shared.As(ValidationError(err))
+	if e.Kind == shared.KindValidation {
+		e.Kind, e.Code = shared.KindBadRequest, "invalid_query"
+	}
+	return e
+}
+
+// fieldPath is the namespace of fe without the top-level type name.
+func fieldPath(fe validator.FieldError) string {
+	ns := fe.Namespace()
+	if _, rest, ok := strings.Cut(ns, "."); ok {
+		return rest
+	}
+	return ns
+}
+
+// messageKey is the shared catalog entry for fe's message: its tag, with
+// ".string" for length rules on strings, or "invalid" for tags the catalog
+// has no message for.
+func messageKey(fe validator.FieldError) string {
+	switch tag := fe.Tag(); tag {
+	case "len", "min", "max":
+		if fe.Kind() == reflect.String {
+			return tag + ".string"
+		}
+		return tag
+	case "uuid4", "uuid_opt":
+		return "uuid"
 	default:
-		return fmt.Sprintf("%s is invalid", fe.Field()), false
+		if shared.HasMessage(tag) {
+			return tag
+		}
+		return "invalid"
+	}
+}
+
+// jsonName names struct fields in validation errors as clients see them:
+// by JSON name, or by query parameter name for filters.
+func jsonName(f reflect.StructField) string {
+	for _, tag := range []string{"json", "form"} {
+		name, _, _ := strings.Cut(f.Tag.Get(tag), ",")
+		if name == "-" {
+			return ""
+		}
+		if name != "" {
+			return name
+		}
 	}
+	return f.Name
 }
 
 // Register custom field and struct validations.
 func init() {
+	validate.RegisterTagNameFunc(jsonName)
+
+	// Decimals meet the numeric rules (required, gt, gte, ...) as float64;
+	// only their sign and size matter to those.
+	validate.RegisterCustomTypeFunc(func(v reflect.Value) any {
+		return v.Interface().(Decimal).Float64()
+	}, Decimal{})
+
 	// notblank: trims spaces before checking
 	_ = validate.RegisterValidation("notblank", func(fl validator.FieldLevel) bool {
 		s, ok := fl.Field().Interface().(string)
@@ -148,6 +188,44 @@ func init() {
 		}
 	})
 
+	// gtin: a GTIN-8, -12, -13 or -14 with a correct GS1 check digit
+	_ = validate.RegisterValidation("gtin", func(fl validator.FieldLevel) bool {
+		s, ok := fl.Field().Interface().(string)
+		if !ok {
+			return true
+		}
+		return ValidGTIN(s)
+	})
+
+	// gs1_chars: GS1 character set 82, as serial numbers are printed in
+	_ = validate.RegisterValidation("gs1_chars", func(fl validator.FieldLevel) bool {
+		s, ok := fl.Field().Interface().(string)
+		if !ok {
+			return true
+		}
+		return CSET82(s)
+	})
+
+	// local_code: letters and digits in hyphen-separated groups, as in
+	// NDC-style package codes (12345-678-90)
+	localCode := regexp.MustCompile(`^[0-9A-Z]+(?:-[0-9A-Z]+)*$`)
+	_ = validate.RegisterValidation("local_code", func(fl validator.FieldLevel) bool {
+		s, ok := fl.Field().Interface().(string)
+		if !ok || s == "" {
+			return true
+		}
+		return localCode.MatchString(s)
+	})
+
+	// default_locale: a translatable name has a value in DefaultLocale
+	_ = validate.RegisterValidation("default_locale", func(fl validator.FieldLevel) bool {
+		names, ok := fl.Field().Interface().(LocalizedName)
+		if !ok {
+			return true
+		}
+		return strings.TrimSpace(names[DefaultLocale]) != ""
+	})
+
 	// -------- Struct-level checks --------
 	validate.RegisterStructValidation(func(sl validator.StructLevel) {
 		b, ok := sl.Current().Interface().(Batch)
@@ -156,11 +234,41 @@ func init() {
 		}
 		if !b.MfgDate.IsZero() && !b.ExpireDate.IsZero() {
 			if !b.ExpireDate.After(b.MfgDate) {
-				sl.ReportError(b.ExpireDate, "ExpireDate", "ExpireDate", "gt_mfg", "")
+				sl.ReportError(b.ExpireDate, "expire_date", "ExpireDate", "gt_mfg", "mfg_date")
 			}
 		}
+		if b.Price.Scale() > MaxPriceScale && b.Price.Reduced().Scale() > MaxPriceScale {
+			sl.ReportError(b.Price, "price", "Price", "max_scale", strconv.Itoa(MaxPriceScale))
+		}
+		if !b.Price.IsZero() && b.Currency == "" {
+			sl.ReportError(b.Currency, "currency", "Currency", "required_with", "price")
+		}
 	}, Batch{})
 
+	validate.RegisterStructValidation(func(sl validator.StructLevel) {
+		p, ok := sl.Current().Interface().(DrugPrice)
+		if !ok {
+			return
+		}
+		if p.MaxPrice.Reduced().Scale() > MaxPriceScale {
+			sl.ReportError(p.MaxPrice, "max_price", "MaxPrice", "max_scale", strconv.Itoa(MaxPriceScale))
+		}
+	}, DrugPrice{})
+
+	// A convertible unit has both a dimension and a factor.
+	validate.RegisterStructValidation(func(sl validator.StructLevel) {
+		u, ok := sl.Current().Interface().(StrengthUnit)
+		if !ok {
+			return
+		}
+		if u.Dimension != "" && u.Factor == nil {
+			sl.ReportError(u.Factor, "factor", "Factor", "required_with", "dimension")
+		}
+		if u.Dimension == "" && u.Factor != nil {
+			sl.ReportError(u.Dimension, "dimension", "Dimension", "required_with", "factor")
+		}
+	}, StrengthUnit{})
+
 	validate.RegisterStructValidation(func(sl validator.StructLevel) {
 		dr, ok := sl.Current().Interface().(DrugRegistration)
 		if !ok {
@@ -168,10 +276,57 @@ func init() {
 		}
 		if !dr.ValidFrom.IsZero() && !dr.ValidTo.IsZero() {
 			if !dr.ValidTo.After(dr.ValidFrom) {
-				sl.ReportError(dr.ValidTo, "ValidTo", "ValidTo", "gt_valid_from", "")
+				sl.ReportError(dr.ValidTo, "valid_to", "ValidTo", "gt_valid_from", "valid_from")
 			}
 		}
 	}, DrugRegistration{})
+
+	// A denominator needs both its quantity and its unit, and a strength
+	// stated as the salt needs the salt's name.
+	validate.RegisterStructValidation(func(sl validator.StructLevel) {
+		di, ok := sl.Current().Interface().(DrugIngredient)
+		if !ok {
+			return
+		}
+		if di.PerQuantity != nil && di.PerUnitID == "" {
+			sl.ReportError(di.PerUnitID, "per_unit_id", "PerUnitID", "required_with", "per_quantity")
+		}
+		if di.PerQuantity == nil && di.PerUnitID != "" {
+			sl.ReportError(di.PerQuantity, "per_quantity", "PerQuantity", "required_with", "per_unit_id")
+		}
+		if di.Basis == BasisSalt && di.Salt == "" {
+			sl.ReportError(di.Salt, "salt", "Salt", "required_for_basis", string(BasisSalt))
+		}
+	}, DrugIngredient{})
+
+	// A bundle's links get drug_registration_id from the new registration.
+	validate.RegisterStructValidation(func(sl validator.StructLevel) {
+		b, ok := sl.Current().Interface().(DrugRegistrationBundle)
+		if !ok {
+			return
+		}
+		for i, s := range b.Sites {
+			if s.DrugRegistrationID != "" {
+				sl.ReportError(s.DrugRegistrationID, fmt.Sprintf("sites[%d].drug_registration_id", i), "DrugRegistrationID", "excluded", "")
+			}
+		}
+		for i, h := range b.AuthHolders {
+			if h.DrugRegistrationID != "" {
+				sl.ReportError(h.DrugRegistrationID, fmt.Sprintf("auth_holders[%d].drug_registration_id", i), "DrugRegistrationID", "excluded", "")
+			}
+		}
+	}, DrugRegistrationBundle{})
+
+	// A decommissioned pack says why it left the supply chain.
+	validate.RegisterStructValidation(func(sl validator.StructLevel) {
+		e, ok := sl.Current().Interface().(SerialEvent)
+		if !ok {
+			return
+		}
+		if e.Type == EventDecommission && e.Reason == "" {
+			sl.ReportError(e.Reason, "reason", "Reason", "required_for_event", string(EventDecommission))
+		}
+	}, SerialEvent{})
 }
 
 // Utility helpers
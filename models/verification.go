@@ -0,0 +1,58 @@
+package models
+
+import (
+    "strings"
+    "time"
+
+    "moh/shared"
+)
+
+// VerifyRequest is the body of POST /verify: the GS1 element string read
+// from a pack's barcode, as scanned or in its parenthesised form.
+type VerifyRequest struct {
+    Payload string `json:"payload" validate:"required,max=512"`
+}
+
+func (m *VerifyRequest) Validate() error { return validate.Struct(m) }
+
+// Normalize trims the input.
+func (m *VerifyRequest) Normalize() { m.Payload = strings.TrimSpace(m.Payload) }
+
+// VerifyResult is the verdict on a scanned pack.
+type VerifyResult string
+const (
+    VerifyGenuine  VerifyResult = "genuine"  // a registered batch, released, in date
+    VerifyRecalled VerifyResult = "recalled" // a registered batch under recall
+    VerifyExpired  VerifyResult = "expired"  // a registered batch past its expiry
+    VerifyUnknown  VerifyResult = "unknown"  // not a batch the registry can vouch for
+)
+
+// VerifyReason is one finding behind a Verification. Code is stable;
+// Message is the catalog message for it, in the client's language.
+type VerifyReason struct {
+    Code    string   `json:"code"`
+    Message string   `json:"message"`
+    Args    []string `json:"-"`
+}
+
+// NewVerifyReason is the reason code, with its English message.
+func NewVerifyReason(code string, args ...string) VerifyReason {
+    return VerifyReason{Code: code, Message: shared.Message(shared.English, code, args...), Args: args}
+}
+
+// Verification is the answer of POST /verify: the verdict and its
+// reasons, what was read from the code and, when the registry knows the
+// batch, what it holds for it.
+type Verification struct {
+    Result       VerifyResult   `json:"result"`
+    Reasons      []VerifyReason `json:"reasons"`
+    GTIN         string         `json:"gtin,omitempty"`
+    BatchNumber  string         `json:"batch_number,omitempty"`
+    Expiry       *time.Time     `json:"expiry,omitempty"` // as printed
+    Serial       string         `json:"serial,omitempty"`
+    BrandName    string         `json:"brand_name,omitempty"`
+    Pack         string         `json:"pack,omitempty"` // the presentation's description
+    BatchID      string         `json:"batch_id,omitempty"`
+    ExpireDate   *time.Time     `json:"expire_date,omitempty"` // as registered
+    RecallNumber string         `json:"recall_number,omitempty"`
+}
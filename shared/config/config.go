@@ -1 +1,349 @@
+// Package config loads the service configuration from an optional YAML or
+// TOML file overlaid with MOH_* environment variables.
 package config
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	toml "github.com/pelletier/go-toml/v2"
+	"gopkg.in/yaml.v3"
+)
+
+// Config is the complete service configuration.
+type Config struct {
+	HTTP HTTPConfig `yaml:"http" toml:"http" json:"http"`
+	GRPC GRPCConfig `yaml:"grpc" toml:"grpc" json:"grpc"`
+	DB   DBConfig   `yaml:"db" toml:"db" json:"db"`
+	JWT  JWTConfig  `yaml:"jwt" toml:"jwt" json:"jwt"`
+	CORS CORSConfig `yaml:"cors" toml:"cors" json:"cors"`
+	Log  LogConfig  `yaml:"log" toml:"log" json:"log"`
+
+	Scheduler SchedulerConfig `yaml:"scheduler" toml:"scheduler" json:"scheduler"`
+	Safety    SafetyConfig    `yaml:"safety" toml:"safety" json:"safety"`
+	Pricing   PricingConfig   `yaml:"pricing" toml:"pricing" json:"pricing"`
+}
+
+type HTTPConfig struct {
+	Addr string `yaml:"addr" toml:"addr" json:"addr"`
+}
+
+type GRPCConfig struct {
+	Addr string `yaml:"addr" toml:"addr" json:"addr"`
+}
+
+// DBConfig holds the Postgres DSN and pgxpool tuning.
+type DBConfig struct {
+	DSN               string   `yaml:"dsn" toml:"dsn" json:"dsn"`
+	MinConns          int32    `yaml:"min_conns" toml:"min_conns" json:"min_conns"`
+	MaxConns          int32    `yaml:"max_conns" toml:"max_conns" json:"max_conns"`
+	HealthCheckPeriod Duration `yaml:"health_check_period" toml:"health_check_period" json:"health_check_period"`
+	MaxConnLifetime   Duration `yaml:"max_conn_lifetime" toml:"max_conn_lifetime" json:"max_conn_lifetime"`
+	MaxConnIdleTime   Duration `yaml:"max_conn_idle_time" toml:"max_conn_idle_time" json:"max_conn_idle_time"`
+}
+
+type JWTConfig struct {
+	Issuer   string         `yaml:"issuer" toml:"issuer" json:"issuer,omitempty"`
+	Audience string         `yaml:"audience" toml:"audience" json:"audience,omitempty"`
+	Keys     []JWTKeyConfig `yaml:"keys" toml:"keys" json:"keys"`
+}
+
+// JWTKeyConfig is one verification key. HS256 keys set Secret; RS256/ES256
+// keys set either an inline PEM PublicKey or a PublicKeyFile.
+type JWTKeyConfig struct {
+	ID            string `yaml:"id" toml:"id" json:"id"`
+	Alg           string `yaml:"alg" toml:"alg" json:"alg"`
+	Secret        string `yaml:"secret" toml:"secret" json:"secret,omitempty"`
+	PublicKey     string `yaml:"public_key" toml:"public_key" json:"public_key,omitempty"`
+	PublicKeyFile string `yaml:"public_key_file" toml:"public_key_file" json:"public_key_file,omitempty"`
+}
+
+// Material returns the key in the form middleware.ParseJWTKey expects.
+func (k JWTKeyConfig) Material() string {
+	switch {
+	case k.Secret != "":
+		return k.Secret
+	case k.PublicKey != "":
+		return k.PublicKey
+	default:
+		return "@" + k.PublicKeyFile
+	}
+}
+
+type CORSConfig struct {
+	AllowedOrigins []string `yaml:"allowed_origins" toml:"allowed_origins" json:"allowed_origins"`
+}
+
+type LogConfig struct {
+	Level string `yaml:"level" toml:"level" json:"level"` // debug, info, warn, error
+}
+
+// SchedulerConfig controls the background jobs run by the HTTP server.
+type SchedulerConfig struct {
+	Enabled  bool     `yaml:"enabled" toml:"enabled" json:"enabled"`
+	Interval Duration `yaml:"interval" toml:"interval" json:"interval"`
+}
+
+// SafetyConfig holds the patient-safety checks on registry input.
+// BrandSimilarityThreshold is the look-alike/sound-alike score (0..1) from
+// which adding a drug, over HTTP or gRPC, refuses a brand name unless the
+// request overrides the check; 0 turns the check off.
+type SafetyConfig struct {
+	BrandSimilarityThreshold float64 `yaml:"brand_similarity_threshold" toml:"brand_similarity_threshold" json:"brand_similarity_threshold"`
+}
+
+// PricingConfig holds the maximum price control. CeilingPolicy is what
+// adding or updating a batch does with a price above the ceiling in force:
+// "reject" it, or "flag" it by storing the batch and listing it as a
+// violation.
+type PricingConfig struct {
+	CeilingPolicy string `yaml:"ceiling_policy" toml:"ceiling_policy" json:"ceiling_policy"`
+}
+
+// Duration is a time.Duration written as "30s", "10m" in config files.
+type Duration time.Duration
+
+func (d *Duration) UnmarshalText(b []byte) error {
+	v, err := time.ParseDuration(string(b))
+	if err != nil {
+		return err
+	}
+	*d = Duration(v)
+	return nil
+}
+
+func (d Duration) MarshalText() ([]byte, error) { return []byte(time.Duration(d).String()), nil }
+
+// Defaults returns the configuration used when nothing overrides it.
+func Defaults() Config {
+	return Config{
+		HTTP: HTTPConfig{Addr: ":8001"},
+		GRPC: GRPCConfig{Addr: ":9001"},
+		DB: DBConfig{
+			MinConns:          1,
+			MaxConns:          10,
+			HealthCheckPeriod: Duration(30 * time.Second),
+			MaxConnLifetime:   Duration(30 * time.Minute),
+			MaxConnIdleTime:   Duration(10 * time.Minute),
+		},
+		CORS: CORSConfig{AllowedOrigins: []string{"*"}},
+		Log:  LogConfig{Level: "info"},
+
+		Scheduler: SchedulerConfig{Enabled: true, Interval: Duration(time.Hour)},
+		Safety:    SafetyConfig{BrandSimilarityThreshold: 0.65},
+		Pricing:   PricingConfig{CeilingPolicy: "reject"},
+	}
+}
+
+// Load builds the configuration: defaults, then the file at path (if any,
+// format chosen by .yaml/.yml/.toml extension), then MOH_* environment
+// variables. Callers validate the sections they use: the servers call
+// Config.Validate, tools that only need the database call DBConfig.Validate.
+func Load(path string) (Config, error) {
+	cfg := Defaults()
+	if path != "" {
+		if err := loadFile(path, &cfg); err != nil {
+			return Config{}, err
+		}
+	}
+	if err := applyEnv(&cfg, os.LookupEnv); err != nil {
+		return Config{}, err
+	}
+	return cfg, nil
+}
+
+func loadFile(path string, cfg *Config) error {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("config: %w", err)
+	}
+	switch ext := strings.ToLower(filepath.Ext(path)); ext {
+	case ".yaml", ".yml":
+		dec := yaml.NewDecoder(bytes.NewReader(b))
+		dec.KnownFields(true)
+		if err := dec.Decode(cfg); err != nil && !errors.Is(err, io.EOF) {
+			return fmt.Errorf("config: parse %s: %w", path, err)
+		}
+	case ".toml":
+		dec := toml.NewDecoder(bytes.NewReader(b))
+		dec.DisallowUnknownFields()
+		if err := dec.Decode(cfg); err != nil {
+			return fmt.Errorf("config: parse %s: %w", path, err)
+		}
+	default:
+		return fmt.Errorf("config: unsupported file type %q (want .yaml, .yml or .toml)", ext)
+	}
+	return nil
+}
+
+// Validate reports every problem with the configuration at once.
+func (c Config) Validate() error {
+	return errors.Join(c.HTTP.Validate(), c.GRPC.Validate(), c.DB.Validate(), c.JWT.Validate(), c.CORS.Validate(), c.Log.Validate(), c.Scheduler.Validate(), c.Safety.Validate(), c.Pricing.Validate())
+}
+
+func (c HTTPConfig) Validate() error {
+	if strings.TrimSpace(c.Addr) == "" {
+		return errors.New("http.addr is required")
+	}
+	return nil
+}
+
+func (c GRPCConfig) Validate() error {
+	if strings.TrimSpace(c.Addr) == "" {
+		return errors.New("grpc.addr is required")
+	}
+	return nil
+}
+
+func (c DBConfig) Validate() error {
+	var errs []error
+	if strings.TrimSpace(c.DSN) == "" {
+		errs = append(errs, errors.New("db.dsn is required"))
+	}
+	if c.MaxConns < 1 {
+		errs = append(errs, errors.New("db.max_conns must be at least 1"))
+	}
+	if c.MinConns < 0 || c.MinConns > c.MaxConns {
+		errs = append(errs, errors.New("db.min_conns must be between 0 and db.max_conns"))
+	}
+	if c.HealthCheckPeriod <= 0 || c.MaxConnLifetime <= 0 || c.MaxConnIdleTime <= 0 {
+		errs = append(errs, errors.New("db durations must be positive"))
+	}
+	return errors.Join(errs...)
+}
+
+func (c JWTConfig) Validate() error {
+	if len(c.Keys) == 0 {
+		return errors.New("jwt.keys: at least one key is required")
+	}
+	var errs []error
+	seen := map[string]bool{}
+	for i, k := range c.Keys {
+		if seen[k.ID] {
+			errs = append(errs, fmt.Errorf("jwt.keys[%d]: duplicate id %q", i, k.ID))
+		}
+		seen[k.ID] = true
+
+		set := 0
+		for _, v := range []string{k.Secret, k.PublicKey, k.PublicKeyFile} {
+			if v != "" {
+				set++
+			}
+		}
+		switch strings.ToUpper(k.Alg) {
+		case "HS256":
+			if k.Secret == "" || set != 1 {
+				errs = append(errs, fmt.Errorf("jwt.keys[%d]: HS256 needs secret only", i))
+			} else if len(k.Secret) < 32 {
+				errs = append(errs, fmt.Errorf("jwt.keys[%d]: HS256 secret must be at least 32 bytes", i))
+			}
+		case "RS256", "ES256":
+			if k.Secret != "" || set != 1 {
+				errs = append(errs, fmt.Errorf("jwt.keys[%d]: %s needs exactly one of public_key, public_key_file", i, k.Alg))
+			}
+		default:
+			errs = append(errs, fmt.Errorf("jwt.keys[%d]: alg must be HS256, RS256 or ES256", i))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (c CORSConfig) Validate() error {
+	if len(c.AllowedOrigins) == 0 {
+		return errors.New(`cors.allowed_origins: list at least one origin or "*"`)
+	}
+	var errs []error
+	for _, o := range c.AllowedOrigins {
+		if o == "*" {
+			continue
+		}
+		u, err := url.Parse(o)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" || u.Path != "" {
+			errs = append(errs, fmt.Errorf("cors.allowed_origins: %q is not a scheme://host[:port] origin", o))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (c LogConfig) Validate() error {
+	switch c.Level {
+	case "debug", "info", "warn", "error":
+		return nil
+	}
+	return errors.New("log.level must be debug, info, warn or error")
+}
+
+func (c SchedulerConfig) Validate() error {
+	if c.Interval < Duration(time.Minute) {
+		return errors.New("scheduler.interval must be at least 1m")
+	}
+	return nil
+}
+
+func (c SafetyConfig) Validate() error {
+	if c.BrandSimilarityThreshold < 0 || c.BrandSimilarityThreshold > 1 {
+		return errors.New("safety.brand_similarity_threshold must be between 0 and 1")
+	}
+	return nil
+}
+
+func (c PricingConfig) Validate() error {
+	if c.CeilingPolicy != "reject" && c.CeilingPolicy != "flag" {
+		return errors.New("pricing.ceiling_policy must be reject or flag")
+	}
+	return nil
+}
+
+// ===== Redaction =====
+
+const redacted = "REDACTED"
+
+var kvPassword = regexp.MustCompile(`(?i)(password\s*=\s*)('[^']*'|\S+)`)
+
+// Redacted returns a copy with the DSN password and JWT secrets masked.
+func (c Config) Redacted() Config {
+	out := c
+	out.DB.DSN = redactDSN(c.DB.DSN)
+	out.JWT.Keys = make([]JWTKeyConfig, len(c.JWT.Keys))
+	for i, k := range c.JWT.Keys {
+		if k.Secret != "" {
+			k.Secret = redacted
+		}
+		out.JWT.Keys[i] = k
+	}
+	return out
+}
+
+// String renders the redacted configuration, safe for logs.
+func (c Config) String() string {
+	b, err := json.Marshal(c.Redacted())
+	if err != nil {
+		return "config{unprintable}"
+	}
+	return string(b)
+}
+
+func redactDSN(dsn string) string {
+	if u, err := url.Parse(dsn); err == nil && u.Scheme != "" {
+		if u.User != nil {
+			if _, hasPw := u.User.Password(); hasPw {
+				u.User = url.UserPassword(u.User.Username(), redacted)
+			}
+		}
+		if q := u.Query(); q.Has("password") {
+			q.Set("password", redacted)
+			u.RawQuery = q.Encode()
+		}
+		return u.String()
+	}
+	return kvPassword.ReplaceAllString(dsn, "${1}"+redacted)
+}
@@ -0,0 +1,138 @@
+package config
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Environment variables that override the config file.
+//
+//	MOH_HTTP_ADDR               listen address, e.g. ":8001"
+//	MOH_GRPC_ADDR               gRPC listen address, e.g. ":9001"
+//	MOH_DB_DSN                  Postgres DSN
+//	MOH_DB_MIN_CONNS            pool minimum connections
+//	MOH_DB_MAX_CONNS            pool maximum connections
+//	MOH_DB_HEALTH_CHECK_PERIOD  e.g. "30s"
+//	MOH_DB_MAX_CONN_LIFETIME    e.g. "30m"
+//	MOH_DB_MAX_CONN_IDLE_TIME   e.g. "10m"
+//	MOH_JWT_ISSUER              required "iss" claim
+//	MOH_JWT_AUDIENCE            required "aud" claim
+//	MOH_JWT_KEYS                kid:alg:material[,kid:alg:material...]; material is the
+//	                            HS256 secret, an inline PEM, or @/path/to/key.pem
+//	MOH_CORS_ORIGINS            comma-separated allowed origins, or "*"
+//	MOH_LOG_LEVEL               debug, info, warn or error
+//	MOH_SCHEDULER_ENABLED       true or false
+//	MOH_SCHEDULER_INTERVAL      e.g. "1h"
+//	MOH_SAFETY_BRAND_SIMILARITY_THRESHOLD
+//	                            0..1; 0 turns the look-alike check off
+//	MOH_PRICING_CEILING_POLICY  reject or flag a batch priced above its ceiling
+func applyEnv(cfg *Config, lookup func(string) (string, bool)) error {
+	str := func(name string, dst *string) {
+		if v, ok := lookup(name); ok {
+			*dst = strings.TrimSpace(v)
+		}
+	}
+	i32 := func(name string, dst *int32) error {
+		if v, ok := lookup(name); ok {
+			n, err := strconv.ParseInt(strings.TrimSpace(v), 10, 32)
+			if err != nil {
+				return fmt.Errorf("config: %s: %w", name, err)
+			}
+			*dst = int32(n)
+		}
+		return nil
+	}
+	dur := func(name string, dst *Duration) error {
+		if v, ok := lookup(name); ok {
+			d, err := time.ParseDuration(strings.TrimSpace(v))
+			if err != nil {
+				return fmt.Errorf("config: %s: %w", name, err)
+			}
+			*dst = Duration(d)
+		}
+		return nil
+	}
+
+	str("MOH_HTTP_ADDR", &cfg.HTTP.Addr)
+	str("MOH_GRPC_ADDR", &cfg.GRPC.Addr)
+	str("MOH_DB_DSN", &cfg.DB.DSN)
+	for _, err := range []error{
+		i32("MOH_DB_MIN_CONNS", &cfg.DB.MinConns),
+		i32("MOH_DB_MAX_CONNS", &cfg.DB.MaxConns),
+		dur("MOH_DB_HEALTH_CHECK_PERIOD", &cfg.DB.HealthCheckPeriod),
+		dur("MOH_DB_MAX_CONN_LIFETIME", &cfg.DB.MaxConnLifetime),
+		dur("MOH_DB_MAX_CONN_IDLE_TIME", &cfg.DB.MaxConnIdleTime),
+		dur("MOH_SCHEDULER_INTERVAL", &cfg.Scheduler.Interval),
+	} {
+		if err != nil {
+			return err
+		}
+	}
+	str("MOH_JWT_ISSUER", &cfg.JWT.Issuer)
+	str("MOH_JWT_AUDIENCE", &cfg.JWT.Audience)
+	if v, ok := lookup("MOH_JWT_KEYS"); ok {
+		keys, err := parseJWTKeys(v)
+		if err != nil {
+			return err
+		}
+		cfg.JWT.Keys = keys
+	}
+	if v, ok := lookup("MOH_CORS_ORIGINS"); ok {
+		cfg.CORS.AllowedOrigins = splitList(v)
+	}
+	str("MOH_LOG_LEVEL", &cfg.Log.Level)
+	cfg.Log.Level = strings.ToLower(cfg.Log.Level)
+	if v, ok := lookup("MOH_SCHEDULER_ENABLED"); ok {
+		b, err := strconv.ParseBool(strings.TrimSpace(v))
+		if err != nil {
+			return fmt.Errorf("config: MOH_SCHEDULER_ENABLED: %w", err)
+		}
+		cfg.Scheduler.Enabled = b
+	}
+	if v, ok := lookup("MOH_SAFETY_BRAND_SIMILARITY_THRESHOLD"); ok {
+		f, err := strconv.ParseFloat(strings.TrimSpace(v), 64)
+		if err != nil {
+			return fmt.Errorf("config: MOH_SAFETY_BRAND_SIMILARITY_THRESHOLD: %w", err)
+		}
+		cfg.Safety.BrandSimilarityThreshold = f
+	}
+	if v, ok := lookup("MOH_PRICING_CEILING_POLICY"); ok {
+		cfg.Pricing.CeilingPolicy = strings.ToLower(strings.TrimSpace(v))
+	}
+	return nil
+}
+
+// parseJWTKeys reads the MOH_JWT_KEYS format, e.g.
+// "2025-01:HS256:<secret>,2025-06:RS256:@/etc/moh/jwt.pub".
+func parseJWTKeys(spec string) ([]JWTKeyConfig, error) {
+	var keys []JWTKeyConfig
+	for _, entry := range splitList(spec) {
+		parts := strings.SplitN(entry, ":", 3)
+		if len(parts) != 3 {
+			return nil, fmt.Errorf("config: MOH_JWT_KEYS entry must be kid:alg:material")
+		}
+		k := JWTKeyConfig{ID: parts[0], Alg: strings.ToUpper(parts[1])}
+		switch material := parts[2]; {
+		case k.Alg == "HS256":
+			k.Secret = material
+		case strings.HasPrefix(material, "@"):
+			k.PublicKeyFile = strings.TrimPrefix(material, "@")
+		default:
+			k.PublicKey = material
+		}
+		keys = append(keys, k)
+	}
+	return keys, nil
+}
+
+func splitList(s string) []string {
+	var out []string
+	for _, p := range strings.Split(s, ",") {
+		if p = strings.TrimSpace(p); p != "" {
+			out = append(out, p)
+		}
+	}
+	return out
+}
@@ -0,0 +1,301 @@
+// shared/db/migrate.go
+package db
+
+import (
+	"context"
+	"crypto/sha256"
+	"embed"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+//go:embed migrations/*.sql
+var migrationFS embed.FS
+
+// migrationLockID is the pg_advisory_lock key held while migrations run,
+// so concurrent deploys never apply the same version twice.
+const migrationLockID int64 = 0x6d6f685f6d6967 // "moh_mig"
+
+// Migration is one versioned schema change loaded from migrations/.
+type Migration struct {
+	Version  int64
+	Name     string
+	Up       string
+	Down     string
+	Checksum string
+}
+
+// MigrationStatus describes a migration and whether it has been applied.
+type MigrationStatus struct {
+	Version   int64
+	Name      string
+	Applied   bool
+	AppliedAt *time.Time
+}
+
+// LoadMigrations reads the embedded NNNN_name.{up,down}.sql files ordered by version.
+func LoadMigrations() ([]Migration, error) {
+	entries, err := fs.ReadDir(migrationFS, "migrations")
+	if err != nil {
+		return nil, fmt.Errorf("read migrations: %w", err)
+	}
+
+	byVersion := map[int64]*Migration{}
+	for _, e := range entries {
+		file := e.Name()
+		var direction string
+		switch {
+		case strings.HasSuffix(file, ".up.sql"):
+			direction = "up"
+		case strings.HasSuffix(file, ".down.sql"):
+			direction = "down"
+		default:
+			continue
+		}
+		base := strings.TrimSuffix(file, "."+direction+".sql")
+		num, name, ok := strings.Cut(base, "_")
+		if !ok {
+			return nil, fmt.Errorf("migration %q: expected NNNN_name", file)
+		}
+		version, err := strconv.ParseInt(num, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("migration %q: bad version: %w", file, err)
+		}
+		body, err := migrationFS.ReadFile(path.Join("migrations", file))
+		if err != nil {
+			return nil, fmt.Errorf("read migration %q: %w", file, err)
+		}
+
+		m := byVersion[version]
+		if m == nil {
+			m = &Migration{Version: version, Name: name}
+			byVersion[version] = m
+		} else if m.Name != name {
+			return nil, fmt.Errorf("migration %d: name mismatch %q vs %q", version, m.Name, name)
+		}
+		if direction == "up" {
+			m.Up = string(body)
+			sum := sha256.Sum256(body)
+			m.Checksum = hex.EncodeToString(sum[:])
+		} else {
+			m.Down = string(body)
+		}
+	}
+
+	out := make([]Migration, 0, len(byVersion))
+	for _, m := range byVersion {
+		if m.Up == "" {
+			return nil, fmt.Errorf("migration %d_%s: missing up file", m.Version, m.Name)
+		}
+		out = append(out, *m)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Version < out[j].Version })
+	return out, nil
+}
+
+// MigrateUp applies every pending migration in version order.
+// It returns the versions applied by this call.
+func MigrateUp(ctx context.Context, pool *pgxpool.Pool) ([]int64, error) {
+	migrations, err := LoadMigrations()
+	if err != nil {
+		return nil, err
+	}
+
+	var done []int64
+	err = withMigrationLock(ctx, pool, func(conn *pgx.Conn) error {
+		applied, err := appliedMigrations(ctx, conn)
+		if err != nil {
+			return err
+		}
+		if err := verifyChecksums(migrations, applied); err != nil {
+			return err
+		}
+		for _, m := range migrations {
+			if _, ok := applied[m.Version]; ok {
+				continue
+			}
+			if err := runMigration(ctx, conn, m, true); err != nil {
+				return err
+			}
+			done = append(done, m.Version)
+		}
+		return nil
+	})
+	return done, err
+}
+
+// MigrateDown rolls back the most recent `steps` applied migrations.
+// It returns the versions rolled back by this call.
+func MigrateDown(ctx context.Context, pool *pgxpool.Pool, steps int) ([]int64, error) {
+	if steps < 1 {
+		return nil, errors.New("steps must be at least 1")
+	}
+	migrations, err := LoadMigrations()
+	if err != nil {
+		return nil, err
+	}
+
+	var done []int64
+	err = withMigrationLock(ctx, pool, func(conn *pgx.Conn) error {
+		applied, err := appliedMigrations(ctx, conn)
+		if err != nil {
+			return err
+		}
+		if err := verifyChecksums(migrations, applied); err != nil {
+			return err
+		}
+		for i := len(migrations) - 1; i >= 0 && len(done) < steps; i-- {
+			m := migrations[i]
+			if _, ok := applied[m.Version]; !ok {
+				continue
+			}
+			if m.Down == "" {
+				return fmt.Errorf("migration %d_%s: missing down file", m.Version, m.Name)
+			}
+			if err := runMigration(ctx, conn, m, false); err != nil {
+				return err
+			}
+			done = append(done, m.Version)
+		}
+		return nil
+	})
+	return done, err
+}
+
+// MigrationStatuses lists every known migration with its applied state.
+func MigrationStatuses(ctx context.Context, pool *pgxpool.Pool) ([]MigrationStatus, error) {
+	migrations, err := LoadMigrations()
+	if err != nil {
+		return nil, err
+	}
+
+	var out []MigrationStatus
+	err = withMigrationLock(ctx, pool, func(conn *pgx.Conn) error {
+		applied, err := appliedMigrations(ctx, conn)
+		if err != nil {
+			return err
+		}
+		for _, m := range migrations {
+			st := MigrationStatus{Version: m.Version, Name: m.Name}
+			if a, ok := applied[m.Version]; ok {
+				st.Applied = true
+				st.AppliedAt = &a.appliedAt
+			}
+			out = append(out, st)
+		}
+		return nil
+	})
+	return out, err
+}
+
+// ---- internals ----
+
+type appliedMigration struct {
+	checksum  string
+	appliedAt time.Time
+}
+
+func withMigrationLock(ctx context.Context, pool *pgxpool.Pool, fn func(conn *pgx.Conn) error) error {
+	conn, err := pool.Acquire(ctx)
+	if err != nil {
+		return fmt.Errorf("acquire conn: %w", err)
+	}
+	defer conn.Release()
+
+	if _, err := conn.Exec(ctx, `SELECT pg_advisory_lock($1)`, migrationLockID); err != nil {
+		return fmt.Errorf("acquire migration lock: %w", err)
+	}
+	defer func() {
+		// Use a fresh context so the lock is released even if ctx was cancelled.
+		unlockCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		_, _ = conn.Exec(unlockCtx, `SELECT pg_advisory_unlock($1)`, migrationLockID)
+	}()
+
+	const ddl = `
+		CREATE TABLE IF NOT EXISTS public.schema_migrations (
+			version    bigint PRIMARY KEY,
+			name       text        NOT NULL,
+			checksum   text        NOT NULL,
+			applied_at timestamptz NOT NULL DEFAULT now()
+		)
+	`
+	if _, err := conn.Exec(ctx, ddl); err != nil {
+		return fmt.Errorf("create schema_migrations: %w", err)
+	}
+	return fn(conn.Conn())
+}
+
+func appliedMigrations(ctx context.Context, conn *pgx.Conn) (map[int64]appliedMigration, error) {
+	rows, err := conn.Query(ctx, `SELECT version, checksum, applied_at FROM public.schema_migrations`)
+	if err != nil {
+		return nil, fmt.Errorf("read schema_migrations: %w", err)
+	}
+	defer rows.Close()
+
+	out := map[int64]appliedMigration{}
+	for rows.Next() {
+		var v int64
+		var a appliedMigration
+		if err := rows.Scan(&v, &a.checksum, &a.appliedAt); err != nil {
+			return nil, err
+		}
+		out[v] = a
+	}
+	return out, rows.Err()
+}
+
+func verifyChecksums(migrations []Migration, applied map[int64]appliedMigration) error {
+	known := make(map[int64]bool, len(migrations))
+	for _, m := range migrations {
+		known[m.Version] = true
+		if a, ok := applied[m.Version]; ok && a.checksum != m.Checksum {
+			return fmt.Errorf("migration %d_%s: checksum mismatch (applied %s, embedded %s)",
+				m.Version, m.Name, a.checksum, m.Checksum)
+		}
+	}
+	for v := range applied {
+		if !known[v] {
+			return fmt.Errorf("migration %d is applied but not embedded in this binary", v)
+		}
+	}
+	return nil
+}
+
+func runMigration(ctx context.Context, conn *pgx.Conn, m Migration, up bool) error {
+	tx, err := conn.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("begin migration %d: %w", m.Version, err)
+	}
+	defer tx.Rollback(ctx)
+
+	if up {
+		if _, err := tx.Exec(ctx, m.Up); err != nil {
+			return fmt.Errorf("apply migration %d_%s: %w", m.Version, m.Name, err)
+		}
+		if _, err := tx.Exec(ctx,
+			`INSERT INTO public.schema_migrations (version, name, checksum) VALUES ($1, $2, $3)`,
+			m.Version, m.Name, m.Checksum,
+		); err != nil {
+			return fmt.Errorf("record migration %d: %w", m.Version, err)
+		}
+	} else {
+		if _, err := tx.Exec(ctx, m.Down); err != nil {
+			return fmt.Errorf("revert migration %d_%s: %w", m.Version, m.Name, err)
+		}
+		if _, err := tx.Exec(ctx, `DELETE FROM public.schema_migrations WHERE version = $1`, m.Version); err != nil {
+			return fmt.Errorf("unrecord migration %d: %w", m.Version, err)
+		}
+	}
+	return tx.Commit(ctx)
+}
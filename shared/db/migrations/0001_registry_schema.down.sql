@@ -0,0 +1,12 @@
+DROP TABLE IF EXISTS public.batches;
+DROP TABLE IF EXISTS public.drug_registration_auth_holders;
+DROP TABLE IF EXISTS public.drug_registration_sites;
+DROP TABLE IF EXISTS public.drug_registrations;
+DROP TABLE IF EXISTS public.drugs;
+DROP TABLE IF EXISTS public.manufacturing_sites;
+DROP TABLE IF EXISTS public.marketing_authorizations;
+DROP TABLE IF EXISTS public.auth_holders;
+DROP TABLE IF EXISTS public.apis;
+DROP TABLE IF EXISTS public.routes_of_admin;
+DROP TABLE IF EXISTS public.strength_units;
+DROP TABLE IF EXISTS public.dosage_forms;
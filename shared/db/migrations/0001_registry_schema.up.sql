@@ -0,0 +1,173 @@
+-- 0001_registry_schema: catalog, registry and drug tables used by internal/services.
+
+-- ===== Catalog =====
+CREATE TABLE public.dosage_forms (
+    id         uuid PRIMARY KEY,
+    code       text        NOT NULL,
+    name       text        NOT NULL,
+    created_at timestamptz NOT NULL DEFAULT now(),
+    updated_at timestamptz NOT NULL DEFAULT now(),
+    CONSTRAINT dosage_forms_code_key UNIQUE (code),
+    CONSTRAINT dosage_forms_code_len CHECK (char_length(code) BETWEEN 1 AND 32),
+    CONSTRAINT dosage_forms_name_len CHECK (char_length(name) BETWEEN 1 AND 120)
+);
+
+CREATE TABLE public.strength_units (
+    id         uuid PRIMARY KEY,
+    code       text        NOT NULL,
+    name       text        NOT NULL,
+    created_at timestamptz NOT NULL DEFAULT now(),
+    updated_at timestamptz NOT NULL DEFAULT now(),
+    CONSTRAINT strength_units_code_key UNIQUE (code),
+    CONSTRAINT strength_units_code_len CHECK (char_length(code) BETWEEN 1 AND 32),
+    CONSTRAINT strength_units_name_len CHECK (char_length(name) BETWEEN 1 AND 120)
+);
+
+CREATE TABLE public.routes_of_admin (
+    id         uuid PRIMARY KEY,
+    code       text        NOT NULL,
+    name       text        NOT NULL,
+    created_at timestamptz NOT NULL DEFAULT now(),
+    updated_at timestamptz NOT NULL DEFAULT now(),
+    CONSTRAINT routes_of_admin_code_key UNIQUE (code),
+    CONSTRAINT routes_of_admin_code_len CHECK (char_length(code) BETWEEN 1 AND 32),
+    CONSTRAINT routes_of_admin_name_len CHECK (char_length(name) BETWEEN 1 AND 120)
+);
+
+CREATE TABLE public.apis (
+    id         uuid PRIMARY KEY,
+    name       text        NOT NULL,
+    status     text        NOT NULL DEFAULT 'active',
+    created_at timestamptz NOT NULL DEFAULT now(),
+    updated_at timestamptz NOT NULL DEFAULT now(),
+    CONSTRAINT apis_name_len CHECK (char_length(name) BETWEEN 1 AND 200),
+    CONSTRAINT apis_status_check CHECK (status IN ('active', 'inactive', 'withdrawn', 'banned'))
+);
+CREATE UNIQUE INDEX apis_name_lower_key ON public.apis (lower(name));
+
+-- ===== Registry =====
+CREATE TABLE public.auth_holders (
+    id                  uuid PRIMARY KEY,
+    name                text        NOT NULL,
+    registration_number text,
+    created_at          timestamptz NOT NULL DEFAULT now(),
+    updated_at          timestamptz NOT NULL DEFAULT now(),
+    CONSTRAINT auth_holders_name_len CHECK (char_length(name) BETWEEN 1 AND 200),
+    CONSTRAINT auth_holders_registration_number_len CHECK (char_length(registration_number) <= 100)
+);
+CREATE UNIQUE INDEX auth_holders_name_lower_key ON public.auth_holders (lower(name));
+CREATE UNIQUE INDEX auth_holders_registration_number_key ON public.auth_holders (registration_number)
+    WHERE registration_number IS NOT NULL;
+
+CREATE TABLE public.marketing_authorizations (
+    id         uuid PRIMARY KEY,
+    name       text        NOT NULL,
+    country    char(2)     NOT NULL,
+    created_at timestamptz NOT NULL DEFAULT now(),
+    updated_at timestamptz NOT NULL DEFAULT now(),
+    CONSTRAINT marketing_authorizations_name_len CHECK (char_length(name) BETWEEN 1 AND 200),
+    CONSTRAINT marketing_authorizations_country_check CHECK (country ~ '^[A-Z]{2}$')
+);
+CREATE UNIQUE INDEX marketing_authorizations_name_country_key
+    ON public.marketing_authorizations (lower(name), country);
+
+CREATE TABLE public.manufacturing_sites (
+    id         uuid PRIMARY KEY,
+    name       text        NOT NULL,
+    country    char(2)     NOT NULL,
+    created_at timestamptz NOT NULL DEFAULT now(),
+    updated_at timestamptz NOT NULL DEFAULT now(),
+    CONSTRAINT manufacturing_sites_name_len CHECK (char_length(name) BETWEEN 1 AND 200),
+    CONSTRAINT manufacturing_sites_country_check CHECK (country ~ '^[A-Z]{2}$')
+);
+CREATE UNIQUE INDEX manufacturing_sites_name_country_key
+    ON public.manufacturing_sites (lower(name), country);
+CREATE INDEX manufacturing_sites_country_idx ON public.manufacturing_sites (country);
+
+-- ===== Domain =====
+CREATE TABLE public.drugs (
+    id               uuid PRIMARY KEY,
+    brand_name       text             NOT NULL,
+    dosage_form_id   uuid             NOT NULL REFERENCES public.dosage_forms (id),
+    route_id         uuid             NOT NULL REFERENCES public.routes_of_admin (id),
+    strength_unit_id uuid             NOT NULL REFERENCES public.strength_units (id),
+    dose             double precision NOT NULL,
+    api_id           uuid             NOT NULL REFERENCES public.apis (id),
+    created_at       timestamptz      NOT NULL DEFAULT now(),
+    updated_at       timestamptz      NOT NULL DEFAULT now(),
+    CONSTRAINT drugs_brand_name_len CHECK (char_length(brand_name) BETWEEN 1 AND 200),
+    CONSTRAINT drugs_dose_positive CHECK (dose > 0)
+);
+CREATE UNIQUE INDEX drugs_identity_key
+    ON public.drugs (lower(brand_name), api_id, dosage_form_id, route_id, strength_unit_id, dose);
+CREATE INDEX drugs_dosage_form_id_idx ON public.drugs (dosage_form_id);
+CREATE INDEX drugs_route_id_idx ON public.drugs (route_id);
+CREATE INDEX drugs_strength_unit_id_idx ON public.drugs (strength_unit_id);
+CREATE INDEX drugs_api_id_idx ON public.drugs (api_id);
+
+CREATE TABLE public.drug_registrations (
+    id                  uuid PRIMARY KEY,
+    drug_id             uuid        NOT NULL REFERENCES public.drugs (id),
+    ma_id               uuid        NOT NULL REFERENCES public.marketing_authorizations (id),
+    registration_number text,
+    status              text        NOT NULL,
+    valid_from          date        NOT NULL,
+    valid_to            date        NOT NULL,
+    is_primary          boolean     NOT NULL DEFAULT false,
+    created_at          timestamptz NOT NULL DEFAULT now(),
+    updated_at          timestamptz NOT NULL DEFAULT now(),
+    CONSTRAINT drug_registrations_status_check CHECK (status IN ('active', 'suspended', 'expired', 'withdrawn')),
+    CONSTRAINT drug_registrations_validity_check CHECK (valid_to > valid_from),
+    CONSTRAINT drug_registrations_registration_number_len CHECK (char_length(registration_number) <= 100)
+);
+CREATE UNIQUE INDEX drug_registrations_registration_number_key ON public.drug_registrations (registration_number)
+    WHERE registration_number IS NOT NULL;
+CREATE UNIQUE INDEX drug_registrations_primary_key ON public.drug_registrations (drug_id)
+    WHERE is_primary;
+CREATE INDEX drug_registrations_drug_id_idx ON public.drug_registrations (drug_id);
+CREATE INDEX drug_registrations_ma_id_idx ON public.drug_registrations (ma_id);
+CREATE INDEX drug_registrations_status_valid_to_idx ON public.drug_registrations (status, valid_to);
+
+CREATE TABLE public.drug_registration_sites (
+    id                   uuid PRIMARY KEY,
+    drug_registration_id uuid NOT NULL REFERENCES public.drug_registrations (id),
+    site_id              uuid NOT NULL REFERENCES public.manufacturing_sites (id),
+    role                 text,
+    CONSTRAINT drug_registration_sites_role_len CHECK (char_length(role) <= 80),
+    CONSTRAINT drug_registration_sites_link_key UNIQUE (drug_registration_id, site_id)
+);
+CREATE INDEX drug_registration_sites_site_id_idx ON public.drug_registration_sites (site_id);
+
+CREATE TABLE public.drug_registration_auth_holders (
+    id                   uuid PRIMARY KEY,
+    drug_registration_id uuid NOT NULL REFERENCES public.drug_registrations (id),
+    auth_holder_id       uuid NOT NULL REFERENCES public.auth_holders (id),
+    role                 text,
+    CONSTRAINT drug_registration_auth_holders_role_len CHECK (char_length(role) <= 80),
+    CONSTRAINT drug_registration_auth_holders_link_key UNIQUE (drug_registration_id, auth_holder_id)
+);
+CREATE INDEX drug_registration_auth_holders_auth_holder_id_idx ON public.drug_registration_auth_holders (auth_holder_id);
+
+CREATE TABLE public.batches (
+    id                   uuid PRIMARY KEY,
+    drug_id              uuid             NOT NULL REFERENCES public.drugs (id),
+    drug_registration_id uuid             REFERENCES public.drug_registrations (id),
+    batch_number         text             NOT NULL,
+    mfg_date             date             NOT NULL,
+    expire_date          date             NOT NULL,
+    qty_in_batch         bigint           NOT NULL DEFAULT 0,
+    status               text             NOT NULL,
+    price                double precision NOT NULL DEFAULT 0,
+    recall_reason        jsonb,
+    created_at           timestamptz      NOT NULL DEFAULT now(),
+    updated_at           timestamptz      NOT NULL DEFAULT now(),
+    CONSTRAINT batches_batch_number_len CHECK (char_length(batch_number) BETWEEN 1 AND 120),
+    CONSTRAINT batches_qty_non_negative CHECK (qty_in_batch >= 0),
+    CONSTRAINT batches_price_non_negative CHECK (price >= 0),
+    CONSTRAINT batches_dates_check CHECK (expire_date > mfg_date),
+    CONSTRAINT batches_status_check CHECK (status IN ('planned', 'released', 'on_hold', 'recalled', 'expired', 'sold_out', 'inactive')),
+    CONSTRAINT batches_drug_batch_number_key UNIQUE (drug_id, batch_number)
+);
+CREATE INDEX batches_drug_registration_id_idx ON public.batches (drug_registration_id);
+CREATE INDEX batches_status_expire_date_idx ON public.batches (status, expire_date);
+CREATE INDEX batches_expire_date_idx ON public.batches (expire_date DESC, batch_number);
@@ -0,0 +1,9 @@
+DROP INDEX IF EXISTS public.manufacturing_sites_name_id_idx;
+DROP INDEX IF EXISTS public.marketing_authorizations_name_id_idx;
+DROP INDEX IF EXISTS public.auth_holders_name_id_idx;
+DROP INDEX IF EXISTS public.apis_name_id_idx;
+DROP INDEX IF EXISTS public.drugs_brand_name_id_idx;
+DROP INDEX IF EXISTS public.drug_registrations_valid_to_id_idx;
+DROP INDEX IF EXISTS public.batches_drug_id_expire_date_id_idx;
+DROP INDEX IF EXISTS public.batches_expire_date_id_idx;
+CREATE INDEX batches_expire_date_idx ON public.batches (expire_date DESC, batch_number);
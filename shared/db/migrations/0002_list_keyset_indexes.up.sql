@@ -0,0 +1,13 @@
+-- 0002_list_keyset_indexes: composite (sort key, id) indexes for the keyset-paginated List* queries.
+
+DROP INDEX IF EXISTS public.batches_expire_date_idx;
+CREATE INDEX batches_expire_date_id_idx ON public.batches (expire_date, id);
+CREATE INDEX batches_drug_id_expire_date_id_idx ON public.batches (drug_id, expire_date, id);
+
+CREATE INDEX drug_registrations_valid_to_id_idx ON public.drug_registrations (valid_to, id);
+
+CREATE INDEX drugs_brand_name_id_idx ON public.drugs (lower(brand_name), id);
+CREATE INDEX apis_name_id_idx ON public.apis (lower(name), id);
+CREATE INDEX auth_holders_name_id_idx ON public.auth_holders (lower(name), id);
+CREATE INDEX marketing_authorizations_name_id_idx ON public.marketing_authorizations (lower(name), id);
+CREATE INDEX manufacturing_sites_name_id_idx ON public.manufacturing_sites (lower(name), id);
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS public.batch_status_history;
@@ -0,0 +1,15 @@
+-- 0003_batch_status_history: audit trail of batch status transitions.
+
+CREATE TABLE public.batch_status_history (
+    id          uuid PRIMARY KEY,
+    batch_id    uuid        NOT NULL REFERENCES public.batches (id) ON DELETE CASCADE,
+    from_status text        NOT NULL,
+    to_status   text        NOT NULL,
+    reason      text,
+    changed_by  text        NOT NULL,
+    changed_at  timestamptz NOT NULL DEFAULT now(),
+    CONSTRAINT batch_status_history_from_status_check CHECK (from_status IN ('planned', 'released', 'on_hold', 'recalled', 'expired', 'sold_out', 'inactive')),
+    CONSTRAINT batch_status_history_to_status_check CHECK (to_status IN ('planned', 'released', 'on_hold', 'recalled', 'expired', 'sold_out', 'inactive')),
+    CONSTRAINT batch_status_history_reason_len CHECK (reason IS NULL OR char_length(reason) <= 500)
+);
+CREATE INDEX batch_status_history_batch_id_changed_at_idx ON public.batch_status_history (batch_id, changed_at, id);
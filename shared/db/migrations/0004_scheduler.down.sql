@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS public.scheduler_job_runs;
+DROP TABLE IF EXISTS public.drug_registration_status_history;
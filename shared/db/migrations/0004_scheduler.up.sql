@@ -0,0 +1,30 @@
+-- 0004_scheduler: registration status history and the last run of each background job.
+
+CREATE TABLE public.drug_registration_status_history (
+    id                   uuid PRIMARY KEY,
+    drug_registration_id uuid        NOT NULL REFERENCES public.drug_registrations (id) ON DELETE CASCADE,
+    from_status          text        NOT NULL,
+    to_status            text        NOT NULL,
+    reason               text,
+    changed_by           text        NOT NULL,
+    changed_at           timestamptz NOT NULL DEFAULT now(),
+    CONSTRAINT drug_registration_status_history_from_status_check CHECK (from_status IN ('active', 'suspended', 'expired', 'withdrawn')),
+    CONSTRAINT drug_registration_status_history_to_status_check CHECK (to_status IN ('active', 'suspended', 'expired', 'withdrawn')),
+    CONSTRAINT drug_registration_status_history_reason_len CHECK (reason IS NULL OR char_length(reason) <= 500)
+);
+CREATE INDEX drug_registration_status_history_reg_changed_at_idx
+    ON public.drug_registration_status_history (drug_registration_id, changed_at, id);
+
+-- One row per job, overwritten by whichever replica ran it last.
+CREATE TABLE public.scheduler_job_runs (
+    job_name         text PRIMARY KEY,
+    last_started_at  timestamptz NOT NULL,
+    last_finished_at timestamptz NOT NULL,
+    last_status      text        NOT NULL,
+    last_error       text,
+    last_affected    bigint      NOT NULL DEFAULT 0,
+    last_instance    text        NOT NULL,
+    run_count        bigint      NOT NULL DEFAULT 0,
+    error_count      bigint      NOT NULL DEFAULT 0,
+    CONSTRAINT scheduler_job_runs_last_status_check CHECK (last_status IN ('ok', 'error'))
+);
@@ -0,0 +1,12 @@
+ALTER TABLE public.batches ADD COLUMN recall_reason jsonb;
+
+-- Only legacy recalls can be folded back into a single JSON value per batch.
+UPDATE public.batches b
+SET recall_reason = r.legacy_details
+FROM public.recall_batches rb
+JOIN public.recalls r ON r.id = rb.recall_id
+WHERE rb.batch_id = b.id AND r.legacy_details IS NOT NULL;
+
+DROP TABLE IF EXISTS public.recall_batches;
+DROP TABLE IF EXISTS public.recalls;
+DROP SEQUENCE IF EXISTS public.recall_number_seq;
@@ -0,0 +1,64 @@
+-- 0005_recalls: structured recalls replace batches.recall_reason.
+-- Batches that carried a free-form recall_reason become one "legacy" recall
+-- each, keeping the original JSON in legacy_details.
+
+CREATE SEQUENCE public.recall_number_seq;
+
+CREATE TABLE public.recalls (
+    id             uuid PRIMARY KEY,
+    recall_number  text        NOT NULL,
+    class          text,
+    level          text,
+    reason_codes   text[]      NOT NULL DEFAULT '{}',
+    reason         text,
+    initiated_by   text,
+    initiator_name text,
+    started_on     date        NOT NULL DEFAULT current_date,
+    closed_on      date,
+    status         text        NOT NULL DEFAULT 'open',
+    close_note     text,
+    legacy_details jsonb,
+    created_by     text        NOT NULL,
+    created_at     timestamptz NOT NULL DEFAULT now(),
+    updated_at     timestamptz NOT NULL DEFAULT now(),
+    CONSTRAINT recalls_recall_number_key UNIQUE (recall_number),
+    CONSTRAINT recalls_class_check CHECK (class IN ('I', 'II', 'III')),
+    CONSTRAINT recalls_level_check CHECK (level IN ('wholesale', 'retail', 'patient')),
+    CONSTRAINT recalls_initiated_by_check CHECK (initiated_by IN ('manufacturer', 'auth_holder', 'regulator')),
+    CONSTRAINT recalls_status_check CHECK (status IN ('open', 'closed')),
+    CONSTRAINT recalls_closed_check CHECK ((status = 'closed') = (closed_on IS NOT NULL)),
+    CONSTRAINT recalls_dates_check CHECK (closed_on IS NULL OR closed_on >= started_on),
+    CONSTRAINT recalls_reason_len CHECK (char_length(reason) <= 2000),
+    CONSTRAINT recalls_close_note_len CHECK (char_length(close_note) <= 2000),
+    CONSTRAINT recalls_classified_check CHECK (
+        legacy_details IS NOT NULL
+        OR (class IS NOT NULL AND level IS NOT NULL AND initiated_by IS NOT NULL AND cardinality(reason_codes) > 0)
+    )
+);
+CREATE INDEX recalls_status_started_on_idx ON public.recalls (status, started_on, id);
+CREATE INDEX recalls_started_on_id_idx ON public.recalls (started_on, id);
+
+CREATE TABLE public.recall_batches (
+    recall_id uuid        NOT NULL REFERENCES public.recalls (id),
+    batch_id  uuid        NOT NULL REFERENCES public.batches (id),
+    added_by  text        NOT NULL,
+    added_at  timestamptz NOT NULL DEFAULT now(),
+    PRIMARY KEY (recall_id, batch_id)
+);
+CREATE INDEX recall_batches_batch_id_idx ON public.recall_batches (batch_id);
+
+INSERT INTO public.recalls (id, recall_number, started_on, closed_on, status, legacy_details, created_by)
+SELECT gen_random_uuid(), 'LEGACY-' || b.id, b.updated_at::date,
+       CASE WHEN b.status = 'recalled' THEN NULL ELSE b.updated_at::date END,
+       CASE WHEN b.status = 'recalled' THEN 'open' ELSE 'closed' END,
+       b.recall_reason, 'migration'
+FROM public.batches b
+WHERE b.recall_reason IS NOT NULL;
+
+INSERT INTO public.recall_batches (recall_id, batch_id, added_by)
+SELECT r.id, b.id, 'migration'
+FROM public.batches b
+JOIN public.recalls r ON r.recall_number = 'LEGACY-' || b.id
+WHERE b.recall_reason IS NOT NULL;
+
+ALTER TABLE public.batches DROP COLUMN recall_reason;
@@ -0,0 +1,24 @@
+DROP INDEX IF EXISTS public.drugs_brand_name_fr_id_idx;
+DROP INDEX IF EXISTS public.drugs_brand_name_ar_id_idx;
+DROP INDEX IF EXISTS public.drugs_brand_name_en_id_idx;
+DROP INDEX IF EXISTS public.manufacturing_sites_name_fr_id_idx;
+DROP INDEX IF EXISTS public.manufacturing_sites_name_ar_id_idx;
+DROP INDEX IF EXISTS public.manufacturing_sites_name_en_id_idx;
+DROP INDEX IF EXISTS public.apis_name_fr_id_idx;
+DROP INDEX IF EXISTS public.apis_name_ar_id_idx;
+DROP INDEX IF EXISTS public.apis_name_en_id_idx;
+DROP INDEX IF EXISTS public.routes_of_admin_name_fr_id_idx;
+DROP INDEX IF EXISTS public.routes_of_admin_name_ar_id_idx;
+DROP INDEX IF EXISTS public.routes_of_admin_name_en_id_idx;
+DROP INDEX IF EXISTS public.strength_units_name_fr_id_idx;
+DROP INDEX IF EXISTS public.strength_units_name_ar_id_idx;
+DROP INDEX IF EXISTS public.strength_units_name_en_id_idx;
+DROP INDEX IF EXISTS public.dosage_forms_name_fr_id_idx;
+DROP INDEX IF EXISTS public.dosage_forms_name_ar_id_idx;
+DROP INDEX IF EXISTS public.dosage_forms_name_en_id_idx;
+ALTER TABLE public.drugs DROP COLUMN IF EXISTS brand_names;
+ALTER TABLE public.manufacturing_sites DROP COLUMN IF EXISTS names;
+ALTER TABLE public.apis DROP COLUMN IF EXISTS names;
+ALTER TABLE public.routes_of_admin DROP COLUMN IF EXISTS names;
+ALTER TABLE public.strength_units DROP COLUMN IF EXISTS names;
+ALTER TABLE public.dosage_forms DROP COLUMN IF EXISTS names;
@@ -0,0 +1,65 @@
+-- 0006_localized_names: per-locale names for catalog entities, drugs and sites.
+-- The plain name column keeps the default-locale (en) value, which the
+-- unique indexes use; names holds every translation including that one.
+
+ALTER TABLE public.dosage_forms ADD COLUMN names jsonb;
+UPDATE public.dosage_forms SET names = jsonb_build_object('en', name);
+ALTER TABLE public.dosage_forms
+    ALTER COLUMN names SET NOT NULL,
+    ADD CONSTRAINT dosage_forms_names_default CHECK (names ->> 'en' = name);
+
+ALTER TABLE public.strength_units ADD COLUMN names jsonb;
+UPDATE public.strength_units SET names = jsonb_build_object('en', name);
+ALTER TABLE public.strength_units
+    ALTER COLUMN names SET NOT NULL,
+    ADD CONSTRAINT strength_units_names_default CHECK (names ->> 'en' = name);
+
+ALTER TABLE public.routes_of_admin ADD COLUMN names jsonb;
+UPDATE public.routes_of_admin SET names = jsonb_build_object('en', name);
+ALTER TABLE public.routes_of_admin
+    ALTER COLUMN names SET NOT NULL,
+    ADD CONSTRAINT routes_of_admin_names_default CHECK (names ->> 'en' = name);
+
+ALTER TABLE public.apis ADD COLUMN names jsonb;
+UPDATE public.apis SET names = jsonb_build_object('en', name);
+ALTER TABLE public.apis
+    ALTER COLUMN names SET NOT NULL,
+    ADD CONSTRAINT apis_names_default CHECK (names ->> 'en' = name);
+
+ALTER TABLE public.manufacturing_sites ADD COLUMN names jsonb;
+UPDATE public.manufacturing_sites SET names = jsonb_build_object('en', name);
+ALTER TABLE public.manufacturing_sites
+    ALTER COLUMN names SET NOT NULL,
+    ADD CONSTRAINT manufacturing_sites_names_default CHECK (names ->> 'en' = name);
+
+ALTER TABLE public.drugs ADD COLUMN brand_names jsonb;
+UPDATE public.drugs SET brand_names = jsonb_build_object('en', brand_name);
+ALTER TABLE public.drugs
+    ALTER COLUMN brand_names SET NOT NULL,
+    ADD CONSTRAINT drugs_brand_names_default CHECK (brand_names ->> 'en' = brand_name);
+
+-- Keyset indexes for lists ordered by the name in a language (?lang=).
+
+CREATE INDEX dosage_forms_name_en_id_idx ON public.dosage_forms ((lower(COALESCE(names ->> 'en', name)) COLLATE "en-x-icu"), id);
+CREATE INDEX dosage_forms_name_ar_id_idx ON public.dosage_forms ((lower(COALESCE(names ->> 'ar', name)) COLLATE "ar-x-icu"), id);
+CREATE INDEX dosage_forms_name_fr_id_idx ON public.dosage_forms ((lower(COALESCE(names ->> 'fr', name)) COLLATE "fr-x-icu"), id);
+
+CREATE INDEX strength_units_name_en_id_idx ON public.strength_units ((lower(COALESCE(names ->> 'en', name)) COLLATE "en-x-icu"), id);
+CREATE INDEX strength_units_name_ar_id_idx ON public.strength_units ((lower(COALESCE(names ->> 'ar', name)) COLLATE "ar-x-icu"), id);
+CREATE INDEX strength_units_name_fr_id_idx ON public.strength_units ((lower(COALESCE(names ->> 'fr', name)) COLLATE "fr-x-icu"), id);
+
+CREATE INDEX routes_of_admin_name_en_id_idx ON public.routes_of_admin ((lower(COALESCE(names ->> 'en', name)) COLLATE "en-x-icu"), id);
+CREATE INDEX routes_of_admin_name_ar_id_idx ON public.routes_of_admin ((lower(COALESCE(names ->> 'ar', name)) COLLATE "ar-x-icu"), id);
+CREATE INDEX routes_of_admin_name_fr_id_idx ON public.routes_of_admin ((lower(COALESCE(names ->> 'fr', name)) COLLATE "fr-x-icu"), id);
+
+CREATE INDEX apis_name_en_id_idx ON public.apis ((lower(COALESCE(names ->> 'en', name)) COLLATE "en-x-icu"), id);
+CREATE INDEX apis_name_ar_id_idx ON public.apis ((lower(COALESCE(names ->> 'ar', name)) COLLATE "ar-x-icu"), id);
+CREATE INDEX apis_name_fr_id_idx ON public.apis ((lower(COALESCE(names ->> 'fr', name)) COLLATE "fr-x-icu"), id);
+
+CREATE INDEX manufacturing_sites_name_en_id_idx ON public.manufacturing_sites ((lower(COALESCE(names ->> 'en', name)) COLLATE "en-x-icu"), id);
+CREATE INDEX manufacturing_sites_name_ar_id_idx ON public.manufacturing_sites ((lower(COALESCE(names ->> 'ar', name)) COLLATE "ar-x-icu"), id);
+CREATE INDEX manufacturing_sites_name_fr_id_idx ON public.manufacturing_sites ((lower(COALESCE(names ->> 'fr', name)) COLLATE "fr-x-icu"), id);
+
+CREATE INDEX drugs_brand_name_en_id_idx ON public.drugs ((lower(COALESCE(brand_names ->> 'en', brand_name)) COLLATE "en-x-icu"), id);
+CREATE INDEX drugs_brand_name_ar_id_idx ON public.drugs ((lower(COALESCE(brand_names ->> 'ar', brand_name)) COLLATE "ar-x-icu"), id);
+CREATE INDEX drugs_brand_name_fr_id_idx ON public.drugs ((lower(COALESCE(brand_names ->> 'fr', brand_name)) COLLATE "fr-x-icu"), id);
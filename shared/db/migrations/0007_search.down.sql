@@ -0,0 +1,14 @@
+DROP INDEX IF EXISTS public.drug_registrations_registration_number_trgm_idx;
+DROP INDEX IF EXISTS public.drug_registrations_registration_number_fts_idx;
+DROP INDEX IF EXISTS public.auth_holders_registration_number_trgm_idx;
+DROP INDEX IF EXISTS public.auth_holders_registration_number_fts_idx;
+DROP INDEX IF EXISTS public.auth_holders_name_trgm_idx;
+DROP INDEX IF EXISTS public.auth_holders_name_fts_idx;
+DROP INDEX IF EXISTS public.apis_names_trgm_idx;
+DROP INDEX IF EXISTS public.apis_names_fts_idx;
+DROP INDEX IF EXISTS public.drugs_brand_names_trgm_idx;
+DROP INDEX IF EXISTS public.drugs_brand_names_fts_idx;
+
+DROP FUNCTION IF EXISTS public.names_text(jsonb);
+
+-- pg_trgm is left installed: other schemas in the database may use it.
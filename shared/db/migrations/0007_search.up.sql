@@ -0,0 +1,35 @@
+-- 0007_search: full-text and trigram indexes behind GET /search.
+-- Documents use the 'simple' configuration: brand and INN names are not
+-- English prose, and stemming them would only produce false matches.
+
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+-- names_text joins every translation of a names column, so one index covers
+-- all of them. The search queries must use this exact expression.
+CREATE FUNCTION public.names_text(names jsonb) RETURNS text
+    LANGUAGE sql IMMUTABLE STRICT PARALLEL SAFE
+    AS $$ SELECT string_agg(value, ' ' ORDER BY key) FROM jsonb_each_text(names) $$;
+
+CREATE INDEX drugs_brand_names_fts_idx ON public.drugs
+    USING gin (to_tsvector('simple', public.names_text(brand_names)));
+CREATE INDEX drugs_brand_names_trgm_idx ON public.drugs
+    USING gin (public.names_text(brand_names) gin_trgm_ops);
+
+CREATE INDEX apis_names_fts_idx ON public.apis
+    USING gin (to_tsvector('simple', public.names_text(names)));
+CREATE INDEX apis_names_trgm_idx ON public.apis
+    USING gin (public.names_text(names) gin_trgm_ops);
+
+CREATE INDEX auth_holders_name_fts_idx ON public.auth_holders
+    USING gin (to_tsvector('simple', name));
+CREATE INDEX auth_holders_name_trgm_idx ON public.auth_holders
+    USING gin (name gin_trgm_ops);
+CREATE INDEX auth_holders_registration_number_fts_idx ON public.auth_holders
+    USING gin (to_tsvector('simple', registration_number));
+CREATE INDEX auth_holders_registration_number_trgm_idx ON public.auth_holders
+    USING gin (registration_number gin_trgm_ops);
+
+CREATE INDEX drug_registrations_registration_number_fts_idx ON public.drug_registrations
+    USING gin (to_tsvector('simple', registration_number));
+CREATE INDEX drug_registrations_registration_number_trgm_idx ON public.drug_registrations
+    USING gin (registration_number gin_trgm_ops);
@@ -0,0 +1,3 @@
+-- The first ingredient of each drug is still in drugs.api_id and dose;
+-- further ingredients of combination products are lost.
+DROP TABLE IF EXISTS public.drug_ingredients;
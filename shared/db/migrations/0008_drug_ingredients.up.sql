@@ -0,0 +1,33 @@
+-- 0008_drug_ingredients: the active ingredients of a drug, one row each, so
+-- fixed-dose combinations (amoxicillin + clavulanic acid) can be registered.
+-- drugs.api_id, strength_unit_id and dose stay as the first ingredient for
+-- existing clients; every existing drug gets that ingredient as its only row,
+-- with the basis of strength left unknown (NULL) for a reviewer to set.
+
+CREATE TABLE public.drug_ingredients (
+    id               uuid PRIMARY KEY,
+    drug_id          uuid             NOT NULL REFERENCES public.drugs (id) ON DELETE CASCADE,
+    api_id           uuid             NOT NULL REFERENCES public.apis (id),
+    strength         double precision NOT NULL,
+    strength_unit_id uuid             NOT NULL REFERENCES public.strength_units (id),
+    per_quantity     double precision,
+    per_unit_id      uuid             REFERENCES public.strength_units (id),
+    basis            text,
+    salt             text,
+    created_at       timestamptz      NOT NULL DEFAULT now(),
+    updated_at       timestamptz      NOT NULL DEFAULT now(),
+    CONSTRAINT drug_ingredients_drug_api_key UNIQUE (drug_id, api_id),
+    CONSTRAINT drug_ingredients_strength_positive CHECK (strength > 0),
+    CONSTRAINT drug_ingredients_per_positive CHECK (per_quantity > 0),
+    CONSTRAINT drug_ingredients_per_pair CHECK ((per_quantity IS NULL) = (per_unit_id IS NULL)),
+    CONSTRAINT drug_ingredients_basis_check CHECK (basis IN ('base', 'salt')),
+    CONSTRAINT drug_ingredients_salt_len CHECK (char_length(salt) BETWEEN 1 AND 200)
+);
+CREATE INDEX drug_ingredients_api_id_idx ON public.drug_ingredients (api_id);
+CREATE INDEX drug_ingredients_strength_unit_id_idx ON public.drug_ingredients (strength_unit_id);
+CREATE INDEX drug_ingredients_per_unit_id_idx ON public.drug_ingredients (per_unit_id);
+CREATE INDEX drug_ingredients_drug_created_idx ON public.drug_ingredients (drug_id, created_at, id);
+
+INSERT INTO public.drug_ingredients (id, drug_id, api_id, strength, strength_unit_id, created_at, updated_at)
+SELECT gen_random_uuid(), d.id, d.api_id, d.dose, d.strength_unit_id, d.created_at, d.created_at
+FROM public.drugs d;
@@ -0,0 +1,18 @@
+-- Values go back to double precision and may pick up binary rounding again;
+-- batch currencies and unit dimensions are dropped.
+ALTER TABLE public.strength_units
+    DROP COLUMN IF EXISTS factor,
+    DROP COLUMN IF EXISTS dimension;
+
+ALTER TABLE public.batches
+    DROP COLUMN IF EXISTS currency,
+    ALTER COLUMN price DROP DEFAULT,
+    ALTER COLUMN price TYPE double precision USING price::double precision,
+    ALTER COLUMN price SET DEFAULT 0;
+
+ALTER TABLE public.drug_ingredients
+    ALTER COLUMN strength TYPE double precision USING strength::double precision,
+    ALTER COLUMN per_quantity TYPE double precision USING per_quantity::double precision;
+
+ALTER TABLE public.drugs
+    ALTER COLUMN dose TYPE double precision USING dose::double precision;
@@ -0,0 +1,49 @@
+-- 0009_decimal_units: doses, strengths and prices become exact numeric
+-- values instead of double precision, so 0.1 + 0.2 is 0.3 and a price of
+-- 12.50 is stored as entered. The float8 -> numeric cast rounds to 15
+-- significant digits, which also cleans up binary noise such as
+-- 0.30000000000000004 in existing rows.
+--
+-- Batch prices gain an ISO 4217 currency, and strength units a dimension
+-- and a factor to that dimension's base unit (g, mL, IU, mol) so strengths
+-- can be converted and compared across units. Well-known unit codes are
+-- filled in; other units stay unconvertible until an administrator sets
+-- them.
+
+ALTER TABLE public.drugs
+    ALTER COLUMN dose TYPE numeric USING dose::numeric;
+
+ALTER TABLE public.drug_ingredients
+    ALTER COLUMN strength TYPE numeric USING strength::numeric,
+    ALTER COLUMN per_quantity TYPE numeric USING per_quantity::numeric;
+
+ALTER TABLE public.batches
+    ALTER COLUMN price DROP DEFAULT,
+    ALTER COLUMN price TYPE numeric USING price::numeric,
+    ALTER COLUMN price SET DEFAULT 0,
+    ADD COLUMN currency text,
+    ADD CONSTRAINT batches_currency_check CHECK (currency ~ '^[A-Z]{3}$');
+
+ALTER TABLE public.strength_units
+    ADD COLUMN dimension text,
+    ADD COLUMN factor numeric,
+    ADD CONSTRAINT strength_units_dimension_check CHECK (dimension IN ('mass', 'volume', 'activity', 'amount')),
+    ADD CONSTRAINT strength_units_factor_positive CHECK (factor > 0),
+    ADD CONSTRAINT strength_units_dimension_pair CHECK ((dimension IS NULL) = (factor IS NULL));
+
+UPDATE public.strength_units u
+SET dimension = k.dimension, factor = k.factor
+FROM (VALUES
+    ('KG', 'mass', 1000::numeric),
+    ('G', 'mass', 1),
+    ('MG', 'mass', 0.001),
+    ('MCG', 'mass', 0.000001),
+    ('UG', 'mass', 0.000001),
+    ('NG', 'mass', 0.000000001),
+    ('L', 'volume', 1000),
+    ('ML', 'volume', 1),
+    ('IU', 'activity', 1),
+    ('MOL', 'amount', 1),
+    ('MMOL', 'amount', 0.001)
+) AS k (code, dimension, factor)
+WHERE upper(u.code) = k.code;
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS public.batches_drug_released_idx;
+ALTER TABLE public.dosage_forms DROP COLUMN IF EXISTS form_group;
@@ -0,0 +1,11 @@
+-- 0010_drug_equivalence: dosage forms can be grouped (TAB and FCT in
+-- ORAL-SOLID, say) so GET /drug/:id/equivalents treats them as
+-- interchangeable. Forms start ungrouped and only match themselves.
+
+ALTER TABLE public.dosage_forms
+    ADD COLUMN form_group text,
+    ADD CONSTRAINT dosage_forms_form_group_len CHECK (char_length(form_group) BETWEEN 1 AND 32);
+CREATE INDEX dosage_forms_form_group_idx ON public.dosage_forms (form_group);
+
+-- Equivalents must have a released batch.
+CREATE INDEX batches_drug_released_idx ON public.batches (drug_id) WHERE status = 'released';
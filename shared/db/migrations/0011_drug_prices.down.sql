@@ -0,0 +1,3 @@
+DROP VIEW IF EXISTS public.batch_price_violations;
+DROP TABLE IF EXISTS public.drug_prices;
+ALTER TABLE public.batches DROP COLUMN IF EXISTS pack;
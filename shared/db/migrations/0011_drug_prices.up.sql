@@ -0,0 +1,43 @@
+-- 0011_drug_prices: the pricing committee's maximum prices. Each row is a
+-- ceiling for one drug and currency, optionally for one pack (matched to
+-- batches.pack; '' covers every pack), in force from effective_from until
+-- a later row for the same drug, pack and currency replaces it. Rows are
+-- never overwritten, so the table is the price history.
+
+ALTER TABLE public.batches
+    ADD COLUMN pack text,
+    ADD CONSTRAINT batches_pack_len CHECK (char_length(pack) BETWEEN 1 AND 100);
+
+CREATE TABLE public.drug_prices (
+    id             uuid PRIMARY KEY,
+    drug_id        uuid        NOT NULL REFERENCES public.drugs (id),
+    pack           text        NOT NULL DEFAULT '',
+    currency       text        NOT NULL,
+    max_price      numeric     NOT NULL,
+    effective_from date        NOT NULL,
+    reference      text,
+    created_at     timestamptz NOT NULL DEFAULT now(),
+    updated_at     timestamptz NOT NULL DEFAULT now(),
+    CONSTRAINT drug_prices_effective_key UNIQUE (drug_id, pack, currency, effective_from),
+    CONSTRAINT drug_prices_pack_len CHECK (char_length(pack) <= 100),
+    CONSTRAINT drug_prices_currency_check CHECK (currency ~ '^[A-Z]{3}$'),
+    CONSTRAINT drug_prices_max_price_positive CHECK (max_price > 0),
+    CONSTRAINT drug_prices_reference_len CHECK (char_length(reference) BETWEEN 1 AND 200)
+);
+CREATE INDEX drug_prices_effective_from_id_idx ON public.drug_prices (effective_from, id);
+
+-- A batch violates its price when it costs more than the ceiling in force
+-- on its manufacturing date: the pack's own ceiling if it has one, else the
+-- drug-wide one.
+CREATE VIEW public.batch_price_violations AS
+SELECT b.id, b.drug_id, b.batch_number, COALESCE(b.pack, '') AS pack, b.mfg_date, b.price, b.currency,
+       p.id AS price_id, p.max_price, p.effective_from
+FROM public.batches b
+CROSS JOIN LATERAL (
+    SELECT id, max_price, effective_from FROM public.drug_prices
+    WHERE drug_id = b.drug_id AND currency = b.currency AND pack IN (COALESCE(b.pack, ''), '')
+      AND effective_from <= b.mfg_date
+    ORDER BY pack <> '' DESC, effective_from DESC
+    LIMIT 1
+) p
+WHERE b.price > p.max_price;
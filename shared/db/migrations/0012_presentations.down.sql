@@ -0,0 +1,2 @@
+ALTER TABLE public.batches DROP COLUMN IF EXISTS presentation_id;
+DROP TABLE IF EXISTS public.presentations;
@@ -0,0 +1,37 @@
+-- 0012_presentations: the packs a drug is marketed in. A drug is a
+-- formulation; each presentation of it (10x10 blisters, a 100 mL bottle)
+-- has its own GTIN, stored zero-padded to 14 digits so GTIN-8/12/13 scans
+-- find it too, and may have a national package code. Batches name the
+-- presentation they were packed in, which must be one of their drug's:
+-- the composite key (id, drug_id) lets the foreign key check that.
+
+CREATE TABLE public.presentations (
+    id             uuid PRIMARY KEY,
+    drug_id        uuid        NOT NULL REFERENCES public.drugs (id) ON DELETE CASCADE,
+    gtin           text        NOT NULL,
+    pack_size      numeric     NOT NULL,
+    pack_unit_id   uuid        REFERENCES public.strength_units (id),
+    container_type text        NOT NULL,
+    description    text,
+    local_code     text,
+    created_at     timestamptz NOT NULL DEFAULT now(),
+    updated_at     timestamptz NOT NULL DEFAULT now(),
+    CONSTRAINT presentations_gtin_key UNIQUE (gtin),
+    CONSTRAINT presentations_local_code_key UNIQUE (local_code),
+    CONSTRAINT presentations_id_drug_id_key UNIQUE (id, drug_id),
+    CONSTRAINT presentations_gtin_check CHECK (gtin ~ '^[0-9]{14}$'),
+    CONSTRAINT presentations_pack_size_positive CHECK (pack_size > 0),
+    CONSTRAINT presentations_container_type_check CHECK (container_type IN
+        ('blister', 'bottle', 'vial', 'ampoule', 'tube', 'sachet', 'box', 'jar', 'syringe', 'inhaler', 'bag', 'other')),
+    CONSTRAINT presentations_description_len CHECK (char_length(description) BETWEEN 1 AND 100),
+    CONSTRAINT presentations_local_code_check CHECK (local_code ~ '^[0-9A-Z]+(-[0-9A-Z]+)*$' AND char_length(local_code) <= 20)
+);
+CREATE INDEX presentations_drug_id_idx ON public.presentations (drug_id);
+CREATE INDEX presentations_created_at_id_idx ON public.presentations (created_at, id);
+
+-- Named <table>_<column>_fkey so a violation reports presentation_id.
+ALTER TABLE public.batches
+    ADD COLUMN presentation_id uuid,
+    ADD CONSTRAINT batches_presentation_id_fkey FOREIGN KEY (presentation_id, drug_id)
+        REFERENCES public.presentations (id, drug_id);
+CREATE INDEX batches_presentation_id_idx ON public.batches (presentation_id) WHERE presentation_id IS NOT NULL;
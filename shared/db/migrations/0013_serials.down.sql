@@ -0,0 +1,3 @@
+DROP VIEW IF EXISTS public.serial_clones;
+DROP TABLE IF EXISTS public.serial_events;
+DROP TABLE IF EXISTS public.serials;
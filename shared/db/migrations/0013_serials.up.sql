@@ -0,0 +1,51 @@
+-- 0013_serials: track-and-trace for serialized packs. A serial is one
+-- pack of a batch, identified by the GTIN of the batch's presentation and
+-- a serial number unique under that GTIN. Its chain of custody is an
+-- append-only list of EPCIS-style events (commission, ship, receive,
+-- dispense, decommission), each with where and by whom. An event the
+-- serial's status does not allow is kept with an anomaly code instead of
+-- being refused: a second dispense is how a cloned serial shows up.
+
+CREATE TABLE public.serials (
+    id         uuid PRIMARY KEY,
+    batch_id   uuid        NOT NULL REFERENCES public.batches (id),
+    gtin       text        NOT NULL,
+    serial     text        NOT NULL,
+    status     text        NOT NULL DEFAULT 'commissioned',
+    created_at timestamptz NOT NULL DEFAULT now(),
+    updated_at timestamptz NOT NULL DEFAULT now(),
+    CONSTRAINT serials_gtin_serial_key UNIQUE (gtin, serial),
+    CONSTRAINT serials_gtin_check CHECK (gtin ~ '^[0-9]{14}$'),
+    CONSTRAINT serials_serial_check CHECK (serial ~ '^[!-~]{1,20}$'),
+    CONSTRAINT serials_status_check CHECK (status IN ('commissioned', 'shipped', 'received', 'dispensed', 'decommissioned'))
+);
+CREATE INDEX serials_batch_id_idx ON public.serials (batch_id);
+CREATE INDEX serials_serial_id_idx ON public.serials (serial, id);
+
+CREATE TABLE public.serial_events (
+    id          uuid PRIMARY KEY,
+    serial_id   uuid        NOT NULL REFERENCES public.serials (id) ON DELETE CASCADE,
+    event_type  text        NOT NULL,
+    location    text        NOT NULL,
+    actor       text        NOT NULL,
+    reason      text,
+    anomaly     text,
+    occurred_at timestamptz NOT NULL DEFAULT now(),
+    recorded_at timestamptz NOT NULL DEFAULT now(),
+    CONSTRAINT serial_events_event_type_check CHECK (event_type IN ('commission', 'ship', 'receive', 'dispense', 'decommission')),
+    CONSTRAINT serial_events_anomaly_check CHECK (anomaly IN ('already_dispensed', 'decommissioned', 'out_of_sequence'))
+);
+CREATE INDEX serial_events_serial_id_idx ON public.serial_events (serial_id, occurred_at, recorded_at);
+CREATE INDEX serial_events_dispense_idx ON public.serial_events (serial_id) WHERE event_type = 'dispense';
+
+-- Serials dispensed more than once: the same code is on several packs.
+CREATE VIEW public.serial_clones AS
+SELECT s.id, s.batch_id, s.gtin, s.serial, d.dispenses, d.first_dispensed_at, d.last_dispensed_at
+FROM public.serials s
+JOIN (
+    SELECT serial_id, count(*) AS dispenses, min(occurred_at) AS first_dispensed_at, max(occurred_at) AS last_dispensed_at
+    FROM public.serial_events
+    WHERE event_type = 'dispense'
+    GROUP BY serial_id
+    HAVING count(*) > 1
+) d ON d.serial_id = s.id;
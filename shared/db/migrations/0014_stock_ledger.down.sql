@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS public.stock_movements;
+DROP FUNCTION IF EXISTS public.stock_movements_append_only();
+ALTER TABLE public.batches DROP COLUMN IF EXISTS qty_remaining;
@@ -0,0 +1,40 @@
+-- 0014_stock_ledger: an append-only ledger of what happens to the units of
+-- a batch (release to market, distribution, returns, destruction), and the
+-- batch's remaining quantity it keeps up to date. Movements lock the batch
+-- row, and the check on qty_remaining backs the application's own test, so
+-- no interleaving of movements can take a batch below zero. Batches already
+-- sold out open with nothing remaining.
+
+ALTER TABLE public.batches ADD COLUMN qty_remaining bigint;
+UPDATE public.batches SET qty_remaining = CASE WHEN status = 'sold_out' THEN 0 ELSE qty_in_batch END;
+ALTER TABLE public.batches
+    ALTER COLUMN qty_remaining SET NOT NULL,
+    ADD CONSTRAINT batches_qty_remaining_check CHECK (qty_remaining BETWEEN 0 AND qty_in_batch);
+
+CREATE TABLE public.stock_movements (
+    id            uuid PRIMARY KEY,
+    batch_id      uuid        NOT NULL REFERENCES public.batches (id),
+    movement_type text        NOT NULL,
+    quantity      bigint      NOT NULL,
+    balance_after bigint      NOT NULL,
+    reference     text,
+    counterparty  text,
+    note          text,
+    created_by    text        NOT NULL,
+    created_at    timestamptz NOT NULL DEFAULT now(),
+    CONSTRAINT stock_movements_movement_type_check CHECK (movement_type IN ('release', 'distribution', 'return', 'destruction')),
+    CONSTRAINT stock_movements_quantity_positive CHECK (quantity > 0),
+    CONSTRAINT stock_movements_balance_after_check CHECK (balance_after >= 0)
+);
+CREATE INDEX stock_movements_batch_id_created_at_idx ON public.stock_movements (batch_id, created_at, id);
+
+-- The ledger is append-only: corrections are further movements.
+CREATE FUNCTION public.stock_movements_append_only() RETURNS trigger
+LANGUAGE plpgsql AS $$
+BEGIN
+    RAISE EXCEPTION 'stock_movements is append-only';
+END;
+$$;
+CREATE TRIGGER stock_movements_append_only
+    BEFORE UPDATE OR DELETE ON public.stock_movements
+    FOR EACH ROW EXECUTE FUNCTION public.stock_movements_append_only();
@@ -0,0 +1,33 @@
+-- Packs come back as the presentation's description, or its GTIN if it has none.
+DROP VIEW IF EXISTS public.batch_price_violations;
+
+ALTER TABLE public.batches
+    ADD COLUMN pack text,
+    ADD CONSTRAINT batches_pack_len CHECK (char_length(pack) BETWEEN 1 AND 100);
+UPDATE public.batches b
+SET pack = (SELECT COALESCE(p.description, p.gtin) FROM public.presentations p WHERE p.id = b.presentation_id)
+WHERE b.presentation_id IS NOT NULL;
+
+DROP INDEX IF EXISTS public.drug_prices_effective_key;
+ALTER TABLE public.drug_prices
+    ADD COLUMN pack text NOT NULL DEFAULT '',
+    ADD CONSTRAINT drug_prices_pack_len CHECK (char_length(pack) <= 100);
+UPDATE public.drug_prices d
+SET pack = (SELECT COALESCE(p.description, p.gtin) FROM public.presentations p WHERE p.id = d.presentation_id)
+WHERE d.presentation_id IS NOT NULL;
+ALTER TABLE public.drug_prices
+    DROP COLUMN presentation_id,
+    ADD CONSTRAINT drug_prices_effective_key UNIQUE (drug_id, pack, currency, effective_from);
+
+CREATE VIEW public.batch_price_violations AS
+SELECT b.id, b.drug_id, b.batch_number, COALESCE(b.pack, '') AS pack, b.mfg_date, b.price, b.currency,
+       p.id AS price_id, p.max_price, p.effective_from
+FROM public.batches b
+CROSS JOIN LATERAL (
+    SELECT id, max_price, effective_from FROM public.drug_prices
+    WHERE drug_id = b.drug_id AND currency = b.currency AND pack IN (COALESCE(b.pack, ''), '')
+      AND effective_from <= b.mfg_date
+    ORDER BY pack <> '' DESC, effective_from DESC
+    LIMIT 1
+) p
+WHERE b.price > p.max_price;
@@ -0,0 +1,75 @@
+-- 0015_price_presentations: price ceilings name the presentation they cover
+-- instead of a free-text pack, and batches lose their pack column, which
+-- could name one pack while presentation_id named another. Existing pack
+-- texts are matched, case-insensitively, to the description of exactly one
+-- presentation of the same drug. A ceiling or batch whose pack matches none
+-- stops the migration: dropping it would widen a pack's ceiling to the
+-- whole drug. Add the presentation, or correct the pack, and run it again.
+
+ALTER TABLE public.drug_prices ADD COLUMN presentation_id uuid;
+
+UPDATE public.drug_prices d
+SET presentation_id = (
+    SELECT p.id FROM public.presentations p
+    WHERE p.drug_id = d.drug_id AND lower(p.description) = lower(d.pack)
+)
+WHERE d.pack <> ''
+  AND (SELECT count(*) FROM public.presentations p
+       WHERE p.drug_id = d.drug_id AND lower(p.description) = lower(d.pack)) = 1;
+
+UPDATE public.batches b
+SET presentation_id = (
+    SELECT p.id FROM public.presentations p
+    WHERE p.drug_id = b.drug_id AND lower(p.description) = lower(b.pack)
+)
+WHERE b.presentation_id IS NULL AND b.pack IS NOT NULL
+  AND (SELECT count(*) FROM public.presentations p
+       WHERE p.drug_id = b.drug_id AND lower(p.description) = lower(b.pack)) = 1;
+
+DO $$
+DECLARE
+    r record;
+BEGIN
+    SELECT id, pack INTO r FROM public.drug_prices WHERE pack <> '' AND presentation_id IS NULL LIMIT 1;
+    IF FOUND THEN
+        RAISE EXCEPTION 'drug price %: pack "%" is not the description of exactly one presentation of its drug', r.id, r.pack;
+    END IF;
+    SELECT id, pack INTO r FROM public.batches WHERE pack IS NOT NULL AND presentation_id IS NULL LIMIT 1;
+    IF FOUND THEN
+        RAISE EXCEPTION 'batch %: pack "%" is not the description of exactly one presentation of its drug', r.id, r.pack;
+    END IF;
+END
+$$;
+
+DROP VIEW public.batch_price_violations;
+
+-- Named <table>_<column>_fkey so a violation reports presentation_id; the
+-- composite key keeps a ceiling on one of its own drug's presentations.
+ALTER TABLE public.drug_prices
+    DROP CONSTRAINT drug_prices_effective_key,
+    DROP COLUMN pack,
+    ADD CONSTRAINT drug_prices_presentation_id_fkey FOREIGN KEY (presentation_id, drug_id)
+        REFERENCES public.presentations (id, drug_id);
+-- One ceiling per drug, presentation (or none, for the drug-wide one),
+-- currency and date.
+CREATE UNIQUE INDEX drug_prices_effective_key ON public.drug_prices
+    (drug_id, COALESCE(presentation_id, '00000000-0000-0000-0000-000000000000'::uuid), currency, effective_from);
+
+ALTER TABLE public.batches DROP COLUMN pack;
+
+-- As in 0011, keyed on the presentation: a batch violates its price when it
+-- costs more than the ceiling in force on its manufacturing date, its
+-- presentation's own if it has one, else the drug-wide one.
+CREATE VIEW public.batch_price_violations AS
+SELECT b.id, b.drug_id, b.batch_number, COALESCE(b.presentation_id::text, '') AS presentation_id, b.mfg_date, b.price, b.currency,
+       p.id AS price_id, p.max_price, p.effective_from
+FROM public.batches b
+CROSS JOIN LATERAL (
+    SELECT id, max_price, effective_from FROM public.drug_prices
+    WHERE drug_id = b.drug_id AND currency = b.currency
+      AND (presentation_id IS NULL OR presentation_id = b.presentation_id)
+      AND effective_from <= b.mfg_date
+    ORDER BY presentation_id IS NOT NULL DESC, effective_from DESC
+    LIMIT 1
+) p
+WHERE b.price > p.max_price;
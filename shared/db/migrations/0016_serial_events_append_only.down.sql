@@ -0,0 +1,5 @@
+DROP TRIGGER IF EXISTS serial_events_append_only ON public.serial_events;
+DROP FUNCTION IF EXISTS public.serial_events_append_only();
+ALTER TABLE public.serial_events
+    DROP CONSTRAINT serial_events_serial_id_fkey,
+    ADD CONSTRAINT serial_events_serial_id_fkey FOREIGN KEY (serial_id) REFERENCES public.serials (id) ON DELETE CASCADE;
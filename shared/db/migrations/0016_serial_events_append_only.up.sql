@@ -0,0 +1,18 @@
+-- 0016_serial_events_append_only: a pack's chain of custody is evidence
+-- and, like the stock ledger, is only ever added to. Deleting a serial no
+-- longer takes its events with it; the serial must stay for as long as
+-- its events do, which is always.
+
+ALTER TABLE public.serial_events
+    DROP CONSTRAINT serial_events_serial_id_fkey,
+    ADD CONSTRAINT serial_events_serial_id_fkey FOREIGN KEY (serial_id) REFERENCES public.serials (id) ON DELETE RESTRICT;
+
+CREATE FUNCTION public.serial_events_append_only() RETURNS trigger
+LANGUAGE plpgsql AS $$
+BEGIN
+    RAISE EXCEPTION 'serial_events is append-only';
+END;
+$$;
+CREATE TRIGGER serial_events_append_only
+    BEFORE UPDATE OR DELETE ON public.serial_events
+    FOR EACH ROW EXECUTE FUNCTION public.serial_events_append_only();
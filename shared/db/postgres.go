@@ -8,21 +8,23 @@ import (
 	"log"
 	"time"
 
+	"moh/shared/config"
+
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
-func Open(ctx context.Context, connStr string) (*pgxpool.Pool, error) {
-	cfg, err := pgxpool.ParseConfig(connStr)
+func Open(ctx context.Context, c config.DBConfig) (*pgxpool.Pool, error) {
+	cfg, err := pgxpool.ParseConfig(c.DSN)
 	if err != nil {
 		return nil, fmt.Errorf("parse conn string: %w", err)
 	}
 
-	// Optional tuning
-	cfg.MinConns = 1
-	cfg.MaxConns = 10
-	cfg.HealthCheckPeriod = 30 * time.Second
-	cfg.MaxConnLifetime = 30 * time.Minute
-	cfg.MaxConnIdleTime = 10 * time.Minute
+	// Pool tuning
+	cfg.MinConns = c.MinConns
+	cfg.MaxConns = c.MaxConns
+	cfg.HealthCheckPeriod = time.Duration(c.HealthCheckPeriod)
+	cfg.MaxConnLifetime = time.Duration(c.MaxConnLifetime)
+	cfg.MaxConnIdleTime = time.Duration(c.MaxConnIdleTime)
 
 	pool, err := pgxpool.NewWithConfig(ctx, cfg)
 	if err != nil {
@@ -41,8 +43,8 @@ func Open(ctx context.Context, connStr string) (*pgxpool.Pool, error) {
 	return pool, nil
 }
 
-func MustOpen(ctx context.Context, connStr string) *pgxpool.Pool {
-	pool, err := Open(ctx, connStr)
+func MustOpen(ctx context.Context, c config.DBConfig) *pgxpool.Pool {
+	pool, err := Open(ctx, c)
 	if err != nil {
 		log.Fatalf("❌ open db: %v", err)
 	}
@@ -1 +1,191 @@
-package shared
\ No newline at end of file
+// Package shared holds the domain error type every layer reports failures
+// with. Services create typed errors, adapters map their Kind to a transport
+// status (see shared/responed for HTTP), and nothing else decides what a
+// client is told.
+package shared
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Kind classifies an error by whose fault it is and how a client should react.
+type Kind uint8
+
+const (
+	// KindInternal is an unexpected failure: a lost connection, a query bug.
+	// Clients only learn that something went wrong; the cause is logged.
+	KindInternal Kind = iota
+	// KindBadRequest is a request that cannot be understood: malformed JSON,
+	// an id that is not a UUID, an unknown sort field.
+	KindBadRequest
+	// KindValidation is well-formed input that breaks a field rule.
+	KindValidation
+	// KindNotFound is a missing row addressed by id.
+	KindNotFound
+	// KindConflict clashes with current state: a duplicate key, a row still
+	// in use, a status change not allowed from the current status.
+	KindConflict
+	// KindForeignKey is a reference to a row that does not exist.
+	KindForeignKey
+	// KindUnauthorized is a missing or invalid credential.
+	KindUnauthorized
+	// KindForbidden is a valid credential without the required role.
+	KindForbidden
+)
+
+var kindNames = [...]string{"internal", "bad_request", "validation", "not_found", "conflict", "foreign_key", "unauthorized", "forbidden"}
+
+func (k Kind) String() string {
+	if int(k) < len(kindNames) {
+		return kindNames[k]
+	}
+	return "unknown"
+}
+
+// Error is a domain error. Its message is the catalog entry Key filled in
+// with Args (see messages.go), so adapters can render it in the client's
+// language with Localize; Detail holds the English rendering. Neither may
+// contain text from the database or other dependencies; Err, the cause, is
+// only for logs.
+type Error struct {
+	Kind   Kind
+	Code   string // stable, machine-readable, e.g. "already_exists"
+	Field  string // JSON name of the offending field, if there is one
+	Detail string
+	Key    string       // catalog key of the message; empty for validation errors
+	Args   []string     // arguments of the message
+	Path   string       // element of the request that failed, set by Within
+	Fields []FieldError // every failed rule, for validation errors
+	Err    error
+}
+
+// FieldError is one failed rule on one field of a request. Key and Args
+// name its catalog message when that is not simply the one for Rule.
+type FieldError struct {
+	Field   string   `json:"field"` // JSON path, e.g. "expire_date" or "sites[0].site_id"
+	Rule    string   `json:"rule"`  // the validate tag, e.g. "required", "max"
+	Param   string   `json:"param,omitempty"`
+	Message string   `json:"message"`
+	Key     string   `json:"-"`
+	Args    []string `json:"-"`
+}
+
+// NewFieldError reports that field broke rule, with the English message of
+// the catalog entry key (Rule's own when key is empty).
+func NewFieldError(field, rule, param, key string, args ...string) FieldError {
+	f := FieldError{Field: field, Rule: rule, Param: param, Key: key, Args: args}
+	f.Message = f.render(English)
+	return f
+}
+
+func (e *Error) Error() string { return e.Detail }
+
+func (e *Error) Unwrap() error { return e.Err }
+
+// Is reports whether target is an *Error of the same kind and code, so a
+// freshly built error matches a sentinel such as services.ErrNotFound.
+func (e *Error) Is(target error) bool {
+	t, ok := target.(*Error)
+	return ok && t.Kind == e.Kind && t.Code == e.Code
+}
+
+func newError(kind Kind, code, key string, args []string) *Error {
+	e := &Error{Kind: kind, Code: code, Key: key, Args: args}
+	e.Detail = e.render(English)
+	return e
+}
+
+// BadRequest reports a request that cannot be understood.
+func BadRequest(code, key string, args ...string) error {
+	return newError(KindBadRequest, code, key, args)
+}
+
+// Validation reports input that breaks one or more field rules. Detail
+// joins their messages; Field is set when only one field failed.
+func Validation(fields ...FieldError) error {
+	e := &Error{Kind: KindValidation, Code: "validation_failed", Fields: fields}
+	e.Detail = e.render(English)
+	if len(fields) == 1 {
+		e.Field = fields[0].Field
+	}
+	return e
+}
+
+// NotFound reports a missing row.
+func NotFound(key string, args ...string) error {
+	return newError(KindNotFound, "not_found", key, args)
+}
+
+// Conflict reports a clash with current state.
+func Conflict(code, key string, args ...string) error {
+	return newError(KindConflict, code, key, args)
+}
+
+// ForeignKey reports that field refers to a row that does not exist. The
+// message is the catalog entry key, or "invalid foreign key: <field>" when
+// key is empty.
+func ForeignKey(field, key string, args ...string) error {
+	if key == "" {
+		key, args = "invalid_reference", []string{field}
+	}
+	e := newError(KindForeignKey, "invalid_reference", key, args)
+	e.Field = field
+	return e
+}
+
+// Unauthorized reports a missing or invalid credential.
+func Unauthorized(key string) error {
+	return newError(KindUnauthorized, "unauthorized", key, nil)
+}
+
+// Forbidden reports a caller without the required role.
+func Forbidden(key string) error {
+	return newError(KindForbidden, "forbidden", key, nil)
+}
+
+// Internal wraps an unexpected failure. Its message is always "internal
+// error"; err is kept for logging.
+func Internal(err error) error {
+	e := newError(KindInternal, "internal", "internal", nil)
+	e.Err = err
+	return e
+}
+
+// Within reports err as a failure of the element at path, e.g. "sites[1]"
+// of a request that holds several: the message is prefixed with path and so
+// are the field names, which become "sites[1].site_id".
+func Within(path string, err error) error {
+	var e *Error
+	if !errors.As(err, &e) || e.Kind == KindInternal {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+	out := *e
+	out.Path = path
+	if e.Path != "" {
+		out.Path += ": " + e.Path
+	}
+	if e.Field != "" {
+		out.Field = path + "." + e.Field
+	}
+	if e.Fields != nil {
+		out.Fields = make([]FieldError, len(e.Fields))
+		for i, f := range e.Fields {
+			f.Field = path + "." + f.Field
+			f.Message = f.render(English)
+			out.Fields[i] = f
+		}
+	}
+	out.Detail = out.render(English)
+	return &out
+}
+
+// As returns the first *Error in err's chain. Errors that carry none are
+// reported as internal, wrapping err.
+func As(err error) *Error {
+	var e *Error
+	if errors.As(err, &e) {
+		return e
+	}
+	return Internal(err).(*Error)
+}
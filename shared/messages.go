@@ -0,0 +1,253 @@
+package shared
+
+// catalog holds every client-facing message by locale and key. Field rule
+// messages are keyed by validate tag (with ".string" variants where strings
+// read differently) and see {0} field, {1} rule parameter; the others take
+// the arguments their constructor is given. Placeholders must run from {0}
+// without gaps, and every key needs an entry in each locale.
+var catalog = map[string]map[string]string{
+	LocaleEnglish: {
+		// Field rules.
+		"required":         "{0} is required",
+		"len":              "{0} must have exactly {1} items",
+		"len.string":       "{0} must be exactly {1} characters",
+		"min":              "{0} must be at least {1}",
+		"min.string":       "{0} must be at least {1} characters",
+		"max":              "{0} must be at most {1}",
+		"max.string":       "{0} must be at most {1} characters",
+		"gt":               "{0} must be greater than {1}",
+		"gte":              "{0} must be greater than or equal to {1}",
+		"lt":               "{0} must be less than {1}",
+		"lte":              "{0} must be less than or equal to {1}",
+		"email":            "{0} must be a valid email address",
+		"email_domain":     "{0} must be a @school.edu address",
+		"alpha":            "{0} must contain only letters (no spaces)",
+		"alphanum":         "{0} must be alphanumeric (no spaces)",
+		"alpha_space":      "{0} must contain only letters and spaces",
+		"alphanum_space":   "{0} must be letters, numbers, and spaces",
+		"uppercase":        "{0} must be uppercase",
+		"required_with":    "{0} is required when {1} is present",
+		"required_without": "{0} is required when {1} is missing",
+		"uuid":             "{0} must be a valid UUID",
+		"json":             "{0} must be valid JSON",
+		"excluded":         "{0} must not be set",
+		"notblank":         "{0} must not be blank",
+		"oneof":            "{0} must be one of {1}",
+		"unique":           "{0} must not contain duplicates",
+		"max_scale":        "{0} must have at most {1} decimal places",
+		"gt_mfg":           "{0} must be after {1}",
+		"gt_valid_from":    "{0} must be after {1}",
+		"gtefield":         "{0} must not be before {1}",
+		"default_locale":   "{0} must include a name in the default language (en)",
+		"gtin":             "{0} must be a GTIN of 8, 12, 13 or 14 digits with a valid check digit",
+		"local_code":       "{0} must be letters and digits, optionally in groups separated by hyphens",
+		"gs1_chars":        "{0} must be printable ASCII without spaces (GS1 character set 82)",
+		"invalid":          "{0} is invalid",
+
+		"initial_status":      "{0} of a new batch must be one of {1}, not {2}",
+		"required_for_status": "{0} is required to move a batch to {1}",
+		"required_for_basis":  "{0} is required when the strength basis is {1}",
+		"required_for_event":  "{0} is required for a {1} event",
+
+		// Request errors.
+		"invalid_id":           "id must be a valid UUID",
+		"invalid_json":         "malformed JSON body: {0}",
+		"invalid_json_body":    "invalid JSON body",
+		"invalid_body":         "failed to read request body",
+		"invalid_query":        "invalid query",
+		"query_parse":          "invalid query: {0}",
+		"unknown_sort":         "invalid query: unknown sort field \"{0}\"",
+		"malformed_cursor":     "invalid query: malformed cursor",
+		"cursor_sort":          "invalid query: cursor was issued for sort \"{0}\"",
+		"unknown_unit":         "invalid query: unknown strength unit {0}",
+		"unit_not_convertible": "invalid query: {0} has no dimension and cannot be converted",
+		"incompatible_units":   "invalid query: cannot convert {0} ({1}) to {2} ({3})",
+		"invalid_gtin":         "{0} is not a valid GTIN",
+		"gs1_empty":            "the barcode payload is empty",
+		"gs1_unknown_ai":       "invalid GS1 payload: unknown application identifier at \"{0}\"",
+		"gs1_length":           "invalid GS1 payload: ({0}) must have {1} characters",
+		"gs1_max_length":       "invalid GS1 payload: ({0}) must have 1 to {1} characters",
+		"gs1_invalid":          "invalid GS1 payload: ({0}) \"{1}\" is not valid",
+		"gs1_repeated":         "invalid GS1 payload: ({0}) appears more than once",
+
+		// Authentication.
+		"auth_missing":  "authorization header is missing",
+		"auth_format":   "invalid authorization header format",
+		"token_expired": "token has expired",
+		"token_invalid": "invalid token",
+		"auth_required": "authentication required",
+		"forbidden":     "insufficient role for this operation",
+
+		// Service errors.
+		"internal":                       "internal error",
+		"not_found":                      "not found",
+		"in_use":                         "still referenced by other records",
+		"in_use_by":                      "still referenced by other records: referenced by {0}",
+		"invalid_reference":              "invalid foreign key: {0}",
+		"batch_missing":                  "batch {0} does not exist",
+		"illegal_transition":             "illegal status transition",
+		"illegal_transition_from":        "illegal status transition: {0} → {1}",
+		"recall_only":                    "illegal status transition: batches are recalled by opening or extending a recall",
+		"stock_ledger_only":              "illegal status transition: a batch with stock movements is sold out by the movement that uses up its last units",
+		"sold_out_no_stock":              "illegal status transition: sold-out batch {0} has no stock to release",
+		"use_transition_endpoints":       "illegal status transition: use the batch transition endpoints to change status",
+		"recall_not_open":                "illegal status transition: recall {0} is {1}",
+		"code_exists":                    "code already exists",
+		"name_exists":                    "name already exists",
+		"auth_holder_exists":             "auth holder already exists",
+		"marketing_authorization_exists": "marketing authorization already exists",
+		"manufacturing_site_exists":      "manufacturing site already exists",
+		"drug_exists":                    "drug already exists",
+		"registration_exists":            "registration already exists",
+		"link_exists":                    "link already exists",
+		"ingredient_exists":              "the drug already has this ingredient",
+		"batch_exists":                   "batch already exists",
+		"similar_brand":                  "brand name looks or sounds like registered brands: {0}; resend with override_lasa=true to register it anyway",
+		"price_exists":                   "a ceiling for this drug, pack and currency already takes effect on that date",
+		"price_above_ceiling":            "price {0} is above the ceiling of {1} in force since {2}",
+		"presentation_exists":            "a presentation with this GTIN or local code already exists",
+		"serial_unknown":                 "no pack with GTIN {0} and serial number {1} is registered",
+		"serials_exist":                  "serial numbers already registered under GTIN {0}: {1}",
+		"serial_exists":                  "a serial number in the upload is already registered",
+		"batch_no_presentation":          "batch {0} has no presentation, so its packs have no GTIN to serialize under",
+		"insufficient_stock":             "batch {0} has {1} units left, fewer than the {2} requested",
+		"stock_not_released":             "batch {0} is {1}; only released batches can be released to market or distributed",
+		"return_exceeds_issued":          "a return of {0} units exceeds the {1} issued from batch {2}",
+		"qty_below_moved":                "qty_in_batch cannot be less than the quantity already moved out of the batch",
+		"qty_fixed_by_ledger":            "batch {0} has stock movements, so its qty_in_batch can no longer change; record a movement instead",
+		"qty_changes_status":             "this qty_in_batch would make batch {0} {1}; only stock movements change that",
+
+		// Product verification reasons.
+		"verify_no_gtin":         "the code carries no GTIN (01)",
+		"verify_no_batch":        "the code carries no batch number (10)",
+		"verify_no_expiry":       "the code carries no expiry date (17)",
+		"verify_unknown_gtin":    "GTIN {0} is not registered",
+		"verify_unknown_batch":   "batch {0} is not registered for this product",
+		"verify_wrong_pack":      "batch {0} was not packed in this presentation",
+		"verify_expiry_mismatch": "the printed expiry {0} does not match the registered expiry {1}",
+		"verify_recalled":        "batch {0} has been recalled",
+		"verify_expired":         "batch {0} expired on {1}",
+		"verify_not_released":    "batch {0} is {1} and not released for sale",
+		"verify_genuine":         "batch {0} of {1} is registered and released",
+	},
+	LocaleArabic: {
+		"required":         "{0} مطلوب",
+		"len":              "يجب أن يحتوي {0} على {1} عناصر بالضبط",
+		"len.string":       "يجب أن يتكون {0} من {1} أحرف بالضبط",
+		"min":              "يجب ألا يقل {0} عن {1}",
+		"min.string":       "يجب ألا يقل طول {0} عن {1} أحرف",
+		"max":              "يجب ألا يزيد {0} عن {1}",
+		"max.string":       "يجب ألا يزيد طول {0} عن {1} أحرف",
+		"gt":               "يجب أن يكون {0} أكبر من {1}",
+		"gte":              "يجب أن يكون {0} أكبر من أو يساوي {1}",
+		"lt":               "يجب أن يكون {0} أصغر من {1}",
+		"lte":              "يجب أن يكون {0} أصغر من أو يساوي {1}",
+		"email":            "يجب أن يكون {0} بريدًا إلكترونيًا صالحًا",
+		"email_domain":     "يجب أن يكون {0} عنوانًا ضمن النطاق ‎@school.edu",
+		"alpha":            "يجب أن يحتوي {0} على أحرف فقط (بدون مسافات)",
+		"alphanum":         "يجب أن يحتوي {0} على أحرف وأرقام فقط (بدون مسافات)",
+		"alpha_space":      "يجب أن يحتوي {0} على أحرف ومسافات فقط",
+		"alphanum_space":   "يجب أن يحتوي {0} على أحرف وأرقام ومسافات فقط",
+		"uppercase":        "يجب أن يكون {0} بأحرف كبيرة",
+		"required_with":    "{0} مطلوب عند وجود {1}",
+		"required_without": "{0} مطلوب عند غياب {1}",
+		"uuid":             "يجب أن يكون {0} معرّف UUID صالحًا",
+		"json":             "يجب أن يكون {0} نص JSON صالحًا",
+		"excluded":         "يجب عدم تعيين {0}",
+		"notblank":         "يجب ألا يكون {0} فارغًا",
+		"oneof":            "يجب أن تكون قيمة {0} إحدى القيم: {1}",
+		"unique":           "يجب ألا يحتوي {0} على قيم مكررة",
+		"max_scale":        "يجب ألا يزيد عدد المنازل العشرية في {0} عن {1}",
+		"gt_mfg":           "يجب أن يكون {0} بعد {1}",
+		"gt_valid_from":    "يجب أن يكون {0} بعد {1}",
+		"gtefield":         "يجب ألا يكون {0} قبل {1}",
+		"default_locale":   "يجب أن يتضمن {0} اسمًا باللغة الافتراضية (en)",
+		"gtin":             "يجب أن يكون {0} رقم GTIN من 8 أو 12 أو 13 أو 14 خانة برقم تحقق صحيح",
+		"local_code":       "يجب أن يتكون {0} من حروف وأرقام، ويجوز فصلها في مجموعات بشرطات",
+		"gs1_chars":        "يجب أن يتكون {0} من أحرف ASCII قابلة للطباعة دون مسافات (مجموعة أحرف GS1 رقم 82)",
+		"invalid":          "{0} غير صالح",
+
+		"initial_status":      "يجب أن تكون قيمة {0} للدفعة الجديدة إحدى القيم: {1}، وليس {2}",
+		"required_for_status": "{0} مطلوب لنقل الدفعة إلى الحالة {1}",
+		"required_for_basis":  "{0} مطلوب عندما يكون أساس التركيز {1}",
+		"required_for_event":  "{0} مطلوب لحدث {1}",
+
+		"invalid_id":           "يجب أن يكون المعرّف UUID صالحًا",
+		"invalid_json":         "نص JSON غير سليم: {0}",
+		"invalid_json_body":    "نص JSON في الطلب غير صالح",
+		"invalid_body":         "تعذّرت قراءة نص الطلب",
+		"invalid_query":        "استعلام غير صالح",
+		"query_parse":          "استعلام غير صالح: {0}",
+		"unknown_sort":         "استعلام غير صالح: حقل الترتيب \"{0}\" غير معروف",
+		"malformed_cursor":     "استعلام غير صالح: مؤشر الصفحة تالف",
+		"cursor_sort":          "استعلام غير صالح: صدر المؤشر للترتيب \"{0}\"",
+		"unknown_unit":         "استعلام غير صالح: وحدة التركيز {0} غير معروفة",
+		"unit_not_convertible": "استعلام غير صالح: الوحدة {0} بلا بُعد ولا يمكن تحويلها",
+		"incompatible_units":   "استعلام غير صالح: لا يمكن تحويل {0} ({1}) إلى {2} ({3})",
+		"invalid_gtin":         "{0} ليس رقم GTIN صالحًا",
+		"gs1_empty":            "محتوى الرمز الشريطي فارغ",
+		"gs1_unknown_ai":       "محتوى GS1 غير صالح: معرّف تطبيق غير معروف عند \"{0}\"",
+		"gs1_length":           "محتوى GS1 غير صالح: يجب أن يتكون ({0}) من {1} خانات",
+		"gs1_max_length":       "محتوى GS1 غير صالح: يجب أن يتكون ({0}) من خانة واحدة إلى {1} خانة",
+		"gs1_invalid":          "محتوى GS1 غير صالح: القيمة \"{1}\" في ({0}) غير صالحة",
+		"gs1_repeated":         "محتوى GS1 غير صالح: ({0}) مكرر أكثر من مرة",
+
+		"auth_missing":  "ترويسة التفويض مفقودة",
+		"auth_format":   "صيغة ترويسة التفويض غير صالحة",
+		"token_expired": "انتهت صلاحية الرمز المميز",
+		"token_invalid": "رمز مميز غير صالح",
+		"auth_required": "المصادقة مطلوبة",
+		"forbidden":     "لا تملك الدور المطلوب لهذه العملية",
+
+		"internal":                       "خطأ داخلي",
+		"not_found":                      "غير موجود",
+		"in_use":                         "لا يزال مرتبطًا بسجلات أخرى",
+		"in_use_by":                      "لا يزال مرتبطًا بسجلات أخرى: مُشار إليه في {0}",
+		"invalid_reference":              "مرجع غير صالح: {0}",
+		"batch_missing":                  "الدفعة {0} غير موجودة",
+		"illegal_transition":             "انتقال حالة غير مسموح",
+		"illegal_transition_from":        "انتقال حالة غير مسموح: من {0} إلى {1}",
+		"recall_only":                    "انتقال حالة غير مسموح: تُسحب الدفعات بفتح استدعاء أو توسيعه",
+		"stock_ledger_only":              "انتقال حالة غير مسموح: الدفعة التي لها حركات مخزون تنفد بالحركة التي تستهلك آخر وحداتها",
+		"sold_out_no_stock":              "انتقال حالة غير مسموح: لا مخزون في الدفعة النافدة {0} للإفراج عنه",
+		"use_transition_endpoints":       "انتقال حالة غير مسموح: استخدم نقاط انتقال حالة الدفعة لتغيير الحالة",
+		"recall_not_open":                "انتقال حالة غير مسموح: الاستدعاء {0} في الحالة {1}",
+		"code_exists":                    "الرمز موجود مسبقًا",
+		"name_exists":                    "الاسم موجود مسبقًا",
+		"auth_holder_exists":             "صاحب الترخيص موجود مسبقًا",
+		"marketing_authorization_exists": "ترخيص التسويق موجود مسبقًا",
+		"manufacturing_site_exists":      "موقع التصنيع موجود مسبقًا",
+		"drug_exists":                    "الدواء موجود مسبقًا",
+		"registration_exists":            "التسجيل موجود مسبقًا",
+		"link_exists":                    "الارتباط موجود مسبقًا",
+		"ingredient_exists":              "الدواء يحتوي على هذه المادة الفعالة مسبقًا",
+		"batch_exists":                   "الدفعة موجودة مسبقًا",
+		"similar_brand":                  "الاسم التجاري يشبه في الكتابة أو النطق أسماء مسجلة: {0}؛ أعد الإرسال مع override_lasa=true لتسجيله رغم ذلك",
+		"price_exists":                   "يوجد سقف سعري لهذا الدواء والعبوة والعملة يسري من التاريخ نفسه",
+		"price_above_ceiling":            "السعر {0} أعلى من السقف السعري {1} الساري منذ {2}",
+		"presentation_exists":            "توجد عبوة بهذا الرقم GTIN أو الرمز المحلي مسبقًا",
+		"serial_unknown":                 "لا توجد عبوة مسجلة بالرقم GTIN {0} والرقم التسلسلي {1}",
+		"serials_exist":                  "أرقام تسلسلية مسجلة مسبقًا تحت الرقم GTIN {0}: {1}",
+		"serial_exists":                  "أحد الأرقام التسلسلية في الملف مسجل مسبقًا",
+		"batch_no_presentation":          "التشغيلة {0} ليس لها عرض عبوة، فلا يوجد رقم GTIN تُسلسل عبواتها تحته",
+		"insufficient_stock":             "تبقى في التشغيلة {0} عدد {1} وحدة، وهو أقل من {2} المطلوبة",
+		"stock_not_released":             "حالة التشغيلة {0} هي {1}؛ لا يمكن طرح أو توزيع إلا التشغيلات المفرج عنها",
+		"return_exceeds_issued":          "إرجاع {0} وحدة يتجاوز {1} وحدة صُرفت من التشغيلة {2}",
+		"qty_below_moved":                "لا يمكن أن تقل qty_in_batch عن الكمية التي خرجت من التشغيلة",
+		"qty_fixed_by_ledger":            "للتشغيلة {0} حركات مخزون، فلم يعد ممكنًا تغيير qty_in_batch؛ سجّل حركة بدلًا من ذلك",
+		"qty_changes_status":             "قيمة qty_in_batch هذه تجعل حالة التشغيلة {0} هي {1}؛ لا تغيّر ذلك إلا حركات المخزون",
+
+		// Product verification reasons.
+		"verify_no_gtin":         "لا يحمل الرمز رقم GTIN (01)",
+		"verify_no_batch":        "لا يحمل الرمز رقم التشغيلة (10)",
+		"verify_no_expiry":       "لا يحمل الرمز تاريخ انتهاء الصلاحية (17)",
+		"verify_unknown_gtin":    "رقم GTIN {0} غير مسجل",
+		"verify_unknown_batch":   "التشغيلة {0} غير مسجلة لهذا المنتج",
+		"verify_wrong_pack":      "لم تُعبّأ التشغيلة {0} في هذه العبوة",
+		"verify_expiry_mismatch": "تاريخ الانتهاء المطبوع {0} لا يطابق تاريخ الانتهاء المسجل {1}",
+		"verify_recalled":        "تم سحب التشغيلة {0}",
+		"verify_expired":         "انتهت صلاحية التشغيلة {0} في {1}",
+		"verify_not_released":    "التشغيلة {0} في حالة {1} وغير مفرج عنها للبيع",
+		"verify_genuine":         "التشغيلة {0} من {1} مسجلة ومفرج عنها",
+	},
+}
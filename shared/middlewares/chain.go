@@ -0,0 +1,11 @@
+package middleware
+
+import "github.com/gin-gonic/gin"
+
+// Global is the middleware every route runs behind, in order: CORS for
+// allowedOrigins, the response time header, security headers and input
+// sanitizing.
+// Usage: r.Use(middlewares.Global(cfg.CORS.AllowedOrigins)...)
+func Global(allowedOrigins []string) []gin.HandlerFunc {
+	return []gin.HandlerFunc{CORS(allowedOrigins), ResponseTimeGin(), SecurityHeaderGin(), XssValidatorGin()}
+}
@@ -6,13 +6,30 @@ import (
 	"github.com/gin-gonic/gin"
 )
 
-// CORS allows cross-origin requests (Gin version).
-// Usage: r.Use(middlewares.CORS())
-func CORS() gin.HandlerFunc {
+// CORS allows cross-origin requests from allowedOrigins (Gin version).
+// A "*" entry allows any origin.
+// Usage: r.Use(middlewares.CORS(cfg.CORS.AllowedOrigins))
+func CORS(allowedOrigins []string) gin.HandlerFunc {
+	allowAll := false
+	allowed := make(map[string]bool, len(allowedOrigins))
+	for _, o := range allowedOrigins {
+		if o == "*" {
+			allowAll = true
+		}
+		allowed[o] = true
+	}
+
 	return func(c *gin.Context) {
 		h := c.Writer.Header()
-		h.Set("Access-Control-Allow-Origin", "*")
-		h.Set("Access-Control-Allow-Methods", "GET, POST, PUT, DELETE, OPTIONS")
+		origin := c.GetHeader("Origin")
+		switch {
+		case allowAll:
+			h.Set("Access-Control-Allow-Origin", "*")
+		case origin != "" && allowed[origin]:
+			h.Set("Access-Control-Allow-Origin", origin)
+			h.Add("Vary", "Origin")
+		}
+		h.Set("Access-Control-Allow-Methods", "GET, POST, PUT, PATCH, DELETE, OPTIONS")
 		h.Set("Access-Control-Allow-Headers", "Content-Type, Authorization")
 
 		// Handle preflight
@@ -0,0 +1,128 @@
+package middleware
+
+import (
+	"crypto"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"moh/shared/config"
+
+	jwt "github.com/golang-jwt/jwt/v4"
+)
+
+// JWTKey is one verification key. Tokens select it through the "kid" header;
+// an empty ID marks the key used for tokens that carry no kid.
+type JWTKey struct {
+	ID  string
+	Alg string // HS256, RS256 or ES256
+	// Secret is the shared HMAC secret (HS256 only).
+	Secret []byte
+	// PublicKey verifies RS256/ES256 signatures.
+	PublicKey crypto.PublicKey
+}
+
+// JWTConfig configures token verification.
+type JWTConfig struct {
+	Keys     []JWTKey
+	Issuer   string // required "iss" when non-empty
+	Audience string // required "aud" when non-empty
+}
+
+// KeySet resolves the verification key of a token by kid.
+// Several keys may be active at once, which is how keys are rotated:
+// publish the new key, start signing with it, then retire the old one.
+type KeySet struct {
+	keys     map[string]JWTKey
+	issuer   string
+	audience string
+}
+
+// NewKeySet validates cfg and indexes its keys by kid.
+func NewKeySet(cfg JWTConfig) (*KeySet, error) {
+	if len(cfg.Keys) == 0 {
+		return nil, errors.New("jwt: at least one key is required")
+	}
+	ks := &KeySet{keys: make(map[string]JWTKey, len(cfg.Keys)), issuer: cfg.Issuer, audience: cfg.Audience}
+	for _, k := range cfg.Keys {
+		if _, dup := ks.keys[k.ID]; dup {
+			return nil, fmt.Errorf("jwt: duplicate kid %q", k.ID)
+		}
+		switch k.Alg {
+		case "HS256":
+			if len(k.Secret) < 32 {
+				return nil, fmt.Errorf("jwt: key %q: HS256 secret must be at least 32 bytes", k.ID)
+			}
+		case "RS256", "ES256":
+			if k.PublicKey == nil {
+				return nil, fmt.Errorf("jwt: key %q: %s needs a public key", k.ID, k.Alg)
+			}
+		default:
+			return nil, fmt.Errorf("jwt: key %q: unsupported alg %q", k.ID, k.Alg)
+		}
+		ks.keys[k.ID] = k
+	}
+	return ks, nil
+}
+
+// KeySetFromConfig parses every configured key and builds the KeySet.
+func KeySetFromConfig(c config.JWTConfig) (*KeySet, error) {
+	keys := make([]JWTKey, 0, len(c.Keys))
+	for _, k := range c.Keys {
+		key, err := ParseJWTKey(k.ID, k.Alg, k.Material())
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, key)
+	}
+	return NewKeySet(JWTConfig{Keys: keys, Issuer: c.Issuer, Audience: c.Audience})
+}
+
+// keyFunc picks the key named by the token's kid and refuses any token whose
+// alg differs from the key's, so an RSA public key can never be used as an HMAC secret.
+func (ks *KeySet) keyFunc(token *jwt.Token) (interface{}, error) {
+	kid, _ := token.Header["kid"].(string)
+	k, ok := ks.keys[kid]
+	if !ok {
+		return nil, fmt.Errorf("unknown kid %q", kid)
+	}
+	if token.Method.Alg() != k.Alg {
+		return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
+	}
+	if k.Alg == "HS256" {
+		return k.Secret, nil
+	}
+	return k.PublicKey, nil
+}
+
+// ParseJWTKey builds a JWTKey from its textual form. For HS256 material is
+// the secret; for RS256/ES256 it is a PEM public key or "@path" to a PEM file.
+func ParseJWTKey(kid, alg, material string) (JWTKey, error) {
+	alg = strings.ToUpper(strings.TrimSpace(alg))
+	k := JWTKey{ID: strings.TrimSpace(kid), Alg: alg}
+
+	if strings.HasPrefix(material, "@") {
+		b, err := os.ReadFile(strings.TrimPrefix(material, "@"))
+		if err != nil {
+			return JWTKey{}, fmt.Errorf("jwt: key %q: %w", kid, err)
+		}
+		material = string(b)
+	}
+
+	var err error
+	switch alg {
+	case "HS256":
+		k.Secret = []byte(material)
+	case "RS256":
+		k.PublicKey, err = jwt.ParseRSAPublicKeyFromPEM([]byte(material))
+	case "ES256":
+		k.PublicKey, err = jwt.ParseECPublicKeyFromPEM([]byte(material))
+	default:
+		err = fmt.Errorf("unsupported alg %q", alg)
+	}
+	if err != nil {
+		return JWTKey{}, fmt.Errorf("jwt: key %q: %w", kid, err)
+	}
+	return k, nil
+}
@@ -1,68 +1,143 @@
 package middleware
 
 import (
-	"context"
+	"errors"
 	"fmt"
-	"net/http"
 	"strings"
 
+	"moh/shared"
+	"moh/shared/responed"
+
+	"github.com/gin-gonic/gin"
 	jwt "github.com/golang-jwt/jwt/v4"
 )
 
-func VerifyToken(tokenString string) (jwt.MapClaims, error) {
-	secret := []byte("jwtsecretstring")
+// Role is an authorization role carried in the token's "roles" claim.
+type Role string
+
+const (
+	RoleRegistryAdmin Role = "registry_admin"
+	RoleInspector     Role = "inspector"
+	RoleManufacturer  Role = "manufacturer"
+	RoleReadOnly      Role = "read_only"
+
+	// RoleSupplyChain is a wholesaler, pharmacy or hospital: it reports
+	// what happens to serialized packs and can do nothing else.
+	RoleSupplyChain Role = "supply_chain"
+)
+
+// ClaimsKey is the gin.Context key holding the caller's *Claims.
+const ClaimsKey = "auth.claims"
+
+// ErrTokenExpired is returned by Verify for tokens past their exp.
+var ErrTokenExpired = errors.New("token expired")
+
+// Claims are the JWT claims this API understands.
+type Claims struct {
+	Name  string `json:"name,omitempty"`
+	Roles []Role `json:"roles"`
+	jwt.RegisteredClaims
+}
 
-	token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
-		// Ensure HMAC signing method
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
+// HasRole reports whether the claims grant any of roles.
+func (c *Claims) HasRole(roles ...Role) bool {
+	for _, have := range c.Roles {
+		for _, want := range roles {
+			if have == want {
+				return true
+			}
 		}
-		return secret, nil
-	})
+	}
+	return false
+}
 
+// Verify parses and validates a compact JWT against the key set.
+func (ks *KeySet) Verify(tokenString string) (*Claims, error) {
+	parser := jwt.NewParser(jwt.WithValidMethods([]string{"HS256", "RS256", "ES256"}))
+	claims := &Claims{}
+	token, err := parser.ParseWithClaims(tokenString, claims, ks.keyFunc)
 	if err != nil {
-		if ve, ok := err.(*jwt.ValidationError); ok && ve.Errors&jwt.ValidationErrorExpired != 0 {
-			return nil, fmt.Errorf("token expired")
+		var ve *jwt.ValidationError
+		if errors.As(err, &ve) && ve.Errors&jwt.ValidationErrorExpired != 0 {
+			return nil, ErrTokenExpired
 		}
 		return nil, fmt.Errorf("token validation failed: %w", err)
 	}
-
-	claims, ok := token.Claims.(jwt.MapClaims)
-	if !ok || !token.Valid {
-		return nil, fmt.Errorf("invalid token")
+	if !token.Valid {
+		return nil, errors.New("invalid token")
+	}
+	if ks.issuer != "" && !claims.VerifyIssuer(ks.issuer, true) {
+		return nil, errors.New("invalid token issuer")
+	}
+	if ks.audience != "" && !claims.VerifyAudience(ks.audience, true) {
+		return nil, errors.New("invalid token audience")
+	}
+	if claims.Subject == "" {
+		return nil, errors.New("token has no subject")
 	}
-
 	return claims, nil
 }
 
-func Protect(next http.Handler) http.Handler {
-	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		authHeader := r.Header.Get("Authorization")
+// AuthGin requires a valid "Authorization: Bearer <jwt>" header and stores the
+// verified *Claims under ClaimsKey.
+// Usage: g.Use(middleware.AuthGin(keys))
+func AuthGin(ks *KeySet) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		authHeader := c.GetHeader("Authorization")
 		if authHeader == "" {
-			http.Error(w, "authorization header is missing", http.StatusUnauthorized)
+			abortUnauthorized(c, "auth_missing")
 			return
 		}
-
 		tokenString := strings.TrimPrefix(authHeader, "Bearer ")
-		if tokenString == authHeader {
-			http.Error(w, "invalid authorization header format", http.StatusUnauthorized)
+		if tokenString == authHeader || tokenString == "" {
+			abortUnauthorized(c, "auth_format")
 			return
 		}
 
-		claims, err := VerifyToken(tokenString)
+		claims, err := ks.Verify(tokenString)
 		if err != nil {
-			// Check for the "token expired" error message
-			if strings.Contains(err.Error(), "token expired") {
-				http.Error(w, "token has expired", http.StatusUnauthorized)
+			if errors.Is(err, ErrTokenExpired) {
+				abortUnauthorized(c, "token_expired")
 				return
 			}
-			// For any other validation error, return a generic unauthorized message
-			http.Error(w, "invalid token", http.StatusUnauthorized)
+			// Any other validation error gets a generic message.
+			abortUnauthorized(c, "token_invalid")
+			return
+		}
+
+		c.Set(ClaimsKey, claims)
+		c.Next()
+	}
+}
+
+// RequireRoles lets the request through only if the caller has any of roles.
+// It must run after AuthGin.
+func RequireRoles(roles ...Role) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		claims, ok := ClaimsFrom(c)
+		if !ok {
+			abortUnauthorized(c, "auth_required")
+			return
+		}
+		if !claims.HasRole(roles...) {
+			responed.Error(c, shared.Forbidden("forbidden"))
 			return
 		}
+		c.Next()
+	}
+}
 
-		ctx := context.WithValue(r.Context(), "user_claims", claims)
+// ClaimsFrom returns the claims AuthGin stored on the context.
+func ClaimsFrom(c *gin.Context) (*Claims, bool) {
+	v, ok := c.Get(ClaimsKey)
+	if !ok {
+		return nil, false
+	}
+	claims, ok := v.(*Claims)
+	return claims, ok
+}
 
-		next.ServeHTTP(w, r.WithContext(ctx))
-	})
+func abortUnauthorized(c *gin.Context, key string) {
+	c.Header("WWW-Authenticate", `Bearer realm="moh"`)
+	responed.Error(c, shared.Unauthorized(key))
 }
@@ -3,12 +3,15 @@ package middleware
 import (
 	"bytes"
 	"encoding/json"
+	"fmt"
 	"io"
-	"net/http"
 	"net/url"
 	"strconv"
 	"strings"
 
+	"moh/shared"
+	"moh/shared/responed"
+
 	"github.com/gin-gonic/gin"
 	"github.com/microcosm-cc/bluemonday"
 )
@@ -38,40 +41,33 @@ func XssValidatorGin() gin.HandlerFunc {
 			// Read body (it’s a one-shot reader)
 			bodyBytes, err := io.ReadAll(c.Request.Body)
 			if err != nil {
-				c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{
-					"error":   "invalid_body",
-					"message": "failed to read request body",
-				})
+				responed.Error(c, shared.BadRequest("invalid_body", "invalid_body"))
 				return
 			}
 			// Restore empty body if nothing there
 			if len(bodyBytes) == 0 {
 				c.Request.Body = io.NopCloser(bytes.NewReader(bodyBytes))
 			} else {
+				// UseNumber keeps numbers as the literals they were sent as:
+				// through float64, a price of 12.50 would reach the handler
+				// as 12.5 and long decimals would lose digits.
 				var payload any
-				if err := json.Unmarshal(bodyBytes, &payload); err != nil {
-					c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{
-						"error":   "invalid_json",
-						"message": "invalid JSON body",
-					})
+				dec := json.NewDecoder(bytes.NewReader(bodyBytes))
+				dec.UseNumber()
+				if err := dec.Decode(&payload); err != nil || dec.Decode(new(any)) != io.EOF {
+					responed.Error(c, shared.BadRequest("invalid_json", "invalid_json_body"))
 					return
 				}
 
 				cleaned, err := clean(payload)
 				if err != nil {
-					c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
-						"error":   "sanitize_failed",
-						"message": "failed to sanitize JSON",
-					})
+					responed.Error(c, shared.Internal(fmt.Errorf("sanitize JSON: %w", err)))
 					return
 				}
 
 				safeBytes, err := json.Marshal(cleaned)
 				if err != nil {
-					c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
-						"error":   "sanitize_failed",
-						"message": "failed to re-encode JSON",
-					})
+					responed.Error(c, shared.Internal(fmt.Errorf("re-encode JSON: %w", err)))
 					return
 				}
 
@@ -128,7 +124,7 @@ func sanitizeValue(value any) (any, error) {
 		return clean(v)
 	case []any:
 		return clean(v)
-	default:
+	default: // json.Number, bool, nil
 		return v, nil
 	}
 }
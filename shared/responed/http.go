@@ -1 +1,82 @@
-package responed
\ No newline at end of file
+// Package responed writes error responses as RFC 7807 problem details.
+package responed
+
+import (
+	"log"
+	"net/http"
+
+	"moh/shared"
+
+	"github.com/gin-gonic/gin"
+	ut "github.com/go-playground/universal-translator"
+)
+
+// ContentType is the media type of a problem details body.
+const ContentType = "application/problem+json"
+
+// Problem is an RFC 7807 problem details body. Code, Field and Errors are
+// extension members: Code is the shared.Error code clients switch on, Field
+// the JSON name of the offending field when there is one, and Errors every
+// failed rule of a validation error.
+type Problem struct {
+	Type     string              `json:"type"`
+	Title    string              `json:"title"`
+	Status   int                 `json:"status"`
+	Detail   string              `json:"detail,omitempty"`
+	Instance string              `json:"instance,omitempty"`
+	Code     string              `json:"code"`
+	Field    string              `json:"field,omitempty"`
+	Errors   []shared.FieldError `json:"errors,omitempty"`
+}
+
+// Status is the HTTP status for an error kind.
+func Status(k shared.Kind) int {
+	switch k {
+	case shared.KindBadRequest:
+		return http.StatusBadRequest
+	case shared.KindValidation, shared.KindForeignKey:
+		return http.StatusUnprocessableEntity
+	case shared.KindNotFound:
+		return http.StatusNotFound
+	case shared.KindConflict:
+		return http.StatusConflict
+	case shared.KindUnauthorized:
+		return http.StatusUnauthorized
+	case shared.KindForbidden:
+		return http.StatusForbidden
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// NewProblem builds the problem details for err, with Detail and the field
+// messages in tr's language. Errors without a shared.Error in their chain
+// are internal: their text is logged, never sent.
+func NewProblem(err error, instance string, tr ut.Translator) Problem {
+	e := shared.As(err)
+	if e.Kind == shared.KindInternal {
+		log.Printf("internal error on %s: %v", instance, err)
+	}
+	e = e.Localize(tr)
+	status := Status(e.Kind)
+	return Problem{
+		Type:     "about:blank",
+		Title:    http.StatusText(status),
+		Status:   status,
+		Detail:   e.Detail,
+		Instance: instance,
+		Code:     e.Code,
+		Field:    e.Field,
+		Errors:   e.Fields,
+	}
+}
+
+// Error aborts the request with the problem details for err, written in
+// the language the Accept-Language header asks for.
+func Error(c *gin.Context, err error) {
+	tr := shared.Translator(c.GetHeader("Accept-Language"))
+	p := NewProblem(err, c.Request.URL.Path, tr)
+	c.Header("Content-Type", ContentType)
+	c.Header("Content-Language", tr.Locale())
+	c.AbortWithStatusJSON(p.Status, p)
+}
@@ -0,0 +1,144 @@
+package shared
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/go-playground/locales/ar"
+	"github.com/go-playground/locales/en"
+	ut "github.com/go-playground/universal-translator"
+)
+
+// Locales the message catalog is written in. English is the fallback and
+// the language of Error(), and so of logs.
+const (
+	LocaleEnglish = "en"
+	LocaleArabic  = "ar"
+)
+
+var universal = newUniversal()
+
+// English renders messages for Error() and for clients that asked for no
+// language the catalog has.
+var English = Translator("")
+
+func newUniversal() *ut.UniversalTranslator {
+	uni := ut.New(en.New(), en.New(), ar.New())
+	for locale, msgs := range catalog {
+		tr, _ := uni.GetTranslator(locale)
+		for key, text := range msgs {
+			if err := tr.Add(key, text, false); err != nil {
+				panic(fmt.Sprintf("shared: message %q (%s): %v", key, locale, err))
+			}
+		}
+	}
+	return uni
+}
+
+// Translator picks the catalog language for an Accept-Language header,
+// honouring q-values and falling back from "ar-JO" to "ar". Languages the
+// catalog lacks, and an empty header, get English.
+func Translator(acceptLanguage string) ut.Translator {
+	tr, _ := universal.FindTranslator(languages(acceptLanguage)...)
+	return tr
+}
+
+// languages lists the locales of an Accept-Language header, most preferred
+// first, each region-qualified tag followed by its base language.
+func languages(header string) []string {
+	type lang struct {
+		tag string
+		q   float64
+	}
+	var langs []lang
+	for _, part := range strings.Split(header, ",") {
+		tag, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		if tag == "" || tag == "*" {
+			continue
+		}
+		q := 1.0
+		if v, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			if f, err := strconv.ParseFloat(v, 64); err == nil {
+				q = f
+			}
+		}
+		if q > 0 {
+			langs = append(langs, lang{tag, q})
+		}
+	}
+	sort.SliceStable(langs, func(i, j int) bool { return langs[i].q > langs[j].q })
+
+	out := make([]string, 0, 2*len(langs))
+	for _, l := range langs {
+		tag := strings.ReplaceAll(l.tag, "-", "_")
+		out = append(out, tag)
+		if base, _, ok := strings.Cut(tag, "_"); ok {
+			out = append(out, base)
+		}
+	}
+	return out
+}
+
+// Message renders the catalog entry key in tr's language, substituting
+// args for {0}, {1}, ... Keys missing from tr's catalog fall back to
+// English, and keys missing from both are returned as they are.
+func Message(tr ut.Translator, key string, args ...string) string {
+	if s, err := tr.T(key, args...); err == nil {
+		return s
+	}
+	if tr.Locale() != LocaleEnglish {
+		return Message(English, key, args...)
+	}
+	return key
+}
+
+// HasMessage reports whether the catalog has a message keyed key.
+func HasMessage(key string) bool {
+	_, ok := catalog[LocaleEnglish][key]
+	return ok
+}
+
+// Localize returns a copy of e whose Detail and field messages are written
+// in tr's language.
+func (e *Error) Localize(tr ut.Translator) *Error {
+	out := *e
+	if e.Fields != nil {
+		out.Fields = make([]FieldError, len(e.Fields))
+		for i, f := range e.Fields {
+			f.Message = f.render(tr)
+			out.Fields[i] = f
+		}
+	}
+	out.Detail = out.render(tr)
+	return &out
+}
+
+// render writes the detail in tr's language: the joined field messages of
+// a validation error, whose field paths already name the failed element, or
+// else the message Key names after the Within path.
+func (e *Error) render(tr ut.Translator) string {
+	if e.Key == "" {
+		msgs := make([]string, len(e.Fields))
+		for i, f := range e.Fields {
+			msgs[i] = f.render(tr)
+		}
+		return strings.Join(msgs, "; ")
+	}
+	s := Message(tr, e.Key, e.Args...)
+	if e.Path != "" {
+		s = e.Path + ": " + s
+	}
+	return s
+}
+
+// render writes the message of f in tr's language. Its catalog entry sees
+// the field as {0}, the rule parameter as {1} and Args from {2} on.
+func (f FieldError) render(tr ut.Translator) string {
+	key := f.Key
+	if key == "" {
+		key = f.Rule
+	}
+	return Message(tr, key, append([]string{f.Field, f.Param}, f.Args...)...)
+}
@@ -0,0 +1,64 @@
+package shared
+
+import (
+	"strings"
+	"testing"
+)
+
+// Every message exists in every locale with the same placeholders, so no
+// language falls back to English and no argument is dropped.
+func TestCatalogComplete(t *testing.T) {
+	for locale, msgs := range catalog {
+		for key, text := range catalog[LocaleEnglish] {
+			other, ok := msgs[key]
+			if !ok {
+				t.Errorf("%s: no message %q", locale, key)
+				continue
+			}
+			if strings.Count(other, "{") != strings.Count(text, "{") {
+				t.Errorf("%s: message %q has different placeholders than English", locale, key)
+			}
+		}
+		for key := range msgs {
+			if _, ok := catalog[LocaleEnglish][key]; !ok {
+				t.Errorf("%s: message %q has no English original", locale, key)
+			}
+		}
+	}
+}
+
+func TestTranslator(t *testing.T) {
+	for header, want := range map[string]string{
+		"":                      LocaleEnglish,
+		"ar":                    LocaleArabic,
+		"ar-SA":                 LocaleArabic,
+		"fr, ar;q=0.8":          LocaleArabic,
+		"ar;q=0.3, en-GB;q=0.7": LocaleEnglish,
+		"ar;q=0, en;q=0.1":      LocaleEnglish,
+		"de-DE":                 LocaleEnglish,
+	} {
+		if got := Translator(header).Locale(); got != want {
+			t.Errorf("Translator(%q) = %s, want %s", header, got, want)
+		}
+	}
+}
+
+func TestLocalize(t *testing.T) {
+	err := Within("sites[1]", Validation(NewFieldError("site_id", "required", "", "")))
+	e := As(err)
+	if e.Detail != "sites[1].site_id is required" {
+		t.Errorf("detail = %q", e.Detail)
+	}
+	ar := e.Localize(Translator("ar"))
+	if ar.Detail != "sites[1].site_id مطلوب" || ar.Fields[0].Message != ar.Detail {
+		t.Errorf("localized = %q, %q", ar.Detail, ar.Fields[0].Message)
+	}
+	if e.Fields[0].Message != "sites[1].site_id is required" {
+		t.Errorf("Localize changed the original: %q", e.Fields[0].Message)
+	}
+
+	fk := As(Within("sites[0]", ForeignKey("site_id", "")))
+	if got := fk.Localize(Translator("ar")).Detail; got != "sites[0]: مرجع غير صالح: site_id" {
+		t.Errorf("foreign key detail = %q", got)
+	}
+}